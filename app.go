@@ -325,7 +325,7 @@ func NewApp(
 	app.lpKeeper = liquidityprovider.NewKeeper(app.appCodec, keys[lptypes.StoreKey], app.bankKeeper)
 	app.issuerKeeper = issuer.NewKeeper(app.appCodec, keys[issuer.StoreKey], app.lpKeeper, app.inflationKeeper, app.bankKeeper)
 	app.authorityKeeper = authority.NewKeeper(app.appCodec, keys[authority.StoreKey], app.issuerKeeper, app.bankKeeper, app, &app.upgradeKeeper)
-	app.marketKeeper = market.NewKeeper(app.appCodec, keys[market.StoreKey], keys[market.StoreKeyIdx], app.accountKeeper, app.bankKeeper)
+	app.marketKeeper = market.NewKeeper(app.appCodec, keys[market.StoreKey], keys[market.StoreKeyIdx], app.GetSubspace(market.ModuleName), app.accountKeeper, app.bankKeeper, app.stakingKeeper, app.authorityKeeper, buyback.AccountName, authtypes.FeeCollectorName)
 	app.buybackKeeper = buyback.NewKeeper(app.appCodec, keys[buyback.StoreKey], app.marketKeeper, app.accountKeeper, app.stakingKeeper, app.bankKeeper)
 
 	// NOTE: we may consider parsing `appOpts` inside module constructors. For the moment
@@ -381,7 +381,7 @@ func NewApp(
 	)
 
 	// todo (reviewer): check which modules make sense
-	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, stakingtypes.ModuleName)
+	app.mm.SetOrderEndBlockers(market.ModuleName, crisistypes.ModuleName, stakingtypes.ModuleName)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -613,6 +613,7 @@ func initParamsKeeper(appCodec codec.BinaryMarshaler, legacyAmino *codec.LegacyA
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
+	paramsKeeper.Subspace(market.ModuleName)
 
 	return paramsKeeper
 }
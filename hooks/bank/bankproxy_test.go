@@ -94,9 +94,11 @@ func TestSendCoinsBalanceUpdateNotification(t *testing.T) {
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			receivedAddr := make([][][]sdk.AccAddress, spec.listenerCount)
-			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress) {
-				return func(_ sdk.Context, addrs []sdk.AccAddress) {
+			receivedCoins := make([]sdk.Coins, spec.listenerCount)
+			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress, sdk.Coins) {
+				return func(_ sdk.Context, addrs []sdk.AccAddress, amt sdk.Coins) {
 					receivedAddr[listenerNb] = append(receivedAddr[listenerNb], addrs)
+					receivedCoins[listenerNb] = amt
 				}
 			}
 
@@ -124,6 +126,7 @@ func TestSendCoinsBalanceUpdateNotification(t *testing.T) {
 			for i := 0; i < spec.listenerCount; i++ {
 				require.Len(t, receivedAddr[i], 1)
 				assert.Equal(t, spec.expAddr, receivedAddr[i][0])
+				assert.Equal(t, coins("1token"), receivedCoins[i])
 			}
 		})
 	}
@@ -142,8 +145,9 @@ func TestInputOutputCoinsBalanceUpdateNotification(t *testing.T) {
 		listenerCount      int
 		nestedKeeperResult error
 
-		expAddr []sdk.AccAddress
-		expErr  bool
+		expAddr  []sdk.AccAddress
+		expCoins sdk.Coins
+		expErr   bool
 	}{
 		"one listener called": {
 			srcInput:      []banktypes.Input{{Address: addr1.String()}},
@@ -170,13 +174,22 @@ func TestInputOutputCoinsBalanceUpdateNotification(t *testing.T) {
 			listenerCount: 2,
 			expAddr:       []sdk.AccAddress{addr1, addr2},
 		},
+		"coins of all inputs": {
+			srcInput:      []banktypes.Input{{Address: addr1.String(), Coins: coins("1atoken")}, {Address: addr2.String(), Coins: coins("1atoken,2btoken")}},
+			srcOutput:     []banktypes.Output{{Address: addr2.String(), Coins: coins("2atoken")}, {Address: addr1.String(), Coins: coins("2btoken")}},
+			listenerCount: 1,
+			expAddr:       []sdk.AccAddress{addr1, addr2},
+			expCoins:      coins("2atoken,2btoken"),
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			receivedAddr := make([][][]sdk.AccAddress, spec.listenerCount)
-			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress) {
-				return func(_ sdk.Context, addrs []sdk.AccAddress) {
+			receivedCoins := make([]sdk.Coins, spec.listenerCount)
+			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress, sdk.Coins) {
+				return func(_ sdk.Context, addrs []sdk.AccAddress, amt sdk.Coins) {
 					receivedAddr[listenerNb] = append(receivedAddr[listenerNb], addrs)
+					receivedCoins[listenerNb] = amt
 				}
 			}
 
@@ -204,6 +217,7 @@ func TestInputOutputCoinsBalanceUpdateNotification(t *testing.T) {
 			for i := 0; i < spec.listenerCount; i++ {
 				require.Len(t, receivedAddr[i], 1)
 				assert.Equal(t, spec.expAddr, receivedAddr[i][0])
+				assert.Equal(t, spec.expCoins.String(), receivedCoins[i].String())
 			}
 		})
 	}
@@ -247,8 +261,8 @@ func TestSendCoinsFromModuleToAccount(t *testing.T) {
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			receivedAddr := make([][][]sdk.AccAddress, spec.listenerCount)
-			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress) {
-				return func(_ sdk.Context, addrs []sdk.AccAddress) {
+			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress, sdk.Coins) {
+				return func(_ sdk.Context, addrs []sdk.AccAddress, _ sdk.Coins) {
 					receivedAddr[listenerNb] = append(receivedAddr[listenerNb], addrs)
 				}
 			}
@@ -321,8 +335,8 @@ func TestSendCoinsFromAccountToModule(t *testing.T) {
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			receivedAddr := make([][][]sdk.AccAddress, spec.listenerCount)
-			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress) {
-				return func(_ sdk.Context, addrs []sdk.AccAddress) {
+			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress, sdk.Coins) {
+				return func(_ sdk.Context, addrs []sdk.AccAddress, _ sdk.Coins) {
 					receivedAddr[listenerNb] = append(receivedAddr[listenerNb], addrs)
 				}
 			}
@@ -396,8 +410,8 @@ func TestDelegateCoinsFromAccountToModule(t *testing.T) {
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			receivedAddr := make([][][]sdk.AccAddress, spec.listenerCount)
-			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress) {
-				return func(_ sdk.Context, addrs []sdk.AccAddress) {
+			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress, sdk.Coins) {
+				return func(_ sdk.Context, addrs []sdk.AccAddress, _ sdk.Coins) {
 					receivedAddr[listenerNb] = append(receivedAddr[listenerNb], addrs)
 				}
 			}
@@ -471,8 +485,8 @@ func TestUndelegateCoinsFromModuleToAccount(t *testing.T) {
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			receivedAddr := make([][][]sdk.AccAddress, spec.listenerCount)
-			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress) {
-				return func(_ sdk.Context, addrs []sdk.AccAddress) {
+			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress, sdk.Coins) {
+				return func(_ sdk.Context, addrs []sdk.AccAddress, _ sdk.Coins) {
 					receivedAddr[listenerNb] = append(receivedAddr[listenerNb], addrs)
 				}
 			}
@@ -546,8 +560,8 @@ func TestDelegateCoins(t *testing.T) {
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			receivedAddr := make([][][]sdk.AccAddress, spec.listenerCount)
-			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress) {
-				return func(_ sdk.Context, addrs []sdk.AccAddress) {
+			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress, sdk.Coins) {
+				return func(_ sdk.Context, addrs []sdk.AccAddress, _ sdk.Coins) {
 					receivedAddr[listenerNb] = append(receivedAddr[listenerNb], addrs)
 				}
 			}
@@ -622,8 +636,8 @@ func TestUndelegateCoins(t *testing.T) {
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			receivedAddr := make([][][]sdk.AccAddress, spec.listenerCount)
-			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress) {
-				return func(_ sdk.Context, addrs []sdk.AccAddress) {
+			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress, sdk.Coins) {
+				return func(_ sdk.Context, addrs []sdk.AccAddress, _ sdk.Coins) {
 					receivedAddr[listenerNb] = append(receivedAddr[listenerNb], addrs)
 				}
 			}
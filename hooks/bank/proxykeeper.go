@@ -16,21 +16,23 @@ var _ bankkeeper.Keeper = (*ProxyKeeper)(nil)
 
 type ProxyKeeper struct {
 	bk        bankkeeper.Keeper
-	listeners []func(sdk.Context, []sdk.AccAddress)
+	listeners []func(sdk.Context, []sdk.AccAddress, sdk.Coins)
 }
 
 func Wrap(bk bankkeeper.Keeper) *ProxyKeeper {
 	return &ProxyKeeper{bk: bk}
 }
 
-func (pk *ProxyKeeper) AddBalanceListener(l func(sdk.Context, []sdk.AccAddress)) {
+// AddBalanceListener registers a listener that is notified of the accounts whose balances changed in a transfer, along
+// with the coins that were transferred.
+func (pk *ProxyKeeper) AddBalanceListener(l func(sdk.Context, []sdk.AccAddress, sdk.Coins)) {
 	pk.listeners = append(pk.listeners, l)
 }
 
-func (pk ProxyKeeper) notifyListeners(ctx sdk.Context, accounts ...sdk.AccAddress) {
+func (pk ProxyKeeper) notifyListeners(ctx sdk.Context, amt sdk.Coins, accounts ...sdk.AccAddress) {
 	accounts = deduplicate(accounts)
 	for _, l := range pk.listeners {
-		l(ctx, accounts)
+		l(ctx, accounts, amt)
 	}
 }
 
@@ -54,17 +56,19 @@ func (pk ProxyKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input
 	}
 
 	accounts := make([]sdk.AccAddress, 0, len(inputs)+len(outputs))
+	amt := sdk.NewCoins()
 	for _, a := range inputs {
 		// invalid addresses were handled before in the wrapped keeper
 		addr, _ := sdk.AccAddressFromBech32(a.Address)
 		accounts = append(accounts, addr)
+		amt = amt.Add(a.Coins...)
 	}
 	for _, a := range outputs {
 		addr, _ := sdk.AccAddressFromBech32(a.Address)
 		accounts = append(accounts, addr)
 	}
 
-	pk.notifyListeners(ctx, accounts...)
+	pk.notifyListeners(ctx, amt, accounts...)
 	return nil
 }
 
@@ -73,7 +77,7 @@ func (pk ProxyKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr
 	if err != nil {
 		return err
 	}
-	pk.notifyListeners(ctx, fromAddr, toAddr)
+	pk.notifyListeners(ctx, amt, fromAddr, toAddr)
 	return nil
 }
 
@@ -182,7 +186,7 @@ func (pk *ProxyKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModul
 	if err != nil {
 		return err
 	}
-	pk.notifyListeners(ctx, recipientAddr)
+	pk.notifyListeners(ctx, amt, recipientAddr)
 	return nil
 }
 
@@ -195,7 +199,7 @@ func (pk *ProxyKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr
 	if err != nil {
 		return err
 	}
-	pk.notifyListeners(ctx, senderAddr)
+	pk.notifyListeners(ctx, amt, senderAddr)
 	return nil
 }
 
@@ -204,7 +208,7 @@ func (pk *ProxyKeeper) DelegateCoinsFromAccountToModule(ctx sdk.Context, senderA
 	if err != nil {
 		return err
 	}
-	pk.notifyListeners(ctx, senderAddr)
+	pk.notifyListeners(ctx, amt, senderAddr)
 	return nil
 }
 
@@ -213,7 +217,7 @@ func (pk *ProxyKeeper) UndelegateCoinsFromModuleToAccount(ctx sdk.Context, sende
 	if err != nil {
 		return err
 	}
-	pk.notifyListeners(ctx, recipientAddr)
+	pk.notifyListeners(ctx, amt, recipientAddr)
 	return nil
 }
 
@@ -230,7 +234,7 @@ func (pk *ProxyKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAd
 	if err != nil {
 		return err
 	}
-	pk.notifyListeners(ctx, delegatorAddr)
+	pk.notifyListeners(ctx, amt, delegatorAddr)
 	return nil
 }
 
@@ -239,7 +243,7 @@ func (pk *ProxyKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegator
 	if err != nil {
 		return err
 	}
-	pk.notifyListeners(ctx, delegatorAddr)
+	pk.notifyListeners(ctx, amt, delegatorAddr)
 	return nil
 }
 
@@ -23,6 +23,25 @@ const (
 	TimeInForce_GoodTillCancel    = types.TimeInForce_GoodTillCancel
 	TimeInForce_ImmediateOrCancel = types.TimeInForce_ImmediateOrCancel
 	TimeInForce_FillOrKill        = types.TimeInForce_FillOrKill
+	TimeInForce_GoodTillTime      = types.TimeInForce_GoodTillTime
+	TimeInForce_GoodTillBlock     = types.TimeInForce_GoodTillBlock
+
+	PostOnlyMode_None    = types.PostOnlyMode_None
+	PostOnlyMode_Reject  = types.PostOnlyMode_Reject
+	PostOnlyMode_Reprice = types.PostOnlyMode_Reprice
+
+	SelfTradePrevention_None               = types.SelfTradePrevention_None
+	SelfTradePrevention_CancelNewest       = types.SelfTradePrevention_CancelNewest
+	SelfTradePrevention_CancelOldest       = types.SelfTradePrevention_CancelOldest
+	SelfTradePrevention_CancelBoth         = types.SelfTradePrevention_CancelBoth
+	SelfTradePrevention_DecrementAndCancel = types.SelfTradePrevention_DecrementAndCancel
+
+	StopOrderType_Limit  = types.StopOrderType_Limit
+	StopOrderType_Market = types.StopOrderType_Market
+
+	CandleInterval_Minute = types.CandleInterval_Minute
+	CandleInterval_Hour   = types.CandleInterval_Hour
+	CandleInterval_Day    = types.CandleInterval_Day
 )
 
 var (
@@ -30,6 +49,12 @@ var (
 	NewKeeper = keeper.NewKeeper
 	NewOrder  = types.NewOrder
 
+	NewStopOrder = types.NewStopOrder
+
+	NewParams     = types.NewParams
+	DefaultParams = types.DefaultParams
+	ParamKeyTable = types.ParamKeyTable
+
 	ErrClientOrderIdNotFound                   = types.ErrClientOrderIdNotFound
 	ErrOrderInstrumentChanged                  = types.ErrOrderInstrumentChanged
 	ErrNoSourceRemaining                       = types.ErrNoSourceRemaining
@@ -52,15 +77,34 @@ var (
 )
 
 type (
-	Keeper        = keeper.Keeper
-	Order         = types.Order
-	MarketData    = types.MarketData
-	ExecutionPlan = types.ExecutionPlan
+	Keeper             = keeper.Keeper
+	Order              = types.Order
+	StopOrder          = types.StopOrder
+	MarketData         = types.MarketData
+	ExecutionPlan      = types.ExecutionPlan
+	GenesisState       = types.GenesisState
+	Params             = types.Params
+	Candle             = types.Candle
+	InstrumentFees     = types.InstrumentFees
+	InstrumentRules    = types.InstrumentRules
+	TradingHalt        = types.TradingHalt
+	PriceBand          = types.PriceBand
+	AccountOrderLimits = types.AccountOrderLimits
+	BatchAuction       = types.BatchAuction
 
 	MsgAddMarketOrder          = types.MsgAddMarketOrder
 	MsgAddLimitOrder           = types.MsgAddLimitOrder
 	MsgCancelOrder             = types.MsgCancelOrder
+	MsgCancelAllOrders         = types.MsgCancelAllOrders
 	MsgCancelReplaceLimitOrder = types.MsgCancelReplaceLimitOrder
+	MsgAddStopOrder            = types.MsgAddStopOrder
+	MsgSetFees                 = types.MsgSetFees
+	MsgSetInstrumentRules      = types.MsgSetInstrumentRules
+	MsgHaltTrading             = types.MsgHaltTrading
+	MsgResumeTrading           = types.MsgResumeTrading
+	MsgSetPriceBand            = types.MsgSetPriceBand
+	MsgSetOrderLimits          = types.MsgSetOrderLimits
+	MsgSetBatchAuction         = types.MsgSetBatchAuction
 
 	AccountKeeper = types.AccountKeeper
 	BankKeeper    = types.BankKeeper
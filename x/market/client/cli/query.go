@@ -5,6 +5,9 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,6 +16,11 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	flag_Depth     = "depth"
+	flag_Synthetic = "synthetic"
+)
+
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -25,7 +33,18 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetInstrumentsCmd(),
 		GetInstrumentCmd(),
+		GetOrderBookCmd(),
+		GetCandlesCmd(),
+		GetTradesByInstrumentCmd(),
+		GetTradesByAccountCmd(),
 		GetByAccountCmd(),
+		GetQuoteCmd(),
+		GetOrderByIDCmd(),
+		GetOrderByClientOrderIDCmd(),
+		GetTradingHaltsCmd(),
+		GetPriceBandsCmd(),
+		GetBatchAuctionsCmd(),
+		GetTimeWeightedAveragePriceCmd(),
 	)
 
 	return cmd
@@ -87,6 +106,178 @@ func GetInstrumentCmd() *cobra.Command {
 	return cmd
 }
 
+func GetOrderBookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orderbook [source-denomination] [destination-denomination]",
+		Short: "Query the aggregated price levels of a specific instrument",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			depth, err := cmd.Flags().GetUint32(flag_Depth)
+			if err != nil {
+				return err
+			}
+
+			synthetic, err := cmd.Flags().GetBool(flag_Synthetic)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.OrderBook(cmd.Context(), &types.QueryOrderBookRequest{
+				Source:           args[0],
+				Destination:      args[1],
+				Depth:            depth,
+				IncludeSynthetic: synthetic,
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.WithJSONMarshaler(apptypes.NewMarshaller(clientCtx)).PrintProto(res)
+		},
+	}
+	cmd.Flags().Uint32(flag_Depth, 0, "Number of price levels from the best price to include (0 includes every level)")
+	cmd.Flags().Bool(flag_Synthetic, false, "Include the levels implied by routing through other denominations")
+	flags.AddPaginationFlagsToCmd(cmd, "orderbook")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCandlesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "candles [source-denomination] [destination-denomination] [interval]",
+		Short: "Query the price history of a specific instrument",
+		Long: `Query the open, high, low and close prices and traded volume of an instrument, oldest first.
+The interval is one of 1m, 1h or 1d.
+
+Example:
+ emd query market candles eeur echf 1h
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Candles(cmd.Context(), &types.QueryCandlesRequest{
+				Source:      args[0],
+				Destination: args[1],
+				Interval:    args[2],
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.WithJSONMarshaler(apptypes.NewMarshaller(clientCtx)).PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "candles")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetTradesByInstrumentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trades-instrument [source-denomination] [destination-denomination]",
+		Short: "Query the recent trades of a specific instrument",
+		Long: `Query the recent trades in which the resting order sold the source denomination for the destination denomination, oldest first.
+
+Example:
+ emd query market trades-instrument eeur echf
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TradesByInstrument(cmd.Context(), &types.QueryTradesByInstrumentRequest{
+				Source:      args[0],
+				Destination: args[1],
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.WithJSONMarshaler(apptypes.NewMarshaller(clientCtx)).PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "trades-instrument")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetTradesByAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trades-account [key_or_address]",
+		Short: "Query the recent trades of a specific account",
+		Long: `Query the recent trades in which the account was either maker or taker, oldest first.
+
+Example:
+ emd query market trades-account emoney17up20gamd0vh6g9ne0uh67hx8xhyfrv2lyazgu
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				// Named key specified
+				addr = clientCtx.FromAddress
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TradesByAccount(cmd.Context(), &types.QueryTradesByAccountRequest{
+				Address:    addr.String(),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.WithJSONMarshaler(apptypes.NewMarshaller(clientCtx)).PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "trades-account")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetInstrumentsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "instruments",
@@ -110,3 +301,225 @@ func GetInstrumentsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetQuoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quote [source-amount] [destination-amount]",
+		Short: "Simulate an order against the book without placing it",
+		Long: `Simulate an immediate-or-cancel order that sells at most the source amount to buy the destination amount.
+Reports the expected fills, average price, route and remaining unfilled amount. No account balance is needed and no
+state is changed.
+
+Example:
+ emd query market quote 1100eeur 1000echf
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			src, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			dst, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Quote(cmd.Context(), &types.QueryQuoteRequest{
+				Source:      src,
+				Destination: dst,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.WithJSONMarshaler(apptypes.NewMarshaller(clientCtx)).PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetOrderByIDCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order [order-id]",
+		Short: "Query a resting order by its order id",
+		Long: `Query a resting order by the order id reported in its events, including its filled and remaining amounts.
+
+Example:
+ emd query market order 42
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid order id %q: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.OrderByID(cmd.Context(), &types.QueryOrderByIDRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.WithJSONMarshaler(apptypes.NewMarshaller(clientCtx)).PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetOrderByClientOrderIDCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "client-order [key_or_address] [client-order-id]",
+		Short: "Query a resting order by its owner and client order id",
+		Long: `Query a resting order by its owner and client order id, including its filled and remaining amounts.
+
+Example:
+ emd query market client-order emoney17up20gamd0vh6g9ne0uh67hx8xhyfrv2lyazgu myorder
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				// Named key specified
+				addr = clientCtx.FromAddress
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.OrderByClientOrderID(cmd.Context(), &types.QueryOrderByClientOrderIDRequest{
+				Owner:         addr.String(),
+				ClientOrderId: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.WithJSONMarshaler(apptypes.NewMarshaller(clientCtx)).PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetTradingHaltsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "halts",
+		Short: "Query the instruments and denominations on which trading is halted",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TradingHalts(cmd.Context(), &types.QueryTradingHaltsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.WithJSONMarshaler(apptypes.NewMarshaller(clientCtx)).PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetPriceBandsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-bands",
+		Short: "Query the price bands limiting the trade prices of instruments",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PriceBands(cmd.Context(), &types.QueryPriceBandsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.WithJSONMarshaler(apptypes.NewMarshaller(clientCtx)).PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetBatchAuctionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-auctions",
+		Short: "Query the instruments that are cleared in batch auctions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BatchAuctions(cmd.Context(), &types.QueryBatchAuctionsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.WithJSONMarshaler(apptypes.NewMarshaller(clientCtx)).PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetTimeWeightedAveragePriceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [source-denomination] [destination-denomination] [window]",
+		Short: "Query the time-weighted average price of a specific instrument",
+		Long: `Query the average of the last traded price of an instrument, weighted by the time each price held, over a window
+ending at the latest block. The window cannot exceed the price history retention.
+
+Example:
+ emd query market twap eeur echf 1h
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TimeWeightedAveragePrice(cmd.Context(), &types.QueryTimeWeightedAveragePriceRequest{
+				Source:      args[0],
+				Destination: args[1],
+				Window:      args[2],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.WithJSONMarshaler(apptypes.NewMarshaller(clientCtx)).PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -5,6 +5,11 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -14,9 +19,40 @@ import (
 )
 
 const (
-	flag_TimeInForce = "time-in-force"
+	flag_TimeInForce    = "time-in-force"
+	flag_ExpireTime     = "expire-time"
+	flag_ExpireHeight   = "expire-height"
+	flag_PostOnly       = "post-only"
+	flag_SelfTrade      = "self-trade-prevention"
+	flag_Display        = "display-quantity"
+	flag_InstrumentFee  = "instrument-fee"
+	flag_Source         = "source"
+	flag_Destination    = "destination"
+	flag_CancelOrders   = "cancel-orders"
+	flag_ReferencePrice = "reference-price"
+	flag_MaxBreaches    = "max-breaches"
+	flag_BreachWindow   = "breach-window"
+	flag_HaltDuration   = "halt-duration"
+	flag_AccountLimit   = "account-limit"
+	flag_Disable        = "disable"
 
-	flag_TimeInForceDescription = "Select the order's time-in-force value (GTC|IOC|FOK)"
+	flag_TimeInForceDescription     = "Select the order's time-in-force value (GTC|IOC|FOK|GTT|GTB)"
+	flag_StopTimeInForceDescription = "Select the time-in-force value of the order sent when the stop order is triggered (GTC|IOC|FOK)"
+	flag_ExpireTimeDescription      = "Block time at which a GTT order expires (RFC3339)"
+	flag_ExpireHeightDescription    = "Block height at which a GTB order expires"
+	flag_PostOnlyDescription        = "Make the order post-only. If it would match a resting order, it is rejected or repriced to rest on the book (REJECT|REPRICE)"
+	flag_SelfTradeDescription       = "Prevent the order from matching resting orders of the same owner (CANCEL_NEWEST|CANCEL_OLDEST|CANCEL_BOTH|DECREMENT_AND_CANCEL)"
+	flag_DisplayDescription         = "Only show this amount of the source denomination on the book at a time, refilling it from the remainder as it fills"
+	flag_InstrumentFeeDescription   = "Fee rates of a pair of denominations overriding the default rates, as source/destination:maker-fee:taker-fee. Can be repeated"
+	flag_SourceDescription          = "Only cancel orders selling this denomination"
+	flag_DestinationDescription     = "Only cancel orders buying this denomination"
+	flag_CancelOrdersDescription    = "Cancel the resting orders of the halted instruments"
+	flag_ReferencePriceDescription  = "Fixed price of the source denomination in the destination denomination to measure trades against. Defaults to the last traded price"
+	flag_MaxBreachesDescription     = "Number of breaches within the breach window that halt the instrument. 0 never halts it"
+	flag_BreachWindowDescription    = "Period in which breaches are counted, such as 10m"
+	flag_HaltDurationDescription    = "Time after which a halt triggered by breaches lifts, such as 1h"
+	flag_AccountLimitDescription    = "Open order limits of an account overriding the default limits, as address:max-open-orders:max-instrument-open-orders. Can be repeated"
+	flag_DisableDescription         = "Return the instrument to continuous matching"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -33,7 +69,17 @@ func GetTxCmd() *cobra.Command {
 		AddLimitOrderCmd(),
 		AddMarketOrderCmd(),
 		CancelOrderCmd(),
+		CancelAllOrdersCmd(),
 		CancelReplaceOrder(),
+		AddStopLimitOrderCmd(),
+		AddStopMarketOrderCmd(),
+		SetFeesCmd(),
+		SetInstrumentRulesCmd(),
+		HaltTradingCmd(),
+		ResumeTradingCmd(),
+		SetPriceBandCmd(),
+		SetOrderLimitsCmd(),
+		SetBatchAuctionCmd(),
 	)
 	return txCmd
 }
@@ -70,12 +116,37 @@ func AddLimitOrderCmd() *cobra.Command {
 				return err
 			}
 
+			expireTime, expireHeight, err := getExpiryFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			postOnly, err := getPostOnlyFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			selfTrade, err := getSelfTradeFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			displayQuantity, err := getDisplayFlag(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgAddLimitOrder{
-				Owner:         clientCtx.GetFromAddress().String(),
-				TimeInForce:   timeInForce,
-				Source:        src,
-				Destination:   dst,
-				ClientOrderId: clientOrderID,
+				Owner:               clientCtx.GetFromAddress().String(),
+				TimeInForce:         timeInForce,
+				Source:              src,
+				Destination:         dst,
+				ClientOrderId:       clientOrderID,
+				ExpireTime:          expireTime,
+				ExpireHeight:        expireHeight,
+				PostOnly:            postOnly,
+				SelfTradePrevention: selfTrade,
+				DisplayQuantity:     displayQuantity,
 			}
 
 			err = msg.ValidateBasic()
@@ -88,6 +159,10 @@ func AddLimitOrderCmd() *cobra.Command {
 	}
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(flag_TimeInForce, "GTC", flag_TimeInForceDescription)
+	addExpiryFlags(cmd)
+	cmd.Flags().String(flag_PostOnly, "", flag_PostOnlyDescription)
+	cmd.Flags().String(flag_SelfTrade, "", flag_SelfTradeDescription)
+	cmd.Flags().String(flag_Display, "", flag_DisplayDescription)
 	return cmd
 }
 
@@ -130,13 +205,19 @@ Example:
 				return err
 			}
 
+			selfTrade, err := getSelfTradeFlag(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgAddMarketOrder{
-				Owner:         clientCtx.GetFromAddress().String(),
-				TimeInForce:   timeInForce,
-				Source:        srcDenom,
-				Destination:   dst,
-				ClientOrderId: clientOrderID,
-				MaxSlippage:   slippage,
+				Owner:               clientCtx.GetFromAddress().String(),
+				TimeInForce:         timeInForce,
+				Source:              srcDenom,
+				Destination:         dst,
+				ClientOrderId:       clientOrderID,
+				MaxSlippage:         slippage,
+				SelfTradePrevention: selfTrade,
 			}
 
 			err = msg.ValidateBasic()
@@ -149,6 +230,7 @@ Example:
 	}
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(flag_TimeInForce, "GTC", flag_TimeInForceDescription)
+	cmd.Flags().String(flag_SelfTrade, "", flag_SelfTradeDescription)
 	return cmd
 
 }
@@ -184,6 +266,53 @@ func CancelOrderCmd() *cobra.Command {
 	return cmd
 }
 
+func CancelAllOrdersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-all",
+		Short: "Cancel all of your orders in the market, optionally filtered by instrument",
+		Long: `Cancel every resting order of the sending account. Use --source and --destination to only cancel the orders of an instrument.
+
+Example:
+ emd tx market cancel-all --from acc1 --source eeur --destination echf
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			source, err := cmd.Flags().GetString(flag_Source)
+			if err != nil {
+				return err
+			}
+
+			destination, err := cmd.Flags().GetString(flag_Destination)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCancelAllOrders{
+				Owner:       clientCtx.GetFromAddress().String(),
+				Source:      source,
+				Destination: destination,
+			}
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flag_Source, "", flag_SourceDescription)
+	cmd.Flags().String(flag_Destination, "", flag_DestinationDescription)
+	return cmd
+}
+
 func CancelReplaceOrder() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "cancelreplace [original-client-order-id] [source-amount] [destination-amount] [client-orderid]",
@@ -218,13 +347,38 @@ func CancelReplaceOrder() *cobra.Command {
 				return err
 			}
 
+			expireTime, expireHeight, err := getExpiryFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			postOnly, err := getPostOnlyFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			selfTrade, err := getSelfTradeFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			displayQuantity, err := getDisplayFlag(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgCancelReplaceLimitOrder{
-				Owner:             clientCtx.GetFromAddress().String(),
-				TimeInForce:       timeInForce,
-				Source:            src,
-				Destination:       dst,
-				OrigClientOrderId: origClientOrderID,
-				NewClientOrderId:  newClientOrderID,
+				Owner:               clientCtx.GetFromAddress().String(),
+				TimeInForce:         timeInForce,
+				Source:              src,
+				Destination:         dst,
+				OrigClientOrderId:   origClientOrderID,
+				NewClientOrderId:    newClientOrderID,
+				ExpireTime:          expireTime,
+				ExpireHeight:        expireHeight,
+				PostOnly:            postOnly,
+				SelfTradePrevention: selfTrade,
+				DisplayQuantity:     displayQuantity,
 			}
 
 			err = msg.ValidateBasic()
@@ -237,6 +391,635 @@ func CancelReplaceOrder() *cobra.Command {
 	}
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(flag_TimeInForce, "GTC", flag_TimeInForceDescription)
+	addExpiryFlags(cmd)
+	cmd.Flags().String(flag_PostOnly, "", flag_PostOnlyDescription)
+	cmd.Flags().String(flag_SelfTrade, "", flag_SelfTradeDescription)
+	cmd.Flags().String(flag_Display, "", flag_DisplayDescription)
+
+	return cmd
+}
+
+func AddStopLimitOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-stop-limit [source-amount] [destination-amount] [stop-price] [client-orderid]",
+		Short: "Create a stop order that sends a limit order to the market once the last price reaches the stop price",
+		Long: `Create a stop order that is triggered when the last traded price of the instrument, expressed as destination per source, falls to or below the stop price.
+
+Example:
+ emd tx market add-stop-limit 100eeur 110echf 1.15 order12345
+`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			src, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return
+			}
+
+			dst, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return
+			}
+
+			stopPrice, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientOrderID := args[3]
+
+			tif, err := cmd.Flags().GetString(flag_TimeInForce)
+			if err != nil {
+				return err
+			}
+			timeInForce, err := types.TimeInForceFromString(tif)
+			if err != nil {
+				return err
+			}
 
+			msg := &types.MsgAddStopOrder{
+				Owner:         clientCtx.GetFromAddress().String(),
+				ClientOrderId: clientOrderID,
+				TimeInForce:   timeInForce,
+				OrderType:     types.StopOrderType_Limit,
+				Source:        src,
+				Destination:   dst,
+				StopPrice:     stopPrice,
+				MaxSlippage:   sdk.ZeroDec(),
+			}
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flag_TimeInForce, "GTC", flag_StopTimeInForceDescription)
 	return cmd
 }
+
+func AddStopMarketOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-stop-market [source-denom] [destination-amount] [market-slippage] [stop-price] [client-orderid]",
+		Short: "Create a stop order that sends a market order to the market once the last price reaches the stop price",
+		Long: `Create a stop order that is triggered when the last traded price of the instrument, expressed as destination per source, falls to or below the stop price.
+
+Example:
+ emd tx market add-stop-market eeur 300echf 0.05 1.15 order12345
+`,
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			srcDenom := args[0]
+
+			dst, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return
+			}
+
+			slippage, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			stopPrice, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			clientOrderID := args[4]
+
+			tif, err := cmd.Flags().GetString(flag_TimeInForce)
+			if err != nil {
+				return err
+			}
+			timeInForce, err := types.TimeInForceFromString(tif)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAddStopOrder{
+				Owner:         clientCtx.GetFromAddress().String(),
+				ClientOrderId: clientOrderID,
+				TimeInForce:   timeInForce,
+				OrderType:     types.StopOrderType_Market,
+				Source:        sdk.NewCoin(srcDenom, sdk.ZeroInt()),
+				Destination:   dst,
+				StopPrice:     stopPrice,
+				MaxSlippage:   slippage,
+			}
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flag_TimeInForce, "GTC", flag_StopTimeInForceDescription)
+	return cmd
+}
+
+func SetFeesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fees [authority_key_or_address] [maker-fee] [taker-fee]",
+		Short: "Set the trading fee rates, in basis points. Requires the authority",
+		Long: `Replace the maker and taker fee rates charged on trades, in basis points of the amount received.
+Fee rates of individual pairs of denominations are given with the --instrument-fee flag and apply to both books of the pair.
+
+Example:
+ emd tx market set-fees masterkey 10 20 --instrument-fee eeur/echf:0:5
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			makerFee, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			takerFee, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			instrumentFeeArgs, err := cmd.Flags().GetStringArray(flag_InstrumentFee)
+			if err != nil {
+				return err
+			}
+
+			var instrumentFees []types.InstrumentFees
+			for _, arg := range instrumentFeeArgs {
+				f, err := parseInstrumentFees(arg)
+				if err != nil {
+					return err
+				}
+				instrumentFees = append(instrumentFees, f)
+			}
+
+			msg := &types.MsgSetFees{
+				Authority:      clientCtx.GetFromAddress().String(),
+				MakerFee:       uint32(makerFee),
+				TakerFee:       uint32(takerFee),
+				InstrumentFees: instrumentFees,
+			}
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().StringArray(flag_InstrumentFee, nil, flag_InstrumentFeeDescription)
+	return cmd
+}
+
+func SetInstrumentRulesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-instrument-rules [authority_key_or_address] [source-denom] [destination-denom] [tick-size] [min-order-size] [lot-size]",
+		Short: "Set the tick size, minimum order size and lot size of an instrument. Requires the authority",
+		Long: `Replace the rules that orders from the source denomination to the destination denomination must follow.
+The minimum order size and the lot size are amounts of the source denomination. A value of 0 disables the rule.
+Setting every value to 0 removes the rules of the instrument.
+
+Example:
+ emd tx market set-instrument-rules masterkey eeur echf 0.0001 1000000 1000
+`,
+		Args: cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tickSize, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			minOrderSize, ok := sdk.NewIntFromString(args[4])
+			if !ok {
+				return fmt.Errorf("invalid minimum order size: %v", args[4])
+			}
+
+			lotSize, ok := sdk.NewIntFromString(args[5])
+			if !ok {
+				return fmt.Errorf("invalid lot size: %v", args[5])
+			}
+
+			msg := &types.MsgSetInstrumentRules{
+				Authority:    clientCtx.GetFromAddress().String(),
+				Source:       args[1],
+				Destination:  args[2],
+				TickSize:     tickSize,
+				MinOrderSize: minOrderSize,
+				LotSize:      lotSize,
+			}
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func HaltTradingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "halt-trading [authority_key_or_address] [denom] [destination-denom]",
+		Short: "Halt trading on an instrument, or on every instrument of a denomination. Requires the authority",
+		Long: `Reject new orders on the instrument between two denominations, in both directions. If only one denomination
+is given, every instrument involving it is halted.
+
+Example:
+ emd tx market halt-trading masterkey eeur echf
+ emd tx market halt-trading masterkey eeur --cancel-orders
+`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cancelOrders, err := cmd.Flags().GetBool(flag_CancelOrders)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgHaltTrading{
+				Authority:    clientCtx.GetFromAddress().String(),
+				Halt:         parseTradingHalt(args[1:]),
+				CancelOrders: cancelOrders,
+			}
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Bool(flag_CancelOrders, false, flag_CancelOrdersDescription)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func ResumeTradingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-trading [authority_key_or_address] [denom] [destination-denom]",
+		Short: "Lift a trading halt. Requires the authority",
+		Long: `Lift the halt of the instrument between two denominations, or of every instrument involving a single denomination.
+Instruments covered by another halt remain halted.
+
+Example:
+ emd tx market resume-trading masterkey eeur echf
+`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgResumeTrading{
+				Authority: clientCtx.GetFromAddress().String(),
+				Halt:      parseTradingHalt(args[1:]),
+			}
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func SetPriceBandCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-price-band [authority_key_or_address] [source-denom] [destination-denom] [max-deviation]",
+		Short: "Limit how far trades of an instrument may move from a reference price. Requires the authority",
+		Long: `Replace the price band of the instrument between two denominations, in both directions. Matching stops for an
+order that would trade further from the reference price than the maximum deviation, such as 0.1 for 10%.
+Repeated breaches halt the instrument temporarily. A maximum deviation of 0 removes the band.
+
+Example:
+ emd tx market set-price-band masterkey eeur echf 0.05
+ emd tx market set-price-band masterkey eeur echf 0.05 --max-breaches 3 --breach-window 10m --halt-duration 1h
+`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxDeviation, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			referencePrice := sdk.ZeroDec()
+			if s, _ := cmd.Flags().GetString(flag_ReferencePrice); s != "" {
+				referencePrice, err = sdk.NewDecFromStr(s)
+				if err != nil {
+					return err
+				}
+			}
+
+			maxBreaches, err := cmd.Flags().GetUint32(flag_MaxBreaches)
+			if err != nil {
+				return err
+			}
+
+			breachWindow, err := cmd.Flags().GetDuration(flag_BreachWindow)
+			if err != nil {
+				return err
+			}
+
+			haltDuration, err := cmd.Flags().GetDuration(flag_HaltDuration)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetPriceBand{
+				Authority: clientCtx.GetFromAddress().String(),
+				Band: types.NewPriceBand(
+					args[1], args[2], maxDeviation, referencePrice, maxBreaches, breachWindow, haltDuration,
+				),
+			}
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(flag_ReferencePrice, "", flag_ReferencePriceDescription)
+	cmd.Flags().Uint32(flag_MaxBreaches, 0, flag_MaxBreachesDescription)
+	cmd.Flags().Duration(flag_BreachWindow, 0, flag_BreachWindowDescription)
+	cmd.Flags().Duration(flag_HaltDuration, 0, flag_HaltDurationDescription)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func SetOrderLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-order-limits [authority_key_or_address] [max-open-orders] [max-instrument-open-orders]",
+		Short: "Set the maximum number of resting orders of an account. Requires the authority",
+		Long: `Replace the maximum number of resting orders an account may have in total and in a single instrument. A value of 0
+imposes no limit. Limits of individual accounts, such as market makers, are given with the --account-limit flag.
+
+Example:
+ emd tx market set-order-limits masterkey 200 50 --account-limit emoney1...:1000:500
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxOpenOrders, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			maxInstrumentOpenOrders, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			accountLimitArgs, err := cmd.Flags().GetStringArray(flag_AccountLimit)
+			if err != nil {
+				return err
+			}
+
+			var accountLimits []types.AccountOrderLimits
+			for _, arg := range accountLimitArgs {
+				l, err := parseAccountOrderLimits(arg)
+				if err != nil {
+					return err
+				}
+				accountLimits = append(accountLimits, l)
+			}
+
+			msg := &types.MsgSetOrderLimits{
+				Authority:               clientCtx.GetFromAddress().String(),
+				MaxOpenOrders:           uint32(maxOpenOrders),
+				MaxInstrumentOpenOrders: uint32(maxInstrumentOpenOrders),
+				AccountOrderLimits:      accountLimits,
+			}
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().StringArray(flag_AccountLimit, nil, flag_AccountLimitDescription)
+	return cmd
+}
+
+func SetBatchAuctionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-batch-auction [authority_key_or_address] [source-denom] [destination-denom]",
+		Short: "Clear an instrument in batch auctions at the end of each block. Requires the authority",
+		Long: `Stop matching the orders of the instrument between two denominations on arrival. Its orders are collected
+during the block and cleared together at a single price at the end of it. Use --disable to return the instrument to
+continuous matching.
+
+Example:
+ emd tx market set-batch-auction masterkey eeur echf
+ emd tx market set-batch-auction masterkey eeur echf --disable
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			disable, err := cmd.Flags().GetBool(flag_Disable)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetBatchAuction{
+				Authority: clientCtx.GetFromAddress().String(),
+				Auction:   types.NewBatchAuction(args[1], args[2]),
+				Enabled:   !disable,
+			}
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Bool(flag_Disable, false, flag_DisableDescription)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// A single denomination halts all of its instruments, two denominations halt the instrument between them.
+func parseTradingHalt(denoms []string) types.TradingHalt {
+	if len(denoms) == 1 {
+		return types.NewDenomHalt(denoms[0])
+	}
+
+	return types.NewInstrumentHalt(denoms[0], denoms[1])
+}
+
+// Parse fee rates given as source/destination:maker-fee:taker-fee
+func parseInstrumentFees(s string) (types.InstrumentFees, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return types.InstrumentFees{}, fmt.Errorf("invalid instrument fee: %v", s)
+	}
+
+	denoms := strings.Split(parts[0], "/")
+	if len(denoms) != 2 {
+		return types.InstrumentFees{}, fmt.Errorf("invalid instrument: %v", parts[0])
+	}
+
+	makerFee, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return types.InstrumentFees{}, err
+	}
+
+	takerFee, err := strconv.ParseUint(parts[2], 10, 32)
+	if err != nil {
+		return types.InstrumentFees{}, err
+	}
+
+	return types.InstrumentFees{
+		Source:      denoms[0],
+		Destination: denoms[1],
+		MakerFee:    uint32(makerFee),
+		TakerFee:    uint32(takerFee),
+	}, nil
+}
+
+func addExpiryFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flag_ExpireTime, "", flag_ExpireTimeDescription)
+	cmd.Flags().Int64(flag_ExpireHeight, 0, flag_ExpireHeightDescription)
+}
+
+func getExpiryFlags(cmd *cobra.Command) (*time.Time, int64, error) {
+	expireHeight, err := cmd.Flags().GetInt64(flag_ExpireHeight)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	tm, err := cmd.Flags().GetString(flag_ExpireTime)
+	if err != nil || tm == "" {
+		return nil, expireHeight, err
+	}
+
+	expireTime, err := time.Parse(time.RFC3339, tm)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return &expireTime, expireHeight, nil
+}
+
+func getPostOnlyFlag(cmd *cobra.Command) (types.PostOnlyMode, error) {
+	postOnly, err := cmd.Flags().GetString(flag_PostOnly)
+	if err != nil {
+		return types.PostOnlyMode_None, err
+	}
+
+	return types.PostOnlyModeFromString(postOnly)
+}
+
+func getSelfTradeFlag(cmd *cobra.Command) (types.SelfTradePrevention, error) {
+	selfTrade, err := cmd.Flags().GetString(flag_SelfTrade)
+	if err != nil {
+		return types.SelfTradePrevention_None, err
+	}
+
+	return types.SelfTradePreventionFromString(selfTrade)
+}
+
+func getDisplayFlag(cmd *cobra.Command) (sdk.Int, error) {
+	display, err := cmd.Flags().GetString(flag_Display)
+	if err != nil || display == "" {
+		return sdk.ZeroInt(), err
+	}
+
+	amount, ok := sdk.NewIntFromString(display)
+	if !ok {
+		return sdk.ZeroInt(), fmt.Errorf("invalid display quantity: %v", display)
+	}
+
+	return amount, nil
+}
+
+// Parse open order limits given as address:max-open-orders:max-instrument-open-orders
+func parseAccountOrderLimits(s string) (types.AccountOrderLimits, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return types.AccountOrderLimits{}, fmt.Errorf("invalid account limit: %v", s)
+	}
+
+	maxOpenOrders, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return types.AccountOrderLimits{}, err
+	}
+
+	maxInstrumentOpenOrders, err := strconv.ParseUint(parts[2], 10, 32)
+	if err != nil {
+		return types.AccountOrderLimits{}, err
+	}
+
+	return types.AccountOrderLimits{
+		Account:                 parts[0],
+		MaxOpenOrders:           uint32(maxOpenOrders),
+		MaxInstrumentOpenOrders: uint32(maxInstrumentOpenOrders),
+	}, nil
+}
@@ -0,0 +1,29 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package market
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+func DefaultGenesisState() *types.GenesisState {
+	return types.DefaultGenesisState()
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data types.GenesisState) error {
+	return data.Validate()
+}
+
+func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState) {
+	keeper.InitGenesis(ctx, data)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper *Keeper) types.GenesisState {
+	return keeper.ExportGenesis(ctx)
+}
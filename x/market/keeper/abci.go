@@ -0,0 +1,56 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+func BeginBlocker(ctx sdk.Context, k *Keeper) {
+	k.expireOrders(ctx)
+	k.liftExpiredHalts(ctx)
+}
+
+func EndBlocker(ctx sdk.Context, k *Keeper) {
+	k.clearBatchAuctions(ctx)
+
+	// Send the stop orders triggered by the auctions, and those left queued by the transactions of the block.
+	k.executeTriggeredStopOrders(ctx, maxTriggeredStopOrdersPerBlock)
+}
+
+// Remove all GoodTillTime and GoodTillBlock orders that have reached their expiry.
+func (k *Keeper) expireOrders(ctx sdk.Context) {
+	idxStore := ctx.KVStore(k.keyIndices)
+
+	var ownerKeys [][]byte
+
+	// Collect the due orders before modifying the store
+	timeEnd := sdk.PrefixEndBytes(types.GetExpireTimeKeyByTime(ctx.BlockTime()))
+	heightEnd := sdk.PrefixEndBytes(types.GetExpireHeightKeyByHeight(ctx.BlockHeight()))
+	for _, it := range []sdk.Iterator{
+		idxStore.Iterator(types.GetExpireTimePrefix(), timeEnd),
+		idxStore.Iterator(types.GetExpireHeightPrefix(), heightEnd),
+	} {
+		for ; it.Valid(); it.Next() {
+			ownerKeys = append(ownerKeys, it.Value())
+		}
+		it.Close()
+	}
+
+	store := ctx.KVStore(k.key)
+	for _, ownerKey := range ownerKeys {
+		bz := store.Get(ownerKey)
+		if bz == nil {
+			continue
+		}
+
+		order := new(types.Order)
+		k.cdc.MustUnmarshalBinaryBare(bz, order)
+
+		k.deleteOrder(ctx, order)
+		types.EmitExpireEvent(ctx, *order)
+	}
+}
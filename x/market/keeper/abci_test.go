@@ -0,0 +1,139 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoodTillTimeExpiry(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	expireTime := ctx.BlockTime().Add(time.Hour)
+	o := expiringOrder(ctx, acc1, "100eur", "120usd", types.TimeInForce_GoodTillTime, &expireTime, 0)
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 1)
+
+	// Not yet expired
+	ctx = ctx.WithBlockTime(expireTime.Add(-time.Second)).WithEventManager(sdk.NewEventManager())
+	BeginBlocker(ctx, k)
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 1)
+	require.False(t, findEventAttr(ctx, "expire"))
+
+	ctx = ctx.WithBlockTime(expireTime).WithEventManager(sdk.NewEventManager())
+	BeginBlocker(ctx, k)
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+	require.Nil(t, k.getBestOrder(ctx, "eur", "usd"))
+	require.True(t, findEventAttr(ctx, "expire"))
+
+	// The expiry index is emptied
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.keyIndices), types.GetExpireTimePrefix())
+	require.False(t, it.Valid())
+	it.Close()
+}
+
+func TestGoodTillBlockExpiry(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	ctx = ctx.WithBlockHeight(10)
+
+	gtb := expiringOrder(ctx, acc1, "100eur", "120usd", types.TimeInForce_GoodTillBlock, nil, 12)
+	require.NoError(t, k.NewOrderSingle(ctx, gtb))
+	gtc := order(ctx.BlockTime(), acc1, "100eur", "130usd")
+	require.NoError(t, k.NewOrderSingle(ctx, gtc))
+
+	BeginBlocker(ctx.WithBlockHeight(11), k)
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 2)
+
+	BeginBlocker(ctx.WithBlockHeight(12), k)
+	orders := k.GetOrdersByOwner(ctx, acc1.GetAddress())
+	require.Len(t, orders, 1)
+	require.Equal(t, gtc.ClientOrderID, orders[0].ClientOrderID)
+}
+
+func TestExpiredOrderRejected(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	ctx = ctx.WithBlockHeight(10)
+
+	expireTime := ctx.BlockTime()
+	o := expiringOrder(ctx, acc1, "100eur", "120usd", types.TimeInForce_GoodTillTime, &expireTime, 0)
+	require.True(t, types.ErrInvalidExpiry.Is(k.NewOrderSingle(ctx, o)))
+
+	o = expiringOrder(ctx, acc1, "100eur", "120usd", types.TimeInForce_GoodTillBlock, nil, 10)
+	require.True(t, types.ErrInvalidExpiry.Is(k.NewOrderSingle(ctx, o)))
+
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+}
+
+func TestExpiryOfPartiallyFilledOrder(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	expireTime := ctx.BlockTime().Add(time.Minute)
+	o := expiringOrder(ctx, acc1, "100eur", "120usd", types.TimeInForce_GoodTillTime, &expireTime, 0)
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "60usd", "50eur")))
+
+	orders := k.GetOrdersByOwner(ctx, acc1.GetAddress())
+	require.Len(t, orders, 1)
+	require.Equal(t, int64(50), orders[0].SourceRemaining.Int64())
+
+	BeginBlocker(ctx.WithBlockTime(expireTime), k)
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+}
+
+func TestCancelReplaceKeepsExpiry(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	expireTime := ctx.BlockTime().Add(time.Hour)
+	o := expiringOrder(ctx, acc1, "100eur", "120usd", types.TimeInForce_GoodTillTime, &expireTime, 0)
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+
+	// Replace without an expiry keeps the original one
+	replacement := order(ctx.BlockTime(), acc1, "100eur", "125usd")
+	_, err := k.CancelReplaceLimitOrder(ctx, replacement, o.ClientOrderID)
+	require.NoError(t, err)
+
+	orders := k.GetOrdersByOwner(ctx, acc1.GetAddress())
+	require.Len(t, orders, 1)
+	require.Equal(t, types.TimeInForce_GoodTillTime, orders[0].TimeInForce)
+	require.True(t, expireTime.Equal(*orders[0].ExpireTime))
+
+	// Replace with a new expiry
+	newExpireTime := expireTime.Add(time.Hour)
+	replacement2 := expiringOrder(ctx, acc1, "100eur", "130usd", types.TimeInForce_GoodTillTime, &newExpireTime, 0)
+	_, err = k.CancelReplaceLimitOrder(ctx, replacement2, replacement.ClientOrderID)
+	require.NoError(t, err)
+
+	BeginBlocker(ctx.WithBlockTime(expireTime), k)
+	orders = k.GetOrdersByOwner(ctx, acc1.GetAddress())
+	require.Len(t, orders, 1)
+	require.True(t, newExpireTime.Equal(*orders[0].ExpireTime))
+
+	BeginBlocker(ctx.WithBlockTime(newExpireTime), k)
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+}
+
+func expiringOrder(ctx sdk.Context, account authtypes.AccountI, src, dst string, tif types.TimeInForce, expireTime *time.Time, expireHeight int64) types.Order {
+	o, err := types.NewOrderWithExpiry(
+		ctx.BlockTime(), tif, coin(src), coin(dst), account.GetAddress(), cid(), expireTime, expireHeight,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return o
+}
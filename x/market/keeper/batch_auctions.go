@@ -0,0 +1,389 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// SetBatchAuction switches an instrument between batch auctions and continuous matching on behalf of the authority.
+// The orders collected since the last auction are cleared before an instrument returns to continuous matching.
+func (k *Keeper) SetBatchAuction(ctx sdk.Context, authority sdk.AccAddress, auction types.BatchAuction, enabled bool) error {
+	if err := k.authority.ValidateAuthority(ctx, authority); err != nil {
+		return err
+	}
+
+	if err := auction.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidBatchAuction, err.Error())
+	}
+
+	if enabled {
+		k.setBatchAuction(ctx, auction)
+		return nil
+	}
+
+	if k.IsBatchAuction(ctx, auction.Source, auction.Destination) {
+		k.clearBatchAuction(ctx, auction)
+		ctx.KVStore(k.key).Delete(types.GetBatchAuctionKey(auction.Source, auction.Destination))
+		k.executeTriggeredStopOrders(ctx, maxTriggeredStopOrdersPerTx)
+	}
+
+	return nil
+}
+
+// IsBatchAuction reports whether the instrument from src to dst is cleared in batch auctions.
+func (k *Keeper) IsBatchAuction(ctx sdk.Context, src, dst string) bool {
+	return ctx.KVStore(k.key).Has(types.GetBatchAuctionKey(src, dst))
+}
+
+func (k *Keeper) GetAllBatchAuctions(ctx sdk.Context) []types.BatchAuction {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.GetBatchAuctionPrefix())
+	defer it.Close()
+
+	res := make([]types.BatchAuction, 0)
+	for ; it.Valid(); it.Next() {
+		var auction types.BatchAuction
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &auction)
+		res = append(res, auction)
+	}
+
+	return res
+}
+
+func (k *Keeper) setBatchAuction(ctx sdk.Context, auction types.BatchAuction) {
+	ctx.KVStore(k.key).Set(types.GetBatchAuctionKey(auction.Source, auction.Destination), k.cdc.MustMarshalBinaryBare(&auction))
+}
+
+// Clear the orders collected by every batch auction instrument during the block.
+func (k *Keeper) clearBatchAuctions(ctx sdk.Context) {
+	for _, auction := range k.GetAllBatchAuctions(ctx) {
+		k.clearBatchAuction(ctx, auction)
+	}
+}
+
+// Match the crossing orders of the instrument at a single clearing price. ImmediateOrCancel orders only take part in
+// one auction, so their remainder expires afterwards.
+func (k *Keeper) clearBatchAuction(ctx sdk.Context, auction types.BatchAuction) {
+	defer k.expireImmediateOrders(ctx, auction)
+
+	if k.IsTradingHalted(ctx, auction.Source, auction.Destination) {
+		return
+	}
+
+	// Prices are expressed as auction destination per auction source. Sells offer the auction source, buys bid for it.
+	bestSell := k.getBestInstrumentOrder(ctx, auction.Source, auction.Destination)
+	bestBuy := k.getBestInstrumentOrder(ctx, auction.Destination, auction.Source)
+	if bestSell == nil || bestBuy == nil {
+		return
+	}
+
+	sells := k.crossingOrders(ctx, auction.Source, auction.Destination, bestBuy)
+	buys := k.crossingOrders(ctx, auction.Destination, auction.Source, bestSell)
+	if len(sells) == 0 || len(buys) == 0 {
+		return
+	}
+
+	var lastPrice *sdk.Dec
+	if md := k.GetInstrument(ctx, auction.Source, auction.Destination); md != nil {
+		lastPrice = md.LastPrice
+	}
+
+	price, volume := auctionClearingPrice(sells, buys, lastPrice)
+	if !volume.IsPositive() {
+		return
+	}
+
+	if band := k.GetPriceBand(ctx, auction.Source, auction.Destination); band != nil {
+		if band.Breached(auction.Source, price, k.priceBandReferencePrice(ctx, *band)) {
+			k.recordPriceBandBreach(ctx, *band)
+			return
+		}
+	}
+
+	sellFills := allocateAuctionFills(sells, volume, price, sellCapacity)
+	buyFills := allocateAuctionFills(buys, volume, price, buyCapacity)
+
+	params := k.GetParams(ctx)
+	settled := false
+	for i, j := 0, 0; i < len(sells) && j < len(buys); {
+		quantity := sdk.MinInt(sellFills[i], buyFills[j])
+		if quantity.IsPositive() {
+			settled = k.settleAuctionMatch(ctx, params, sells[i], buys[j], quantity, price) || settled
+		}
+
+		sellFills[i] = sellFills[i].Sub(quantity)
+		buyFills[j] = buyFills[j].Sub(quantity)
+		if !sellFills[i].IsPositive() {
+			i++
+		}
+		if !buyFills[j].IsPositive() {
+			j++
+		}
+	}
+
+	if !settled {
+		return
+	}
+
+	k.setMarketData(ctx, auction.Source, auction.Destination, price)
+	k.setMarketData(ctx, auction.Destination, auction.Source, sdk.OneDec().Quo(price))
+}
+
+// Returns the best resting order from src to dst, or nil if that side of the instrument is empty.
+func (k *Keeper) getBestInstrumentOrder(ctx sdk.Context, src, dst string) *types.Order {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.keyIndices), types.GetPriorityKeyByInstrument(src, dst))
+	defer it.Close()
+
+	if !it.Valid() {
+		return nil
+	}
+
+	o := new(types.Order)
+	k.cdc.MustUnmarshalBinaryBare(it.Value(), o)
+	return o
+}
+
+// Returns the resting orders from src to dst that are willing to trade with the best order of the opposite side, in
+// priority order.
+func (k *Keeper) crossingOrders(ctx sdk.Context, src, dst string, best *types.Order) []*types.Order {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.keyIndices), types.GetPriorityKeyByInstrument(src, dst))
+	defer it.Close()
+
+	var res []*types.Order
+	for ; it.Valid(); it.Next() {
+		o := new(types.Order)
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), o)
+
+		// The order asks at least o.Destination/o.Source, the best order of the other side bids at most best.Source/best.Destination.
+		if o.Destination.Amount.Mul(best.Destination.Amount).GT(o.Source.Amount.Mul(best.Source.Amount)) {
+			break
+		}
+		res = append(res, o)
+	}
+
+	return res
+}
+
+// Returns the price that executes the most volume, measured in the auction source. Ties go to the price that leaves
+// the smallest surplus on either side, then to the price closest to the last price of the instrument and finally to
+// the lowest price. The candidates are the limit prices of the crossing orders, rounded in favour of their owners.
+func auctionClearingPrice(sells, buys []*types.Order, lastPrice *sdk.Dec) (sdk.Dec, sdk.Int) {
+	var candidates []sdk.Dec
+	for _, o := range sells {
+		candidates = append(candidates, o.Destination.Amount.ToDec().QuoRoundUp(o.Source.Amount.ToDec()))
+	}
+	for _, o := range buys {
+		candidates = append(candidates, o.Source.Amount.ToDec().QuoTruncate(o.Destination.Amount.ToDec()))
+	}
+
+	var (
+		bestPrice     = sdk.ZeroDec()
+		bestVolume    = sdk.ZeroInt()
+		bestImbalance = sdk.ZeroInt()
+	)
+	for _, price := range candidates {
+		if !price.IsPositive() {
+			continue
+		}
+
+		supply, demand := sdk.ZeroInt(), sdk.ZeroInt()
+		for _, o := range sells {
+			supply = supply.Add(sellCapacity(o, price))
+		}
+		for _, o := range buys {
+			demand = demand.Add(buyCapacity(o, price))
+		}
+
+		volume := sdk.MinInt(supply, demand)
+		imbalance := supply.Sub(demand)
+		if imbalance.IsNegative() {
+			imbalance = imbalance.Neg()
+		}
+
+		switch {
+		case !volume.IsPositive() || volume.LT(bestVolume):
+			continue
+		case volume.Equal(bestVolume):
+			if imbalance.GT(bestImbalance) || (imbalance.Equal(bestImbalance) && !closerToLastPrice(price, bestPrice, lastPrice)) {
+				continue
+			}
+		}
+
+		bestPrice, bestVolume, bestImbalance = price, volume, imbalance
+	}
+
+	return bestPrice, bestVolume
+}
+
+// Reports whether price is a better clearing price than other with respect to the last price of the instrument.
+func closerToLastPrice(price, other sdk.Dec, lastPrice *sdk.Dec) bool {
+	if lastPrice != nil {
+		distance, otherDistance := price.Sub(*lastPrice).Abs(), other.Sub(*lastPrice).Abs()
+		if !distance.Equal(otherDistance) {
+			return distance.LT(otherDistance)
+		}
+	}
+
+	return price.LT(other)
+}
+
+// The amount of auction source an order selling it can deliver at price without selling below its limit price or
+// receiving more than it asks for.
+func sellCapacity(o *types.Order, price sdk.Dec) sdk.Int {
+	if price.MulInt(o.Source.Amount).LT(o.Destination.Amount.ToDec()) {
+		return sdk.ZeroInt()
+	}
+
+	destinationRemaining := o.Destination.Amount.Sub(o.DestinationFilled)
+	return sdk.MinInt(o.SourceRemaining, destinationRemaining.ToDec().QuoTruncate(price).TruncateInt())
+}
+
+// The amount of auction source an order buying it can pay for at price without paying above its limit price or
+// receiving more than it asks for.
+func buyCapacity(o *types.Order, price sdk.Dec) sdk.Int {
+	if price.MulInt(o.Destination.Amount).GT(o.Source.Amount.ToDec()) {
+		return sdk.ZeroInt()
+	}
+
+	destinationRemaining := o.Destination.Amount.Sub(o.DestinationFilled)
+	return sdk.MinInt(o.SourceRemaining.ToDec().QuoTruncate(price).TruncateInt(), destinationRemaining)
+}
+
+// Distribute volume over the orders of one side in priority order.
+func allocateAuctionFills(orders []*types.Order, volume sdk.Int, price sdk.Dec, capacity func(*types.Order, sdk.Dec) sdk.Int) []sdk.Int {
+	fills := make([]sdk.Int, len(orders))
+	for i, o := range orders {
+		fills[i] = sdk.MinInt(capacity(o, price), volume)
+		volume = volume.Sub(fills[i])
+	}
+
+	return fills
+}
+
+// Settle a match of the auction in isolation, so a match that cannot be settled is skipped instead of halting the
+// chain from EndBlock. The orders and the store are only updated when the match settles. Reports whether a trade took
+// place.
+func (k *Keeper) settleAuctionMatch(ctx sdk.Context, params types.Params, sell, buy *types.Order, quantity sdk.Int, price sdk.Dec) bool {
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	sellCopy, buyCopy := *sell, *buy
+	traded, err := k.settleAuctionTrade(cacheCtx, params, &sellCopy, &buyCopy, quantity, price)
+	if err != nil {
+		ctx.Logger().Error("skipping auction match that failed to settle", "sell", sell.ID, "buy", buy.ID, "err", err)
+		types.EmitAuctionSettlementFailedEvent(ctx, *sell, *buy, err)
+		return false
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	*sell, *buy = sellCopy, buyCopy
+	return traded
+}
+
+// Settle quantity of the auction source between an order selling it and an order buying it at the clearing price. The
+// order that was accepted first is the maker of the trade. Reports whether a trade took place.
+func (k *Keeper) settleAuctionTrade(ctx sdk.Context, params types.Params, sell, buy *types.Order, quantity sdk.Int, price sdk.Dec) (bool, error) {
+	payment := price.MulInt(quantity).TruncateInt()
+	if !payment.IsPositive() {
+		// The trade would transfer less than one token of the auction destination.
+		return false, nil
+	}
+
+	sell.SourceRemaining = sell.SourceRemaining.Sub(quantity)
+	sell.FillSlice(quantity)
+	sell.SourceFilled = sell.SourceFilled.Add(quantity)
+	sell.DestinationFilled = sell.DestinationFilled.Add(payment)
+
+	buy.SourceRemaining = buy.SourceRemaining.Sub(payment)
+	buy.FillSlice(payment)
+	buy.SourceFilled = buy.SourceFilled.Add(payment)
+	buy.DestinationFilled = buy.DestinationFilled.Add(quantity)
+
+	// Invariant checks
+	if sell.SourceRemaining.IsNegative() || buy.SourceRemaining.IsNegative() {
+		return false, fmt.Errorf("auction order's SourceRemaining field is less than zero. orders: %v %v", sell, buy)
+	}
+	if sell.DestinationFilled.GT(sell.Destination.Amount) || buy.DestinationFilled.GT(buy.Destination.Amount) {
+		return false, fmt.Errorf("auction order's DestinationFilled field is greater than Destination.Amount. orders: %v %v", sell, buy)
+	}
+
+	maker, taker := sell, buy
+	makerSourceFilled := sdk.NewCoin(sell.Source.Denom, quantity)
+	makerDestinationFilled := sdk.NewCoin(sell.Destination.Denom, payment)
+	makerPrice := price
+	if buy.ID < sell.ID {
+		maker, taker = buy, sell
+		makerSourceFilled, makerDestinationFilled = makerDestinationFilled, makerSourceFilled
+		makerPrice = sdk.OneDec().Quo(price)
+	}
+
+	makerFeeRate, takerFeeRate := params.FeeRates(maker.Source.Denom, maker.Destination.Denom)
+	makerFee := types.TradingFee(makerDestinationFilled.Amount, makerFeeRate)
+	takerFee := types.TradingFee(makerSourceFilled.Amount, takerFeeRate)
+
+	// Store the orders before settling, so their owners' other orders are adjusted to the filled amounts.
+	for _, o := range []*types.Order{maker, taker} {
+		switch {
+		case o.IsFilled():
+			k.deleteOrder(ctx, o)
+		case o.IsSliceFilled():
+			k.refillSlice(ctx, o)
+		default:
+			k.setOrder(ctx, o)
+		}
+	}
+
+	if err := k.transferTradedAmounts(ctx, makerDestinationFilled, makerSourceFilled, maker.Owner, taker.Owner, makerFee, takerFee); err != nil {
+		return false, err
+	}
+
+	trade := types.NewTrade(*maker, *taker, makerSourceFilled, makerDestinationFilled, ctx.BlockTime(), ctx.BlockHeight())
+	trade.Price = makerPrice
+	k.logTrade(ctx, trade)
+
+	types.EmitFillEvent(ctx, *maker, false, makerSourceFilled.Amount, makerDestinationFilled.Amount, makerFee)
+	types.EmitFillEvent(ctx, *taker, true, makerDestinationFilled.Amount, makerSourceFilled.Amount, takerFee)
+	for _, o := range []*types.Order{maker, taker} {
+		if o.IsFilled() {
+			types.EmitExpireEvent(ctx, *o)
+		}
+	}
+
+	k.updateCandles(ctx, sell.Source.Denom, sell.Destination.Denom, price, quantity)
+	k.updateCandles(ctx, buy.Source.Denom, buy.Destination.Denom, sdk.OneDec().Quo(price), payment)
+	return true, nil
+}
+
+// Remove the ImmediateOrCancel orders of the instrument, which have had their chance in the auction.
+func (k *Keeper) expireImmediateOrders(ctx sdk.Context, auction types.BatchAuction) {
+	var orders []*types.Order
+
+	for _, key := range [][]byte{
+		types.GetPriorityKeyByInstrument(auction.Source, auction.Destination),
+		types.GetPriorityKeyByInstrument(auction.Destination, auction.Source),
+	} {
+		it := sdk.KVStorePrefixIterator(ctx.KVStore(k.keyIndices), key)
+		for ; it.Valid(); it.Next() {
+			o := new(types.Order)
+			k.cdc.MustUnmarshalBinaryBare(it.Value(), o)
+
+			if o.TimeInForce == types.TimeInForce_ImmediateOrCancel {
+				orders = append(orders, o)
+			}
+		}
+		it.Close()
+	}
+
+	// Orders are removed once the iterators are closed, as deleting keys while iterating is not supported.
+	for _, o := range orders {
+		types.EmitExpireEvent(ctx, *o)
+		k.deleteOrder(ctx, o)
+	}
+}
@@ -0,0 +1,215 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchAuctionUniformPrice(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "100eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "100eur")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "120usd")
+	acc4 := createAccount(ctx, ak, bk, randomAddress(), "105usd")
+	acc5 := createAccount(ctx, ak, bk, randomAddress(), "50usd")
+
+	require.NoError(t, k.SetBatchAuction(ctx, testAuthority, types.NewBatchAuction("eur", "usd"), true))
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "100usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100eur", "130usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "120usd", "100eur")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc4, "105usd", "100eur")))
+
+	// Immediate orders wait for the auction
+	ioc, err := types.NewOrder(ctx.BlockTime(), types.TimeInForce_ImmediateOrCancel, coin("50usd"), coin("100eur"), acc5.GetAddress(), cid())
+	require.NoError(t, err)
+	require.NoError(t, k.NewOrderSingle(ctx, ioc))
+
+	// Crossing orders are collected until the end of the block
+	require.Len(t, k.GetAllOrders(ctx), 5)
+	require.Empty(t, k.GetAllTrades(ctx))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, k)
+	require.True(t, findEventAttr(ctx, "fill"))
+
+	// 1.0 usd per eur executes the most volume. The buyer bidding 1.2 pays the clearing price as well.
+	require.Equal(t, "100usd", bk.GetAllBalances(ctx, acc1.GetAddress()).String())
+	require.Equal(t, "100eur,20usd", bk.GetAllBalances(ctx, acc3.GetAddress()).String())
+	require.Equal(t, "105usd", bk.GetAllBalances(ctx, acc4.GetAddress()).String())
+
+	trades := k.GetAllTrades(ctx)
+	require.Len(t, trades, 1)
+	require.Equal(t, acc1.GetAddress().String(), trades[0].Maker)
+	require.Equal(t, sdk.OneDec(), trades[0].Price)
+
+	md := k.GetInstrument(ctx, "eur", "usd")
+	require.NotNil(t, md.LastPrice)
+	require.Equal(t, sdk.OneDec(), *md.LastPrice)
+
+	// The immediate order expired after taking part in the auction
+	require.Nil(t, k.GetOrderByOwnerAndClientOrderId(ctx, acc5.GetAddress().String(), ioc.ClientOrderID))
+	require.Len(t, k.GetAllOrders(ctx), 2)
+
+	msg, broken := AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestBatchAuctionRejectedOrders(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000chf")
+
+	require.NoError(t, k.SetBatchAuction(ctx, testAuthority, types.NewBatchAuction("eur", "usd"), true))
+
+	fok, err := types.NewOrder(ctx.BlockTime(), types.TimeInForce_FillOrKill, coin("100eur"), coin("120usd"), acc1.GetAddress(), cid())
+	require.NoError(t, err)
+	require.ErrorIs(t, k.NewOrderSingle(ctx, fok), types.ErrNotSupportedInBatchAuction)
+
+	postOnly := order(ctx.BlockTime(), acc1, "100eur", "120usd")
+	postOnly.PostOnly = types.PostOnlyMode_Reject
+	require.ErrorIs(t, k.NewOrderSingle(ctx, postOnly), types.ErrNotSupportedInBatchAuction)
+
+	// Resting orders of an auction instrument are not part of synthetic routes
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "100usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100chf", "100usd")))
+	require.Nil(t, k.GetBestPrice(ctx, "chf", "eur"))
+}
+
+func TestDisableBatchAuction(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	auction := types.NewBatchAuction("eur", "usd")
+	require.NoError(t, k.SetBatchAuction(ctx, testAuthority, auction, true))
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "120usd", "100eur")))
+	require.Len(t, k.GetAllOrders(ctx), 2)
+
+	// The collected orders are cleared before the instrument returns to continuous matching
+	require.NoError(t, k.SetBatchAuction(ctx, testAuthority, auction, false))
+	require.False(t, k.IsBatchAuction(ctx, "usd", "eur"))
+	require.Empty(t, k.GetAllOrders(ctx))
+	require.Len(t, k.GetAllTrades(ctx), 1)
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "120usd", "100eur")))
+	require.Empty(t, k.GetAllOrders(ctx))
+	require.Len(t, k.GetAllTrades(ctx), 2)
+}
+
+func TestBatchAuctionSkipsFailedSettlement(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "100eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "100eur")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "200usd")
+
+	require.NoError(t, k.SetBatchAuction(ctx, testAuthority, types.NewBatchAuction("eur", "usd"), true))
+
+	o1 := order(ctx.BlockTime(), acc1, "100eur", "100usd")
+	require.NoError(t, k.NewOrderSingle(ctx, o1))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100eur", "100usd")))
+	o3 := order(ctx.BlockTime(), acc3, "200usd", "200eur")
+	require.NoError(t, k.NewOrderSingle(ctx, o3))
+
+	// Setting the balance directly bypasses the balance listener, so the first sell order can no longer be settled
+	require.NoError(t, bk.SetBalances(ctx, acc1.GetAddress(), sdk.NewCoins()))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NotPanics(t, func() { EndBlocker(ctx, k) })
+	require.True(t, findEventAttr(ctx, "auction_settlement_failed"))
+
+	// The failed match left the first sell order untouched, the second one still traded
+	stored := k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), o1.ClientOrderID)
+	require.NotNil(t, stored)
+	require.True(t, stored.SourceFilled.IsZero())
+
+	stored = k.GetOrderByOwnerAndClientOrderId(ctx, acc3.GetAddress().String(), o3.ClientOrderID)
+	require.NotNil(t, stored)
+	require.Equal(t, sdk.NewInt(100), stored.SourceRemaining)
+
+	require.Equal(t, "100usd", bk.GetAllBalances(ctx, acc2.GetAddress()).String())
+	require.Equal(t, "100eur,100usd", bk.GetAllBalances(ctx, acc3.GetAddress()).String())
+
+	trades := k.GetAllTrades(ctx)
+	require.Len(t, trades, 1)
+	require.Equal(t, acc2.GetAddress().String(), trades[0].Maker)
+
+	md := k.GetInstrument(ctx, "eur", "usd")
+	require.NotNil(t, md.LastPrice)
+	require.Equal(t, sdk.OneDec(), *md.LastPrice)
+}
+
+func TestAuctionClearingPrice(t *testing.T) {
+	owner := randomAddress()
+
+	order := func(src, dst string) *types.Order {
+		o, err := types.NewOrder(time.Now(), types.TimeInForce_GoodTillCancel, coin(src), coin(dst), owner, cid())
+		require.NoError(t, err)
+		return &o
+	}
+
+	specs := map[string]struct {
+		sells, buys []*types.Order
+		expPrice    sdk.Dec
+		expVolume   sdk.Int
+	}{
+		"most volume": {
+			sells:     []*types.Order{order("100eur", "100usd"), order("100eur", "130usd")},
+			buys:      []*types.Order{order("120usd", "100eur"), order("105usd", "100eur")},
+			expPrice:  sdk.OneDec(),
+			expVolume: sdk.NewInt(100),
+		},
+		"smallest surplus": {
+			sells:     []*types.Order{order("100eur", "100usd")},
+			buys:      []*types.Order{order("100usd", "80eur")},
+			expPrice:  sdk.NewDecWithPrec(125, 2),
+			expVolume: sdk.NewInt(80),
+		},
+		"no crossing orders": {
+			sells:     []*types.Order{order("100eur", "130usd")},
+			buys:      []*types.Order{order("120usd", "100eur")},
+			expPrice:  sdk.ZeroDec(),
+			expVolume: sdk.ZeroInt(),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			price, volume := auctionClearingPrice(spec.sells, spec.buys, nil)
+			require.Equal(t, spec.expPrice, price)
+			require.Equal(t, spec.expVolume, volume)
+		})
+	}
+}
+
+func TestSetBatchAuctionAuthorization(t *testing.T) {
+	ctx, k, _, _ := createTestComponents(t)
+
+	auction := types.NewBatchAuction("eur", "usd")
+	require.Error(t, k.SetBatchAuction(ctx, randomAddress(), auction, true))
+	require.False(t, k.IsBatchAuction(ctx, "eur", "usd"))
+
+	err := k.SetBatchAuction(ctx, testAuthority, types.NewBatchAuction("eur", "eur"), true)
+	require.ErrorIs(t, err, types.ErrInvalidBatchAuction)
+
+	require.NoError(t, k.SetBatchAuction(ctx, testAuthority, auction, true))
+	require.True(t, k.IsBatchAuction(ctx, "eur", "usd"))
+	require.True(t, k.IsBatchAuction(ctx, "usd", "eur"))
+
+	res, err := k.BatchAuctions(sdk.WrapSDKContext(ctx), &types.QueryBatchAuctionsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.BatchAuction{auction}, res.Auctions)
+
+	require.NoError(t, k.SetBatchAuction(ctx, testAuthority, types.NewBatchAuction("usd", "eur"), false))
+	require.Empty(t, k.GetAllBatchAuctions(ctx))
+}
@@ -0,0 +1,103 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// Add a trade of volume source tokens at price to the current candle of every interval of the instrument.
+func (k Keeper) updateCandles(ctx sdk.Context, src, dst string, price sdk.Dec, volume sdk.Int) {
+	retention := k.GetParams(ctx).CandleRetention
+
+	for _, interval := range types.CandleIntervals {
+		start := interval.BucketStart(ctx.BlockTime())
+
+		candle := k.GetCandle(ctx, src, dst, interval, start)
+		if candle == nil {
+			c := types.NewCandle(src, dst, interval, start, price, volume)
+			candle = &c
+
+			// Only a new bucket can push an old candle out of the retention window
+			k.pruneCandles(ctx, src, dst, interval, start.Add(-time.Duration(retention-1)*interval.Duration()))
+		} else {
+			candle.AddTrade(price, volume)
+		}
+
+		k.setCandle(ctx, candle)
+	}
+}
+
+func (k Keeper) GetCandle(ctx sdk.Context, src, dst string, interval types.CandleInterval, start time.Time) *types.Candle {
+	idxStore := ctx.KVStore(k.keyIndices)
+
+	bz := idxStore.Get(types.GetCandleKey(src, dst, interval, start))
+	if bz == nil {
+		return nil
+	}
+
+	candle := new(types.Candle)
+	k.cdc.MustUnmarshalBinaryBare(bz, candle)
+	return candle
+}
+
+// GetCandles returns a page of the retained candles of an instrument, oldest first.
+func (k Keeper) GetCandles(ctx sdk.Context, src, dst string, interval types.CandleInterval, pageReq *query.PageRequest) ([]types.Candle, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.keyIndices), types.GetCandleKeyByInstrument(src, dst, interval))
+
+	candles := make([]types.Candle, 0)
+	pageRes, err := query.Paginate(store, pageReq, func(_ []byte, value []byte) error {
+		var candle types.Candle
+		if err := k.cdc.UnmarshalBinaryBare(value, &candle); err != nil {
+			return err
+		}
+
+		candles = append(candles, candle)
+		return nil
+	})
+
+	return candles, pageRes, err
+}
+
+// GetAllCandles returns every retained candle, sorted by instrument, interval and start.
+func (k Keeper) GetAllCandles(ctx sdk.Context) (res []types.Candle) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.keyIndices), types.GetCandlePrefix())
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var candle types.Candle
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &candle)
+		res = append(res, candle)
+	}
+
+	return
+}
+
+func (k Keeper) setCandle(ctx sdk.Context, candle *types.Candle) {
+	idxStore := ctx.KVStore(k.keyIndices)
+	idxStore.Set(types.GetCandleKey(candle.Source, candle.Destination, candle.Interval, candle.Start), k.cdc.MustMarshalBinaryBare(candle))
+}
+
+// Delete the candles of an instrument that started before the given time.
+func (k Keeper) pruneCandles(ctx sdk.Context, src, dst string, interval types.CandleInterval, before time.Time) {
+	idxStore := ctx.KVStore(k.keyIndices)
+
+	var keys [][]byte
+
+	it := idxStore.Iterator(types.GetCandleKeyByInstrument(src, dst, interval), types.GetCandleKey(src, dst, interval, before))
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range keys {
+		idxStore.Delete(key)
+	}
+}
@@ -0,0 +1,123 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCandlesUpdatedByTrades(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	ctx = ctx.WithBlockTime(time.Date(2021, 3, 1, 10, 0, 30, 0, time.UTC))
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "60usd", "50eur")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "110usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "55usd", "50eur")))
+
+	minute := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	candle := k.GetCandle(ctx, "eur", "usd", types.CandleInterval_Minute, minute)
+	require.NotNil(t, candle)
+	require.Equal(t, "1.200000000000000000", candle.Open.String())
+	require.Equal(t, "1.200000000000000000", candle.High.String())
+	require.Equal(t, "1.100000000000000000", candle.Low.String())
+	require.Equal(t, "1.100000000000000000", candle.Close.String())
+	require.Equal(t, sdk.NewInt(100), candle.Volume)
+
+	// The inverse instrument is priced in eur and its volume counted in usd
+	candle = k.GetCandle(ctx, "usd", "eur", types.CandleInterval_Minute, minute)
+	require.NotNil(t, candle)
+	require.True(t, candle.High.Equal(sdk.OneDec().Quo(sdk.MustNewDecFromStr("1.1"))))
+	require.Equal(t, sdk.NewInt(115), candle.Volume)
+
+	// A trade in the next minute opens a new minute candle, but updates the hourly and daily candles
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "60usd", "50eur")))
+
+	candle = k.GetCandle(ctx, "eur", "usd", types.CandleInterval_Minute, minute.Add(time.Minute))
+	require.NotNil(t, candle)
+	require.Equal(t, sdk.NewInt(50), candle.Volume)
+
+	for _, interval := range []types.CandleInterval{types.CandleInterval_Hour, types.CandleInterval_Day} {
+		candle = k.GetCandle(ctx, "eur", "usd", interval, interval.BucketStart(minute))
+		require.NotNil(t, candle)
+		require.Equal(t, "1.200000000000000000", candle.Open.String())
+		require.Equal(t, sdk.NewInt(150), candle.Volume)
+	}
+}
+
+func TestCandleRetention(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	ctx = ctx.WithBlockTime(time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC))
+	k.SetParams(ctx, types.NewParams(2, types.DefaultTradeRetention, 0, 0, nil, types.DefaultMaxHops, types.DefaultMaxOpenOrders, types.DefaultMaxInstrumentOpenOrders, nil, types.DefaultPriceHistoryRetention))
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "1000eur", "1000usd")))
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "10usd", "10eur")))
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	}
+
+	candles, _, err := k.GetCandles(ctx, "eur", "usd", types.CandleInterval_Minute, nil)
+	require.NoError(t, err)
+	require.Len(t, candles, 2)
+	require.Equal(t, time.Date(2021, 3, 1, 10, 1, 0, 0, time.UTC), candles[0].Start)
+	require.Equal(t, time.Date(2021, 3, 1, 10, 2, 0, 0, time.UTC), candles[1].Start)
+
+	candles, _, err = k.GetCandles(ctx, "eur", "usd", types.CandleInterval_Hour, nil)
+	require.NoError(t, err)
+	require.Len(t, candles, 1)
+	require.Equal(t, sdk.NewInt(30), candles[0].Volume)
+}
+
+func TestQueryCandles(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	ctx = ctx.WithBlockTime(time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC))
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "1000eur", "1000usd")))
+	for i := 0; i < 3; i++ {
+		require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "10usd", "10eur")))
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	}
+
+	res, err := k.Candles(sdk.WrapSDKContext(ctx), &types.QueryCandlesRequest{
+		Source: "eur", Destination: "usd", Interval: "1m", Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Candles, 2)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = k.Candles(sdk.WrapSDKContext(ctx), &types.QueryCandlesRequest{
+		Source: "eur", Destination: "usd", Interval: "1m", Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Candles, 1)
+	require.Equal(t, time.Date(2021, 3, 1, 10, 2, 0, 0, time.UTC), res.Candles[0].Start)
+
+	res, err = k.Candles(sdk.WrapSDKContext(ctx), &types.QueryCandlesRequest{Source: "usd", Destination: "eur", Interval: "1D"})
+	require.NoError(t, err)
+	require.Len(t, res.Candles, 1)
+
+	_, err = k.Candles(sdk.WrapSDKContext(ctx), &types.QueryCandlesRequest{Source: "eur", Destination: "usd", Interval: "5m"})
+	require.Error(t, err)
+
+	_, err = k.Candles(sdk.WrapSDKContext(ctx), &types.QueryCandlesRequest{Source: "#!@@", Destination: "usd", Interval: "1m"})
+	require.Error(t, err)
+}
@@ -0,0 +1,29 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// SetFees replaces the trading fee rates on behalf of the authority.
+func (k *Keeper) SetFees(ctx sdk.Context, authority sdk.AccAddress, makerFee, takerFee uint32, instrumentFees []types.InstrumentFees) error {
+	if err := k.authority.ValidateAuthority(ctx, authority); err != nil {
+		return err
+	}
+
+	params := k.GetParams(ctx)
+	params.MakerFee, params.TakerFee = makerFee, takerFee
+	params.InstrumentFees = instrumentFees
+
+	if err := params.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidFees, err.Error())
+	}
+
+	k.SetParams(ctx, params)
+	return nil
+}
@@ -0,0 +1,120 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTradingFees(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	require.NoError(t, k.SetFees(ctx, testAuthority, 10, 20, nil))
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+	totalSupply := snapshotAccounts(ctx, bk)
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "5000eur", "6000usd")))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "6000usd", "5000eur")))
+
+	// The maker receives 6000usd less 10 bps, the taker 5000eur less 20 bps
+	require.Equal(t, "5000eur,5994usd", bk.GetAllBalances(ctx, acc1.GetAddress()).String())
+	require.Equal(t, "4990eur,4000usd", bk.GetAllBalances(ctx, acc2.GetAddress()).String())
+
+	buyback := authtypes.NewModuleAddress(testBuybackAccount)
+	require.Equal(t, "10eur,6usd", bk.GetAllBalances(ctx, buyback).String())
+	require.Equal(t, totalSupply, snapshotAccounts(ctx, bk))
+
+	var fees []string
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type != types.EventTypeMarket {
+			continue
+		}
+		for _, attr := range ev.Attributes {
+			if string(attr.Key) == types.AttributeKeyFee {
+				fees = append(fees, string(attr.Value))
+			}
+		}
+	}
+	require.Equal(t, []string{"6usd", "10eur"}, fees)
+}
+
+func TestTradingFeesInStakingToken(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	require.NoError(t, k.SetFees(ctx, testAuthority, 100, 0, nil))
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "1000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "1000ngm")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "500eur", "500ngm")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "500ngm", "500eur")))
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	require.Equal(t, "5ngm", bk.GetAllBalances(ctx, feeCollector).String())
+	require.True(t, bk.GetAllBalances(ctx, authtypes.NewModuleAddress(testBuybackAccount)).IsZero())
+	require.Equal(t, "500eur,495ngm", bk.GetAllBalances(ctx, acc1.GetAddress()).String())
+	require.Equal(t, "500eur,500ngm", bk.GetAllBalances(ctx, acc2.GetAddress()).String())
+}
+
+func TestInstrumentTradingFees(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	require.NoError(t, k.SetFees(ctx, testAuthority, 100, 100, []types.InstrumentFees{
+		{Source: "usd", Destination: "eur", MakerFee: 0, TakerFee: 50},
+	}))
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "1000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "1000usd")
+
+	// The override applies to both books of the pair
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "1000eur", "1000usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "1000usd", "1000eur")))
+
+	require.Equal(t, "1000usd", bk.GetAllBalances(ctx, acc1.GetAddress()).String())
+	require.Equal(t, "995eur", bk.GetAllBalances(ctx, acc2.GetAddress()).String())
+}
+
+func TestSyntheticTradingFees(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	require.NoError(t, k.SetFees(ctx, testAuthority, 100, 100, nil))
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000chf")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "1000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "1000eur", "1000chf")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "1000chf", "1000usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "1000usd", "1000eur")))
+
+	// Both makers pay a fee, the taker only pays on the tokens it bought
+	require.Equal(t, "990chf,9000eur", bk.GetAllBalances(ctx, acc1.GetAddress()).String())
+	require.Equal(t, "9000chf,990usd", bk.GetAllBalances(ctx, acc2.GetAddress()).String())
+	require.Equal(t, "990eur", bk.GetAllBalances(ctx, acc3.GetAddress()).String())
+	require.Equal(t, "10chf,10eur,10usd", bk.GetAllBalances(ctx, authtypes.NewModuleAddress(testBuybackAccount)).String())
+}
+
+func TestSetFeesAuthorization(t *testing.T) {
+	ctx, k, _, _ := createTestComponents(t)
+
+	require.Error(t, k.SetFees(ctx, randomAddress(), 10, 10, nil))
+	require.Error(t, k.SetFees(ctx, testAuthority, types.MaxFeeRate+1, 10, nil))
+	require.Error(t, k.SetFees(ctx, testAuthority, 10, 10, []types.InstrumentFees{
+		{Source: "eur", Destination: "usd"}, {Source: "usd", Destination: "eur"},
+	}))
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	require.NoError(t, k.SetFees(ctx, testAuthority, 10, 20, nil))
+	params := k.GetParams(ctx)
+	require.Equal(t, uint32(10), params.MakerFee)
+	require.Equal(t, uint32(20), params.TakerFee)
+	require.Empty(t, params.InstrumentFees)
+	require.Equal(t, types.DefaultCandleRetention, params.CandleRetention)
+}
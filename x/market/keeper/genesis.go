@@ -0,0 +1,139 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// InitGenesis loads the resting orders into both the owner store and the
+// priority index, parks the stop orders in the trigger index and restores the
+// parameters, instrument rules, trading halts, price bands, batch auctions,
+// market data, price history, candles, trade log and id sequences. Breaches of
+// the price bands are not part of the genesis state and start over.
+func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
+	k.SetParams(ctx, gs.Params)
+
+	store := ctx.KVStore(k.key)
+	store.Set(types.GetOrderIDGeneratorKey(), sdk.Uint64ToBigEndian(gs.NextOrderID))
+	store.Set(types.GetTradeIDGeneratorKey(), sdk.Uint64ToBigEndian(gs.NextTradeID))
+
+	idxStore := ctx.KVStore(k.keyIndices)
+	for i := range gs.MarketData {
+		md := gs.MarketData[i]
+		idxStore.Set(types.GetMarketDataKey(md.Source, md.Destination), k.cdc.MustMarshalBinaryBare(&md))
+	}
+
+	for _, md := range gs.PriceHistory {
+		k.setPriceHistory(ctx, md)
+	}
+
+	for i := range gs.Orders {
+		order := gs.Orders[i]
+		k.setOrder(ctx, &order)
+	}
+
+	for i := range gs.StopOrders {
+		stopOrder := gs.StopOrders[i]
+		k.setStopOrder(ctx, &stopOrder)
+
+		// Stop orders that were triggered but not sent to the market yet are queued again.
+		md := k.GetInstrument(ctx, stopOrder.Source.Denom, stopOrder.Destination.Denom)
+		if md != nil && md.LastPrice != nil && stopOrder.IsTriggered(*md.LastPrice) {
+			idxStore.Delete(types.GetStopTriggerKey(stopOrder.Source.Denom, stopOrder.Destination.Denom, stopOrder.StopPrice, stopOrder.ID))
+			idxStore.Set(types.GetStopTriggeredKey(stopOrder.ID), types.GetStopOwnerKey(stopOrder.Owner, stopOrder.ClientOrderID))
+		}
+	}
+
+	for i := range gs.Candles {
+		candle := gs.Candles[i]
+		k.setCandle(ctx, &candle)
+	}
+
+	for i := range gs.Trades {
+		trade := gs.Trades[i]
+		k.setTrade(ctx, &trade)
+	}
+
+	for _, rules := range gs.InstrumentRules {
+		k.setInstrumentRules(ctx, rules)
+	}
+
+	for _, halt := range gs.TradingHalts {
+		k.setTradingHalt(ctx, halt)
+	}
+
+	for _, band := range gs.PriceBands {
+		k.setPriceBand(ctx, band)
+	}
+
+	for _, auction := range gs.BatchAuctions {
+		k.setBatchAuction(ctx, auction)
+	}
+}
+
+func (k *Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
+	orders := make([]types.Order, 0)
+	for _, order := range k.GetAllOrders(ctx) {
+		orders = append(orders, *order)
+	}
+
+	marketData := k.GetInstruments(ctx)
+	if marketData == nil {
+		marketData = []types.MarketData{}
+	}
+
+	stopOrders := make([]types.StopOrder, 0)
+	for _, stopOrder := range k.GetAllStopOrders(ctx) {
+		stopOrders = append(stopOrders, *stopOrder)
+	}
+
+	candles := k.GetAllCandles(ctx)
+	if candles == nil {
+		candles = []types.Candle{}
+	}
+
+	trades := k.GetAllTrades(ctx)
+	if trades == nil {
+		trades = []types.Trade{}
+	}
+
+	priceHistory := k.GetAllPriceHistory(ctx)
+	if priceHistory == nil {
+		priceHistory = []types.MarketData{}
+	}
+
+	return types.NewGenesisState(
+		orders, marketData, k.peekNextOrderNumber(ctx), stopOrders, k.GetParams(ctx), candles, trades, k.peekNextTradeNumber(ctx),
+		k.GetAllInstrumentRules(ctx), k.GetAllTradingHalts(ctx), k.GetAllPriceBands(ctx),
+		k.GetAllBatchAuctions(ctx), priceHistory,
+	)
+}
+
+// GetAllOrders returns every resting order, sorted by owner and client order id.
+func (k Keeper) GetAllOrders(ctx sdk.Context) (res []*types.Order) {
+	store := ctx.KVStore(k.key)
+
+	it := sdk.KVStorePrefixIterator(store, types.GetOwnersPrefix())
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		o := &types.Order{}
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), o)
+		res = append(res, o)
+	}
+
+	return
+}
+
+func (k Keeper) peekNextOrderNumber(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.key).Get(types.GetOrderIDGeneratorKey())
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
@@ -0,0 +1,107 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisExportImport(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	// Trade to produce market data
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "60usd", "50eur")))
+
+	// Passive orders on both sides of the book
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "500eur", "700usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "300usd", "280eur")))
+
+	require.NoError(t, k.AddStopOrder(ctx, stopOrder(ctx, acc1, types.StopOrderType_Limit, "100eur", "100usd", "1.1", "0")))
+
+	k.SetParams(ctx, types.NewParams(10, types.DefaultTradeRetention, 0, 0, nil, types.DefaultMaxHops, types.DefaultMaxOpenOrders, types.DefaultMaxInstrumentOpenOrders, nil, types.DefaultPriceHistoryRetention))
+	require.NoError(t, k.SetInstrumentRules(ctx, testAuthority, types.NewInstrumentRules("gbp", "chf", sdk.NewDecWithPrec(1, 2), sdk.NewInt(10), sdk.NewInt(5))))
+	require.NoError(t, k.HaltTrading(ctx, testAuthority, types.NewInstrumentHalt("gbp", "chf"), false))
+	band := types.NewPriceBand("eur", "usd", sdk.NewDecWithPrec(1, 1), sdk.ZeroDec(), 3, time.Minute, time.Hour)
+	require.NoError(t, k.SetPriceBand(ctx, testAuthority, band))
+	require.NoError(t, k.SetBatchAuction(ctx, testAuthority, types.NewBatchAuction("gbp", "chf"), true))
+
+	exported := k.ExportGenesis(ctx)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.Orders, 3)
+	require.Len(t, exported.MarketData, 2)
+	require.Len(t, exported.PriceHistory, 2)
+	require.Len(t, exported.StopOrders, 1)
+	require.Len(t, exported.Candles, 6)
+	require.Len(t, exported.Trades, 1)
+	require.Equal(t, uint64(1), exported.NextTradeID)
+	require.Len(t, exported.InstrumentRules, 1)
+	require.Equal(t, []types.TradingHalt{types.NewInstrumentHalt("gbp", "chf")}, exported.TradingHalts)
+	require.Equal(t, []types.PriceBand{band}, exported.PriceBands)
+	require.Equal(t, []types.BatchAuction{types.NewBatchAuction("gbp", "chf")}, exported.BatchAuctions)
+	require.Equal(t, types.NewParams(10, types.DefaultTradeRetention, 0, 0, nil, types.DefaultMaxHops, types.DefaultMaxOpenOrders, types.DefaultMaxInstrumentOpenOrders, nil, types.DefaultPriceHistoryRetention), exported.Params)
+	require.Equal(t, uint64(5), exported.NextOrderID)
+
+	cdc := MakeTestEncodingConfig().Marshaler
+	bz := cdc.MustMarshalJSON(&exported)
+
+	ctx2, k2, _, _ := createTestComponents(t)
+	var imported types.GenesisState
+	cdc.MustUnmarshalJSON(bz, &imported)
+	k2.InitGenesis(ctx2, imported)
+
+	require.Equal(t, exported, k2.ExportGenesis(ctx2))
+
+	// The priority index is rebuilt
+	for _, o := range exported.Orders {
+		best := k2.getBestOrder(ctx2, o.Source.Denom, o.Destination.Denom)
+		require.NotNil(t, best)
+		require.Equal(t, o.Source.Denom, best.Source.Denom)
+	}
+
+	res, err := k2.Instrument(sdk.WrapSDKContext(ctx2), &types.QueryInstrumentRequest{Source: "eur", Destination: "usd"})
+	require.NoError(t, err)
+	require.Len(t, res.Orders, 2)
+
+	// Order and trade ids continue from the exported sequences
+	require.Equal(t, exported.NextOrderID, k2.getNextOrderNumber(ctx2))
+	require.Equal(t, exported.NextTradeID, k2.getNextTradeNumber(ctx2))
+
+	// The trade indices are rebuilt
+	trades, _, err := k2.GetTradesByAccount(ctx2, acc1.GetAddress(), nil)
+	require.NoError(t, err)
+	require.Equal(t, exported.Trades, trades)
+
+	// The trigger index is rebuilt
+	it := sdk.KVStorePrefixIterator(ctx2.KVStore(k2.keyIndices), types.GetStopTriggerKeyByInstrument("eur", "usd"))
+	require.True(t, it.Valid())
+	it.Close()
+
+	md := k2.GetInstrument(ctx2, "eur", "usd")
+	require.NotNil(t, md.LastPrice)
+	require.True(t, md.LastPrice.Equal(*k.GetInstrument(ctx, "eur", "usd").LastPrice))
+}
+
+func TestGenesisExportEmpty(t *testing.T) {
+	ctx, k, _, _ := createTestComponents(t)
+
+	gs := k.ExportGenesis(ctx)
+	require.NoError(t, gs.Validate())
+	require.Empty(t, gs.Orders)
+	require.Empty(t, gs.MarketData)
+	require.Empty(t, gs.StopOrders)
+	require.Empty(t, gs.Candles)
+	require.Empty(t, gs.Trades)
+	require.Equal(t, types.DefaultParams(), gs.Params)
+	require.Equal(t, uint64(0), gs.NextOrderID)
+}
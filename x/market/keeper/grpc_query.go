@@ -2,8 +2,11 @@ package keeper
 
 import (
 	"context"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/e-money/em-ledger/x/market/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -60,7 +63,7 @@ func (k Keeper) Instrument(c context.Context, req *types.QueryInstrumentRequest)
 		orders = append(orders, types.QueryOrderResponse{
 			ID:              order.ID,
 			Owner:           order.Owner,
-			SourceRemaining: order.SourceRemaining.String(),
+			SourceRemaining: order.VisibleRemaining().String(),
 			Price:           order.Price(),
 			Created:         order.Created,
 		})
@@ -91,3 +94,208 @@ func queryInstruments(ctx sdk.Context, k *Keeper) *types.QueryInstrumentsRespons
 
 	return &types.QueryInstrumentsResponse{Instruments: response}
 }
+
+func (k Keeper) OrderBook(c context.Context, req *types.QueryOrderBookRequest) (*types.QueryOrderBookResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	source, destination := req.Source, req.Destination
+	if sdk.ValidateDenom(source) != nil || sdk.ValidateDenom(destination) != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "Invalid denoms: %v %v", source, destination)
+	}
+
+	levels, pageRes, err := k.GetOrderBookLevels(ctx, source, destination, req.Depth, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	syntheticLevels := make([]types.OrderBookLevel, 0)
+	if req.IncludeSynthetic {
+		// Synthetic levels are not paginated. They are listed with the first page only, up to the depth or page size.
+		if req.Pagination != nil && (req.Pagination.Key != nil || req.Pagination.Offset > 0) {
+			return nil, status.Error(codes.InvalidArgument, "synthetic levels are only listed with the first page")
+		}
+
+		depth := req.Depth
+		if depth == 0 {
+			depth = query.DefaultLimit
+			if req.Pagination != nil && req.Pagination.Limit > 0 {
+				depth = uint32(req.Pagination.Limit)
+			}
+		}
+
+		if depth > maxSyntheticDepth {
+			return nil, status.Errorf(codes.InvalidArgument, "synthetic levels are limited to a depth of %v", maxSyntheticDepth)
+		}
+
+		syntheticLevels = k.GetSyntheticOrderBookLevels(ctx, source, destination, depth)
+	}
+
+	return &types.QueryOrderBookResponse{
+		Source:          source,
+		Destination:     destination,
+		Levels:          levels,
+		SyntheticLevels: syntheticLevels,
+		Pagination:      pageRes,
+	}, nil
+}
+
+func (k Keeper) Candles(c context.Context, req *types.QueryCandlesRequest) (*types.QueryCandlesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	source, destination := req.Source, req.Destination
+	if sdk.ValidateDenom(source) != nil || sdk.ValidateDenom(destination) != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "Invalid denoms: %v %v", source, destination)
+	}
+
+	interval, err := types.CandleIntervalFromString(req.Interval)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	candles, pageRes, err := k.GetCandles(ctx, source, destination, interval, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryCandlesResponse{Candles: candles, Pagination: pageRes}, nil
+}
+
+func (k Keeper) TradesByInstrument(c context.Context, req *types.QueryTradesByInstrumentRequest) (*types.QueryTradesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	source, destination := req.Source, req.Destination
+	if sdk.ValidateDenom(source) != nil || sdk.ValidateDenom(destination) != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "Invalid denoms: %v %v", source, destination)
+	}
+
+	trades, pageRes, err := k.GetTradesByInstrument(ctx, source, destination, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryTradesResponse{Trades: trades, Pagination: pageRes}, nil
+}
+
+func (k Keeper) TradesByAccount(c context.Context, req *types.QueryTradesByAccountRequest) (*types.QueryTradesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	account, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress
+	}
+
+	trades, pageRes, err := k.GetTradesByAccount(ctx, account, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryTradesResponse{Trades: trades, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Quote(c context.Context, req *types.QueryQuoteRequest) (*types.QueryQuoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.Source.Validate() != nil || !req.Source.IsPositive() || req.Destination.Validate() != nil || !req.Destination.IsPositive() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "Invalid source or destination: %v %v", req.Source, req.Destination)
+	}
+
+	return k.GetQuote(ctx, req.Source, req.Destination)
+}
+
+func (k Keeper) OrderByID(c context.Context, req *types.QueryOrderByIDRequest) (*types.QueryOrderByIDResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	order := k.GetOrderByID(ctx, req.Id)
+	if order == nil {
+		return nil, status.Errorf(codes.NotFound, "order %v not found", req.Id)
+	}
+
+	return &types.QueryOrderByIDResponse{Order: *order}, nil
+}
+
+func (k Keeper) OrderByClientOrderID(c context.Context, req *types.QueryOrderByClientOrderIDRequest) (*types.QueryOrderByClientOrderIDResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress
+	}
+
+	order := k.GetOrderByOwnerAndClientOrderId(ctx, req.Owner, req.ClientOrderId)
+	if order == nil {
+		return nil, status.Errorf(codes.NotFound, "order %v not found for %v", req.ClientOrderId, req.Owner)
+	}
+
+	return &types.QueryOrderByClientOrderIDResponse{Order: *order}, nil
+}
+
+func (k Keeper) TradingHalts(c context.Context, req *types.QueryTradingHaltsRequest) (*types.QueryTradingHaltsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTradingHaltsResponse{Halts: k.GetAllTradingHalts(ctx)}, nil
+}
+
+func (k Keeper) PriceBands(c context.Context, req *types.QueryPriceBandsRequest) (*types.QueryPriceBandsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryPriceBandsResponse{Bands: k.GetAllPriceBands(ctx)}, nil
+}
+
+func (k Keeper) BatchAuctions(c context.Context, req *types.QueryBatchAuctionsRequest) (*types.QueryBatchAuctionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBatchAuctionsResponse{Auctions: k.GetAllBatchAuctions(ctx)}, nil
+}
+
+func (k Keeper) TimeWeightedAveragePrice(c context.Context, req *types.QueryTimeWeightedAveragePriceRequest) (*types.QueryTimeWeightedAveragePriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	source, destination := req.Source, req.Destination
+	if sdk.ValidateDenom(source) != nil || sdk.ValidateDenom(destination) != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "Invalid denoms: %v %v", source, destination)
+	}
+
+	window, err := time.ParseDuration(req.Window)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	price, err := k.TWAP(ctx, source, destination, window)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTimeWeightedAveragePriceResponse{Price: price}, nil
+}
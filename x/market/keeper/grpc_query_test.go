@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/e-money/em-ledger/x/market/types"
 	"github.com/stretchr/testify/assert"
@@ -192,3 +193,266 @@ func TestInstrument(t *testing.T) {
 		})
 	}
 }
+
+func TestOrderBook(t *testing.T) {
+	enc := MakeTestEncodingConfig()
+	ctx, k, ak, bk := createTestComponentsWithEncoding(t, enc)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, enc.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, k)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "1000usd")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "1000eur")
+
+	for _, o := range []types.Order{
+		order(ctx.BlockTime(), acc1, "100usd", "120chf"),
+		order(ctx.BlockTime(), acc1, "100usd", "100chf"),
+		order(ctx.BlockTime(), acc1, "50usd", "50chf"),
+		order(ctx.BlockTime(), acc1, "100usd", "110chf"),
+		order(ctx.BlockTime(), acc1, "100usd", "100eur"),
+		order(ctx.BlockTime(), acc2, "50eur", "45chf"),
+	} {
+		require.NoError(t, k.NewOrderSingle(ctx, o))
+	}
+
+	levels := []types.OrderBookLevel{
+		{Price: sdk.NewDec(1), SourceRemaining: sdk.NewInt(150), OrderCount: 2},
+		{Price: sdk.MustNewDecFromStr("1.1"), SourceRemaining: sdk.NewInt(100), OrderCount: 1},
+		{Price: sdk.MustNewDecFromStr("1.2"), SourceRemaining: sdk.NewInt(100), OrderCount: 1},
+	}
+
+	firstPage, err := queryClient.OrderBook(sdk.WrapSDKContext(ctx), &types.QueryOrderBookRequest{
+		Source: "usd", Destination: "chf", Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, firstPage.Pagination.NextKey)
+
+	specs := map[string]struct {
+		req          *types.QueryOrderBookRequest
+		expErr       bool
+		expLevels    []types.OrderBookLevel
+		expSynthetic []types.OrderBookLevel
+		expTotal     uint64
+		expNextKey   bool
+	}{
+		"all levels": {
+			req:       &types.QueryOrderBookRequest{Source: "usd", Destination: "chf"},
+			expLevels: levels,
+			expTotal:  3,
+		},
+		"limited depth": {
+			req:       &types.QueryOrderBookRequest{Source: "usd", Destination: "chf", Depth: 2},
+			expLevels: levels[:2],
+			expTotal:  2,
+		},
+		"first page": {
+			req: &types.QueryOrderBookRequest{
+				Source: "usd", Destination: "chf", Pagination: &query.PageRequest{Limit: 1},
+			},
+			expLevels:  levels[:1],
+			expNextKey: true,
+		},
+		"next page by key": {
+			req: &types.QueryOrderBookRequest{
+				Source: "usd", Destination: "chf", Pagination: &query.PageRequest{Key: firstPage.Pagination.NextKey, Limit: 1},
+			},
+			expLevels:  levels[1:2],
+			expNextKey: true,
+		},
+		"last page by offset": {
+			req: &types.QueryOrderBookRequest{
+				Source: "usd", Destination: "chf", Pagination: &query.PageRequest{Offset: 2, Limit: 1, CountTotal: true},
+			},
+			expLevels: levels[2:],
+			expTotal:  3,
+		},
+		"page beyond depth": {
+			req: &types.QueryOrderBookRequest{
+				Source: "usd", Destination: "chf", Depth: 2, Pagination: &query.PageRequest{Offset: 2, Limit: 1},
+			},
+		},
+		"synthetic levels": {
+			req:       &types.QueryOrderBookRequest{Source: "usd", Destination: "chf", Depth: 1, IncludeSynthetic: true},
+			expLevels: levels[:1],
+			expSynthetic: []types.OrderBookLevel{
+				{Price: sdk.MustNewDecFromStr("0.9"), SourceRemaining: sdk.NewInt(50), Intermediate: "eur"},
+			},
+			expTotal: 1,
+		},
+		"synthetic levels with key": {
+			req: &types.QueryOrderBookRequest{
+				Source: "usd", Destination: "chf", IncludeSynthetic: true,
+				Pagination: &query.PageRequest{Key: firstPage.Pagination.NextKey, Limit: 1},
+			},
+			expErr: true,
+		},
+		"synthetic levels with offset": {
+			req: &types.QueryOrderBookRequest{
+				Source: "usd", Destination: "chf", IncludeSynthetic: true, Pagination: &query.PageRequest{Offset: 1},
+			},
+			expErr: true,
+		},
+		"synthetic levels beyond maximum depth": {
+			req:    &types.QueryOrderBookRequest{Source: "usd", Destination: "chf", Depth: maxSyntheticDepth + 1, IncludeSynthetic: true},
+			expErr: true,
+		},
+		"empty book": {
+			req: &types.QueryOrderBookRequest{Source: "chf", Destination: "usd"},
+		},
+		"offset and key": {
+			req: &types.QueryOrderBookRequest{
+				Source: "usd", Destination: "chf", Pagination: &query.PageRequest{Key: firstPage.Pagination.NextKey, Offset: 1},
+			},
+			expErr: true,
+		},
+		"invalid denom": {
+			req:    &types.QueryOrderBookRequest{Source: "#!@@", Destination: "chf"},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotRsp, gotErr := queryClient.OrderBook(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expLevels, gotRsp.Levels)
+			assert.Equal(t, spec.expTotal, gotRsp.Pagination.Total)
+			assert.Equal(t, spec.expNextKey, gotRsp.Pagination.NextKey != nil)
+			assert.Equal(t, spec.expSynthetic, gotRsp.SyntheticLevels)
+		})
+	}
+}
+
+func TestIcebergOrderHidesReserve(t *testing.T) {
+	enc := MakeTestEncodingConfig()
+	ctx, k, ak, bk := createTestComponentsWithEncoding(t, enc)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, enc.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, k)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	acc := createAccount(ctx, ak, bk, randomAddress(), "1000usd")
+
+	o := icebergOrder(ctx, acc, "500usd", "500chf", 100)
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc, "50usd", "50chf")))
+
+	instrument, err := queryClient.Instrument(sdk.WrapSDKContext(ctx), &types.QueryInstrumentRequest{Source: "usd", Destination: "chf"})
+	require.NoError(t, err)
+	require.Len(t, instrument.Orders, 2)
+	require.Equal(t, "100", instrument.Orders[0].SourceRemaining)
+	require.Equal(t, "50", instrument.Orders[1].SourceRemaining)
+
+	book, err := queryClient.OrderBook(sdk.WrapSDKContext(ctx), &types.QueryOrderBookRequest{Source: "usd", Destination: "chf"})
+	require.NoError(t, err)
+	require.Equal(t, []types.OrderBookLevel{
+		{Price: sdk.NewDec(1), SourceRemaining: sdk.NewInt(150), OrderCount: 2},
+	}, book.Levels)
+
+	// The owner still sees the entire order
+	byAccount, err := queryClient.ByAccount(sdk.WrapSDKContext(ctx), &types.QueryByAccountRequest{Address: acc.GetAddress().String()})
+	require.NoError(t, err)
+	require.Len(t, byAccount.Orders, 2)
+	for _, ao := range byAccount.Orders {
+		if ao.ClientOrderID == o.ClientOrderID {
+			require.Equal(t, "500", ao.SourceRemaining.String())
+		}
+	}
+}
+
+func TestQueryOrderByID(t *testing.T) {
+	enc := MakeTestEncodingConfig()
+	ctx, k, ak, bk := createTestComponentsWithEncoding(t, enc)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	o := order(ctx.BlockTime(), acc1, "1000eur", "1200usd")
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "480usd", "400eur")))
+
+	stored := k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), o.ClientOrderID)
+	require.NotNil(t, stored)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, enc.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, k)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	res, err := queryClient.OrderByID(sdk.WrapSDKContext(ctx), &types.QueryOrderByIDRequest{Id: stored.ID})
+	require.NoError(t, err)
+	require.Equal(t, o.ClientOrderID, res.Order.ClientOrderID)
+	require.Equal(t, "400", res.Order.SourceFilled.String())
+	require.Equal(t, "600", res.Order.SourceRemaining.String())
+	require.Equal(t, "480", res.Order.DestinationFilled.String())
+
+	byClient, err := queryClient.OrderByClientOrderID(sdk.WrapSDKContext(ctx), &types.QueryOrderByClientOrderIDRequest{
+		Owner:         acc1.GetAddress().String(),
+		ClientOrderId: o.ClientOrderID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, res.Order, byClient.Order)
+
+	_, err = queryClient.OrderByClientOrderID(sdk.WrapSDKContext(ctx), &types.QueryOrderByClientOrderIDRequest{
+		Owner:         "invalid",
+		ClientOrderId: o.ClientOrderID,
+	})
+	require.Error(t, err)
+
+	// Canceled orders are no longer found
+	require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), o.ClientOrderID))
+	require.Nil(t, k.GetOrderByID(ctx, stored.ID))
+
+	_, err = queryClient.OrderByID(sdk.WrapSDKContext(ctx), &types.QueryOrderByIDRequest{Id: stored.ID})
+	require.Error(t, err)
+
+	_, err = queryClient.OrderByClientOrderID(sdk.WrapSDKContext(ctx), &types.QueryOrderByClientOrderIDRequest{
+		Owner:         acc1.GetAddress().String(),
+		ClientOrderId: o.ClientOrderID,
+	})
+	require.Error(t, err)
+}
+
+func TestQueryOrderByIDIceberg(t *testing.T) {
+	enc := MakeTestEncodingConfig()
+	ctx, k, ak, bk := createTestComponentsWithEncoding(t, enc)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	iceberg := icebergOrder(ctx, acc1, "300eur", "360usd", 100)
+	require.NoError(t, k.NewOrderSingle(ctx, iceberg))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "60usd", "50eur")))
+
+	stored := k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), iceberg.ClientOrderID)
+	require.NotNil(t, stored)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, enc.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, k)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	// The lookups report the entire order, like the account orders
+	res, err := queryClient.OrderByID(sdk.WrapSDKContext(ctx), &types.QueryOrderByIDRequest{Id: stored.ID})
+	require.NoError(t, err)
+	require.Equal(t, *stored, res.Order)
+	require.Equal(t, "300eur", res.Order.Source.String())
+	require.Equal(t, "50", res.Order.SourceFilled.String())
+	require.Equal(t, "250", res.Order.SourceRemaining.String())
+	require.Equal(t, "50", res.Order.DisplayRemaining.String())
+
+	// The book only shows the displayed slice
+	book, err := queryClient.OrderBook(sdk.WrapSDKContext(ctx), &types.QueryOrderBookRequest{Source: "eur", Destination: "usd"})
+	require.NoError(t, err)
+	require.Len(t, book.Levels, 1)
+	require.Equal(t, "50", book.Levels[0].SourceRemaining.String())
+
+	byClient, err := queryClient.OrderByClientOrderID(sdk.WrapSDKContext(ctx), &types.QueryOrderByClientOrderIDRequest{
+		Owner:         acc1.GetAddress().String(),
+		ClientOrderId: iceberg.ClientOrderID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, res.Order, byClient.Order)
+}
@@ -0,0 +1,131 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// HaltTrading stops trading on the instruments covered by halt on behalf of the authority. If cancelOrders is set, the
+// resting orders of those instruments are canceled.
+func (k *Keeper) HaltTrading(ctx sdk.Context, authority sdk.AccAddress, halt types.TradingHalt, cancelOrders bool) error {
+	if err := k.authority.ValidateAuthority(ctx, authority); err != nil {
+		return err
+	}
+
+	if err := halt.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidTradingHalt, err.Error())
+	}
+
+	k.setTradingHalt(ctx, halt)
+
+	if cancelOrders {
+		k.cancelHaltedOrders(ctx, halt)
+	}
+
+	return nil
+}
+
+// ResumeTrading lifts a halt on behalf of the authority. Instruments covered by another halt remain halted.
+func (k *Keeper) ResumeTrading(ctx sdk.Context, authority sdk.AccAddress, halt types.TradingHalt) error {
+	if err := k.authority.ValidateAuthority(ctx, authority); err != nil {
+		return err
+	}
+
+	if err := halt.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidTradingHalt, err.Error())
+	}
+
+	store := ctx.KVStore(k.key)
+	if !store.Has(halt.Key()) {
+		return sdkerrors.Wrap(types.ErrTradingHaltNotFound, halt.String())
+	}
+
+	store.Delete(halt.Key())
+	return nil
+}
+
+// IsTradingHalted reports whether the instrument from src to dst is halted, either on its own or through one of its
+// denominations. Halts past their expiry no longer apply, even before they are removed.
+func (k *Keeper) IsTradingHalted(ctx sdk.Context, src, dst string) bool {
+	for _, key := range [][]byte{
+		types.GetDenomHaltKey(src),
+		types.GetDenomHaltKey(dst),
+		types.GetInstrumentHaltKey(src, dst),
+	} {
+		if halt := k.getTradingHalt(ctx, key); halt != nil && halt.IsActive(ctx.BlockTime()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (k *Keeper) GetAllTradingHalts(ctx sdk.Context) []types.TradingHalt {
+	res := make([]types.TradingHalt, 0)
+
+	for _, prefix := range [][]byte{types.GetDenomHaltPrefix(), types.GetInstrumentHaltPrefix()} {
+		it := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), prefix)
+
+		for ; it.Valid(); it.Next() {
+			var halt types.TradingHalt
+			k.cdc.MustUnmarshalBinaryBare(it.Value(), &halt)
+			res = append(res, halt)
+		}
+
+		it.Close()
+	}
+
+	return res
+}
+
+func (k *Keeper) getTradingHalt(ctx sdk.Context, key []byte) *types.TradingHalt {
+	bz := ctx.KVStore(k.key).Get(key)
+	if bz == nil {
+		return nil
+	}
+
+	halt := new(types.TradingHalt)
+	k.cdc.MustUnmarshalBinaryBare(bz, halt)
+	return halt
+}
+
+func (k *Keeper) setTradingHalt(ctx sdk.Context, halt types.TradingHalt) {
+	ctx.KVStore(k.key).Set(halt.Key(), k.cdc.MustMarshalBinaryBare(&halt))
+}
+
+// Remove the halts that have reached their expiry.
+func (k *Keeper) liftExpiredHalts(ctx sdk.Context) {
+	store := ctx.KVStore(k.key)
+
+	for _, halt := range k.GetAllTradingHalts(ctx) {
+		if !halt.IsActive(ctx.BlockTime()) {
+			store.Delete(halt.Key())
+		}
+	}
+}
+
+func (k *Keeper) cancelHaltedOrders(ctx sdk.Context, halt types.TradingHalt) {
+	var orders []*types.Order
+
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.keyIndices), types.GetPriorityKeyPrefix())
+	for ; it.Valid(); it.Next() {
+		o := new(types.Order)
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), o)
+
+		if halt.Matches(o.Source.Denom, o.Destination.Denom) {
+			orders = append(orders, o)
+		}
+	}
+	it.Close()
+
+	// Orders are removed once the iterator is closed, as deleting keys while iterating is not supported.
+	for _, o := range orders {
+		types.EmitExpireEvent(ctx, *o)
+		k.deleteOrder(ctx, o)
+	}
+}
@@ -0,0 +1,101 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHaltInstrument(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	resting := order(ctx.BlockTime(), acc1, "1000eur", "1200usd")
+	require.NoError(t, k.NewOrderSingle(ctx, resting))
+
+	halt := types.NewInstrumentHalt("usd", "eur")
+	require.Error(t, k.HaltTrading(ctx, randomAddress(), halt, false))
+	require.ErrorIs(t, k.HaltTrading(ctx, testAuthority, types.NewInstrumentHalt("eur", "eur"), false), types.ErrInvalidTradingHalt)
+	require.NoError(t, k.HaltTrading(ctx, testAuthority, halt, false))
+
+	// Both directions of the instrument are halted
+	require.True(t, k.IsTradingHalted(ctx, "eur", "usd"))
+	require.True(t, k.IsTradingHalted(ctx, "usd", "eur"))
+	require.False(t, k.IsTradingHalted(ctx, "eur", "chf"))
+
+	err := k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "1200usd", "1000eur"))
+	require.ErrorIs(t, err, types.ErrTradingHalted)
+	err = k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "1000eur", "1300usd"))
+	require.ErrorIs(t, err, types.ErrTradingHalted)
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "1000eur", "1100chf")))
+
+	// Resting orders are kept unless canceled
+	require.NotNil(t, k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), resting.ClientOrderID))
+	res, err := k.TradingHalts(sdk.WrapSDKContext(ctx), &types.QueryTradingHaltsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.TradingHalt{halt}, res.Halts)
+
+	require.Error(t, k.ResumeTrading(ctx, randomAddress(), halt))
+	require.NoError(t, k.ResumeTrading(ctx, testAuthority, types.NewInstrumentHalt("eur", "usd")))
+	require.ErrorIs(t, k.ResumeTrading(ctx, testAuthority, halt), types.ErrTradingHaltNotFound)
+	require.Empty(t, k.GetAllTradingHalts(ctx))
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "1200usd", "1000eur")))
+	require.Nil(t, k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), resting.ClientOrderID))
+}
+
+func TestHaltDenomCancelsOrders(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur,10000chf")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "1000eur", "1200usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "500usd", "500eur")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "1000chf", "1100usd")))
+	require.Len(t, k.GetAllOrders(ctx), 3)
+
+	require.NoError(t, k.HaltTrading(ctx, testAuthority, types.NewDenomHalt("eur"), true))
+	require.True(t, findEventAttr(ctx, "expire"))
+
+	orders := k.GetAllOrders(ctx)
+	require.Len(t, orders, 1)
+	require.Equal(t, "chf", orders[0].Source.Denom)
+
+	msg, broken := AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+
+	require.True(t, k.IsTradingHalted(ctx, "chf", "eur"))
+	require.False(t, k.IsTradingHalted(ctx, "chf", "usd"))
+
+	// An instrument halted through one of its denominations stays halted when its own halt is lifted
+	require.NoError(t, k.HaltTrading(ctx, testAuthority, types.NewInstrumentHalt("eur", "usd"), false))
+	require.NoError(t, k.ResumeTrading(ctx, testAuthority, types.NewInstrumentHalt("eur", "usd")))
+	require.True(t, k.IsTradingHalted(ctx, "eur", "usd"))
+
+	require.NoError(t, k.ResumeTrading(ctx, testAuthority, types.NewDenomHalt("eur")))
+	require.False(t, k.IsTradingHalted(ctx, "eur", "usd"))
+}
+
+func TestHaltSyntheticRoute(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "500eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "1000chf")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "500eur", "1000chf")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "1000chf", "1000usd")))
+
+	require.NoError(t, k.HaltTrading(ctx, testAuthority, types.NewInstrumentHalt("eur", "chf"), false))
+
+	// The usd/eur book is open, but its only route passes through the halted instrument
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "1000usd", "500eur")))
+	require.Equal(t, "5000usd", bk.GetAllBalances(ctx, acc3.GetAddress()).String())
+	require.Len(t, k.GetAllOrders(ctx), 3)
+}
@@ -0,0 +1,83 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// SetInstrumentRules replaces the rules of an instrument on behalf of the authority. Rules without any constraint are removed.
+func (k *Keeper) SetInstrumentRules(ctx sdk.Context, authority sdk.AccAddress, rules types.InstrumentRules) error {
+	if err := k.authority.ValidateAuthority(ctx, authority); err != nil {
+		return err
+	}
+
+	if err := rules.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidInstrumentRules, err.Error())
+	}
+
+	k.setInstrumentRules(ctx, rules)
+	return nil
+}
+
+// GetInstrumentRules returns the rules of the instrument, or nil if its orders are not constrained.
+func (k *Keeper) GetInstrumentRules(ctx sdk.Context, src, dst string) *types.InstrumentRules {
+	bz := ctx.KVStore(k.key).Get(types.GetInstrumentRulesKey(src, dst))
+	if bz == nil {
+		return nil
+	}
+
+	rules := new(types.InstrumentRules)
+	k.cdc.MustUnmarshalBinaryBare(bz, rules)
+	return rules
+}
+
+func (k *Keeper) GetAllInstrumentRules(ctx sdk.Context) []types.InstrumentRules {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.GetInstrumentRulesPrefix())
+	defer it.Close()
+
+	res := make([]types.InstrumentRules, 0)
+	for ; it.Valid(); it.Next() {
+		var rules types.InstrumentRules
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &rules)
+		res = append(res, rules)
+	}
+
+	return res
+}
+
+func (k *Keeper) setInstrumentRules(ctx sdk.Context, rules types.InstrumentRules) {
+	store := ctx.KVStore(k.key)
+	key := types.GetInstrumentRulesKey(rules.Source, rules.Destination)
+
+	if rules.IsEmpty() {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, k.cdc.MustMarshalBinaryBare(&rules))
+}
+
+func (k *Keeper) validateInstrumentRules(ctx sdk.Context, order types.Order) error {
+	rules := k.GetInstrumentRules(ctx, order.Source.Denom, order.Destination.Denom)
+	if rules == nil {
+		return nil
+	}
+
+	return rules.ValidateOrder(order)
+}
+
+// Round a source amount derived from a slippage down to the lot size of the instrument, so the order does not
+// exceed the slippage.
+func (k *Keeper) roundToLotSize(ctx sdk.Context, source sdk.Coin, dstDenom string) sdk.Coin {
+	rules := k.GetInstrumentRules(ctx, source.Denom, dstDenom)
+	if rules == nil || !rules.LotSize.IsPositive() {
+		return source
+	}
+
+	return sdk.NewCoin(source.Denom, source.Amount.Sub(source.Amount.Mod(rules.LotSize)))
+}
@@ -0,0 +1,133 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstrumentRulesEnforced(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc := createAccount(ctx, ak, bk, randomAddress(), "100000eur")
+
+	rules := types.NewInstrumentRules("eur", "usd", sdk.NewDecWithPrec(5, 2), sdk.NewInt(1000), sdk.NewInt(100))
+	require.NoError(t, k.SetInstrumentRules(ctx, testAuthority, rules))
+
+	specs := map[string]struct {
+		src, dst string
+		tif      types.TimeInForce
+		expErr   error
+	}{
+		"all good":                 {src: "1000eur", dst: "1200usd", tif: types.TimeInForce_GoodTillCancel},
+		"below minimum size":       {src: "900eur", dst: "1080usd", tif: types.TimeInForce_GoodTillCancel, expErr: types.ErrOrderBelowMinimumSize},
+		"not a multiple of lot":    {src: "1050eur", dst: "1260usd", tif: types.TimeInForce_GoodTillCancel, expErr: types.ErrInvalidLotSize},
+		"price off tick":           {src: "1000eur", dst: "1210usd", tif: types.TimeInForce_GoodTillCancel, expErr: types.ErrInvalidTickSize},
+		"immediate order off tick": {src: "1000eur", dst: "1210usd", tif: types.TimeInForce_ImmediateOrCancel},
+		"fill or kill below minimum": {
+			src: "500eur", dst: "600usd", tif: types.TimeInForce_FillOrKill, expErr: types.ErrOrderBelowMinimumSize,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			o, err := types.NewOrder(ctx.BlockTime(), spec.tif, coin(spec.src), coin(spec.dst), acc.GetAddress(), cid())
+			require.NoError(t, err)
+
+			err = k.NewOrderSingle(ctx, o)
+			if spec.expErr != nil {
+				require.ErrorIs(t, err, spec.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// The rules only apply to the eur/usd book
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc, "1eur", "1chf")))
+}
+
+func TestInstrumentRulesCancelReplace(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	orig := order(ctx.BlockTime(), acc, "1000eur", "1200usd")
+	require.NoError(t, k.NewOrderSingle(ctx, orig))
+
+	rules := types.NewInstrumentRules("eur", "usd", sdk.NewDecWithPrec(1, 1), sdk.ZeroInt(), sdk.NewInt(100))
+	require.NoError(t, k.SetInstrumentRules(ctx, testAuthority, rules))
+
+	// A rejected replacement leaves the original order on the book
+	_, err := k.CancelReplaceLimitOrder(ctx, order(ctx.BlockTime(), acc, "1050eur", "1260usd"), orig.ClientOrderID)
+	require.ErrorIs(t, err, types.ErrInvalidLotSize)
+	require.NotNil(t, k.GetOrderByOwnerAndClientOrderId(ctx, acc.GetAddress().String(), orig.ClientOrderID))
+
+	replacement := order(ctx.BlockTime(), acc, "1100eur", "1210usd")
+	_, err = k.CancelReplaceLimitOrder(ctx, replacement, orig.ClientOrderID)
+	require.NoError(t, err)
+	require.Nil(t, k.GetOrderByOwnerAndClientOrderId(ctx, acc.GetAddress().String(), orig.ClientOrderID))
+	require.NotNil(t, k.GetOrderByOwnerAndClientOrderId(ctx, acc.GetAddress().String(), replacement.ClientOrderID))
+}
+
+func TestInstrumentRulesStopOrders(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	rules := types.NewInstrumentRules("eur", "usd", sdk.NewDecWithPrec(1, 1), sdk.NewInt(100), sdk.ZeroInt())
+	require.NoError(t, k.SetInstrumentRules(ctx, testAuthority, rules))
+
+	err := k.AddStopOrder(ctx, stopOrder(ctx, acc, types.StopOrderType_Limit, "50eur", "60usd", "1.1", "0"))
+	require.ErrorIs(t, err, types.ErrOrderBelowMinimumSize)
+
+	err = k.AddStopOrder(ctx, stopOrder(ctx, acc, types.StopOrderType_Limit, "100eur", "125usd", "1.1", "0"))
+	require.ErrorIs(t, err, types.ErrInvalidTickSize)
+
+	require.NoError(t, k.AddStopOrder(ctx, stopOrder(ctx, acc, types.StopOrderType_Limit, "100eur", "120usd", "1.1", "0")))
+}
+
+func TestInstrumentRulesSlippageRoundedToLotSize(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	// Establish a last price of 1.2 usd per eur
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "120usd", "100eur")))
+
+	src, err := k.GetSrcFromSlippage(ctx, "usd", coin("1000eur"), sdk.NewDecWithPrec(5, 2))
+	require.NoError(t, err)
+	require.Equal(t, "1260usd", src.String())
+
+	rules := types.NewInstrumentRules("usd", "eur", sdk.ZeroDec(), sdk.ZeroInt(), sdk.NewInt(100))
+	require.NoError(t, k.SetInstrumentRules(ctx, testAuthority, rules))
+
+	src, err = k.GetSrcFromSlippage(ctx, "usd", coin("1000eur"), sdk.NewDecWithPrec(5, 2))
+	require.NoError(t, err)
+	require.Equal(t, "1200usd", src.String())
+}
+
+func TestSetInstrumentRulesAuthorization(t *testing.T) {
+	ctx, k, _, _ := createTestComponents(t)
+
+	rules := types.NewInstrumentRules("eur", "usd", sdk.NewDecWithPrec(1, 2), sdk.NewInt(10), sdk.NewInt(10))
+	require.Error(t, k.SetInstrumentRules(ctx, randomAddress(), rules))
+	require.Nil(t, k.GetInstrumentRules(ctx, "eur", "usd"))
+
+	invalid := types.NewInstrumentRules("eur", "usd", sdk.NewDec(-1), sdk.ZeroInt(), sdk.ZeroInt())
+	require.ErrorIs(t, k.SetInstrumentRules(ctx, testAuthority, invalid), types.ErrInvalidInstrumentRules)
+
+	require.NoError(t, k.SetInstrumentRules(ctx, testAuthority, rules))
+	require.Equal(t, &rules, k.GetInstrumentRules(ctx, "eur", "usd"))
+	require.Nil(t, k.GetInstrumentRules(ctx, "usd", "eur"))
+	require.Len(t, k.GetAllInstrumentRules(ctx), 1)
+
+	// Rules without any constraint remove the entry
+	empty := types.NewInstrumentRules("eur", "usd", sdk.ZeroDec(), sdk.ZeroInt(), sdk.ZeroInt())
+	require.NoError(t, k.SetInstrumentRules(ctx, testAuthority, empty))
+	require.Nil(t, k.GetInstrumentRules(ctx, "eur", "usd"))
+	require.Empty(t, k.GetAllInstrumentRules(ctx))
+}
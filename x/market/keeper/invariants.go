@@ -0,0 +1,234 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// RegisterInvariants registers the market module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "order-indices", OrderIndicesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "resting-order-balances", RestingOrderBalancesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "priority-prices", PriorityPricesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the market module.
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			OrderIndicesInvariant(k),
+			RestingOrderBalancesInvariant(k),
+			PriorityPricesInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+
+		return "", false
+	}
+}
+
+// OrderIndicesInvariant checks that the owner store and the priority index hold exactly the same orders, and that the
+// order ID index and the owner denomination index refer to each of them.
+func OrderIndicesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		ownerOrders := make(map[uint64][]byte)
+		ownerIt := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.GetOwnersPrefix())
+		defer ownerIt.Close()
+
+		for ; ownerIt.Valid(); ownerIt.Next() {
+			o := new(types.Order)
+			k.cdc.MustUnmarshalBinaryBare(ownerIt.Value(), o)
+
+			if !bytes.Equal(ownerIt.Key(), types.GetOwnerKey(o.Owner, o.ClientOrderID)) {
+				msg += fmt.Sprintf("\torder %v is stored under the owner key of another order\n", o.ID)
+				broken = true
+			}
+
+			if _, found := ownerOrders[o.ID]; found {
+				msg += fmt.Sprintf("\torder %v appears more than once in the owner store\n", o.ID)
+				broken = true
+			}
+			ownerOrders[o.ID] = ownerIt.Value()
+		}
+
+		indexed := 0
+		priorityIt := sdk.KVStorePrefixIterator(ctx.KVStore(k.keyIndices), types.GetPriorityKeyPrefix())
+		defer priorityIt.Close()
+
+		for ; priorityIt.Valid(); priorityIt.Next() {
+			o := new(types.Order)
+			k.cdc.MustUnmarshalBinaryBare(priorityIt.Value(), o)
+			indexed++
+
+			bz, found := ownerOrders[o.ID]
+			switch {
+			case !found:
+				msg += fmt.Sprintf("\torder %v is missing from the owner store\n", o.ID)
+				broken = true
+			case !bytes.Equal(bz, priorityIt.Value()):
+				msg += fmt.Sprintf("\torder %v differs between the owner store and the priority index\n", o.ID)
+				broken = true
+			}
+		}
+
+		if indexed != len(ownerOrders) {
+			msg += fmt.Sprintf("\towner store holds %v orders, priority index holds %v\n", len(ownerOrders), indexed)
+			broken = true
+		}
+
+		indexed = 0
+		idIt := sdk.KVStorePrefixIterator(ctx.KVStore(k.keyIndices), types.GetOrderIDPrefix())
+		defer idIt.Close()
+
+		for ; idIt.Valid(); idIt.Next() {
+			indexed++
+
+			bz := ctx.KVStore(k.key).Get(idIt.Value())
+			if bz == nil {
+				msg += fmt.Sprintf("\torder id index refers to missing owner key %x\n", idIt.Value())
+				broken = true
+				continue
+			}
+
+			o := new(types.Order)
+			k.cdc.MustUnmarshalBinaryBare(bz, o)
+			if !bytes.Equal(idIt.Key(), types.GetOrderIDKey(o.ID)) {
+				msg += fmt.Sprintf("\torder %v is indexed under another order id\n", o.ID)
+				broken = true
+			}
+		}
+
+		if indexed != len(ownerOrders) {
+			msg += fmt.Sprintf("\towner store holds %v orders, order id index holds %v\n", len(ownerOrders), indexed)
+			broken = true
+		}
+
+		indexed = 0
+		denomIt := sdk.KVStorePrefixIterator(ctx.KVStore(k.keyIndices), types.GetOwnerDenomPrefix())
+		defer denomIt.Close()
+
+		for ; denomIt.Valid(); denomIt.Next() {
+			indexed++
+
+			bz := ctx.KVStore(k.key).Get(denomIt.Value())
+			if bz == nil {
+				msg += fmt.Sprintf("\towner denomination index refers to missing owner key %x\n", denomIt.Value())
+				broken = true
+				continue
+			}
+
+			o := new(types.Order)
+			k.cdc.MustUnmarshalBinaryBare(bz, o)
+			if !bytes.Equal(denomIt.Key(), types.GetOwnerDenomKey(o.Owner, o.Source.Denom, o.ID)) {
+				msg += fmt.Sprintf("\torder %v is indexed under another owner or denomination\n", o.ID)
+				broken = true
+			}
+		}
+
+		if indexed != len(ownerOrders) {
+			msg += fmt.Sprintf("\towner store holds %v orders, owner denomination index holds %v\n", len(ownerOrders), indexed)
+			broken = true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "order indices",
+			fmt.Sprintf("owner store and priority index hold the same orders\n%s", msg)), broken
+	}
+}
+
+// RestingOrderBalancesInvariant checks that the resting orders of an account in an instrument do not sell more than
+// the account's spendable balance.
+func RestingOrderBalancesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		// The owner store is sorted by owner, so the orders of an account are adjacent.
+		orders := k.GetAllOrders(ctx)
+		for start := 0; start < len(orders); {
+			owner := orders[start].Owner
+
+			end := start
+			demand := make(map[instrumentKey]sdk.Int)
+			var instruments []instrumentKey
+			for ; end < len(orders) && orders[end].Owner == owner; end++ {
+				o := orders[end]
+				instrument := instrumentKey{o.Source.Denom, o.Destination.Denom}
+				if _, found := demand[instrument]; !found {
+					demand[instrument] = sdk.ZeroInt()
+					instruments = append(instruments, instrument)
+				}
+				demand[instrument] = demand[instrument].Add(o.SourceRemaining)
+			}
+
+			addr, err := sdk.AccAddressFromBech32(owner)
+			if err != nil {
+				msg += fmt.Sprintf("\torders are owned by invalid address %q\n", owner)
+				broken = true
+				start = end
+				continue
+			}
+
+			spendable := k.bk.SpendableCoins(ctx, addr)
+			for _, instrument := range instruments {
+				if balance := spendable.AmountOf(instrument.src); demand[instrument].GT(balance) {
+					msg += fmt.Sprintf("\t%v sells %v%v in %v/%v but can spend %v%v\n",
+						owner, demand[instrument], instrument.src, instrument.src, instrument.dst, balance, instrument.src)
+					broken = true
+				}
+			}
+
+			start = end
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "resting order balances",
+			fmt.Sprintf("resting orders are covered by spendable balances\n%s", msg)), broken
+	}
+}
+
+// PriorityPricesInvariant checks that every priority key encodes the instrument, price and time priority of the order it
+// holds.
+func PriorityPricesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		it := sdk.KVStorePrefixIterator(ctx.KVStore(k.keyIndices), types.GetPriorityKeyPrefix())
+		defer it.Close()
+
+		for ; it.Valid(); it.Next() {
+			o := new(types.Order)
+			k.cdc.MustUnmarshalBinaryBare(it.Value(), o)
+
+			if !bytes.Equal(it.Key(), types.GetPriorityKey(o.Source.Denom, o.Destination.Denom, o.Price(), o.TimePriority())) {
+				msg += fmt.Sprintf("\torder %v is not indexed at its price %v\n", o.ID, o.Price())
+				broken = true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "priority prices",
+			fmt.Sprintf("priority keys match the order prices\n%s", msg)), broken
+	}
+}
+
+// Identifies the book of an instrument. Denominations may contain slashes, so they are not joined into a string.
+type instrumentKey struct {
+	src, dst string
+}
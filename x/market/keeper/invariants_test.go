@@ -0,0 +1,111 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvariantsHold(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "3000eur", "3600usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "5000eur", "6500usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "10000eur", "11000chf")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "1200usd", "1000eur")))
+
+	// Withdrawing shrinks the orders of an instrument together
+	require.NoError(t, bk.SendCoins(ctx, acc1.GetAddress(), acc2.GetAddress(), coins("4000eur")))
+
+	msg, broken := AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestOrderIndicesInvariant(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	o := order(ctx.BlockTime(), acc, "1000eur", "1200usd")
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+	_, broken := OrderIndicesInvariant(k)(ctx)
+	require.False(t, broken)
+
+	stored := k.GetOrderByOwnerAndClientOrderId(ctx, acc.GetAddress().String(), o.ClientOrderID)
+	ctx.KVStore(k.keyIndices).Delete(types.GetPriorityKey(stored.Source.Denom, stored.Destination.Denom, stored.Price(), stored.ID))
+
+	_, broken = OrderIndicesInvariant(k)(ctx)
+	require.True(t, broken)
+}
+
+func TestOrderIDIndexInvariant(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	o := order(ctx.BlockTime(), acc, "1000eur", "1200usd")
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+
+	stored := k.GetOrderByOwnerAndClientOrderId(ctx, acc.GetAddress().String(), o.ClientOrderID)
+	ctx.KVStore(k.keyIndices).Delete(types.GetOrderIDKey(stored.ID))
+
+	_, broken := OrderIndicesInvariant(k)(ctx)
+	require.True(t, broken)
+}
+
+func TestOwnerDenomIndexInvariant(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	o := order(ctx.BlockTime(), acc, "1000eur", "1200usd")
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+
+	stored := k.GetOrderByOwnerAndClientOrderId(ctx, acc.GetAddress().String(), o.ClientOrderID)
+	ctx.KVStore(k.keyIndices).Delete(types.GetOwnerDenomKey(stored.Owner, stored.Source.Denom, stored.ID))
+
+	_, broken := OrderIndicesInvariant(k)(ctx)
+	require.True(t, broken)
+}
+
+func TestPriorityPricesInvariant(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	o := order(ctx.BlockTime(), acc, "1000eur", "1200usd")
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+	_, broken := PriorityPricesInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// Move the order to another price level
+	stored := k.GetOrderByOwnerAndClientOrderId(ctx, acc.GetAddress().String(), o.ClientOrderID)
+	idxStore := ctx.KVStore(k.keyIndices)
+	key := types.GetPriorityKey(stored.Source.Denom, stored.Destination.Denom, stored.Price(), stored.ID)
+	bz := idxStore.Get(key)
+	idxStore.Delete(key)
+	idxStore.Set(types.GetPriorityKey(stored.Source.Denom, stored.Destination.Denom, sdk.OneDec(), stored.ID), bz)
+
+	_, broken = PriorityPricesInvariant(k)(ctx)
+	require.True(t, broken)
+}
+
+func TestRestingOrderBalancesInvariant(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc, "4000eur", "4800usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc, "6000eur", "7200usd")))
+	_, broken := RestingOrderBalancesInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// Setting the balance directly bypasses the balance listener
+	require.NoError(t, bk.SetBalances(ctx, acc.GetAddress(), coins("9000eur")))
+
+	_, broken = RestingOrderBalancesInvariant(k)(ctx)
+	require.True(t, broken)
+}
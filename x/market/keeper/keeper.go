@@ -13,7 +13,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/e-money/em-ledger/x/market/types"
 )
 
@@ -22,6 +24,13 @@ const (
 	gasPriceNewOrder           = uint64(25000)
 	gasPriceCancelReplaceOrder = uint64(25000)
 	gasPriceCancelOrder        = uint64(12500)
+	gasPriceCancelAllOrders    = uint64(25000)
+
+	// The number of triggered stop orders sent to the market by a transaction, so the work it pays for stays bounded.
+	// Stop orders beyond it remain queued until the end of the block.
+	maxTriggeredStopOrdersPerTx = 10
+	// The number of triggered stop orders sent to the market at the end of a block. The rest wait for the next block.
+	maxTriggeredStopOrdersPerBlock = 500
 )
 
 var _ marketKeeper = &Keeper{}
@@ -30,21 +39,43 @@ type Keeper struct {
 	key        sdk.StoreKey
 	keyIndices sdk.StoreKey
 	cdc        codec.BinaryMarshaler
+	paramSpace paramtypes.Subspace
 	// instruments types.Instruments
 	ak types.AccountKeeper
 	bk types.BankKeeper
+	sk types.StakingKeeper
+	// Validates the signer of fee changes
+	authority types.AuthorityKeeper
+
+	// Module accounts receiving trading fees paid in stablecoins and in the staking token respectively
+	coinTokenFeeDestination    string
+	stakingTokenFeeDestination string
 
 	// accountOrders types.Orders
 	appstateInit *sync.Once
 }
 
-func NewKeeper(cdc codec.BinaryMarshaler, key sdk.StoreKey, keyIndices sdk.StoreKey, authKeeper types.AccountKeeper, bankKeeper types.BankKeeper) *Keeper {
+func NewKeeper(
+	cdc codec.BinaryMarshaler, key sdk.StoreKey, keyIndices sdk.StoreKey, paramSpace paramtypes.Subspace,
+	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, stakingKeeper types.StakingKeeper, authorityKeeper types.AuthorityKeeper,
+	coinTokenFeeDestination, stakingTokenFeeDestination string,
+) *Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	k := &Keeper{
 		cdc:        cdc,
 		key:        key,
 		keyIndices: keyIndices,
+		paramSpace: paramSpace,
 		ak:         authKeeper,
 		bk:         bankKeeper,
+		sk:         stakingKeeper,
+		authority:  authorityKeeper,
+
+		coinTokenFeeDestination:    coinTokenFeeDestination,
+		stakingTokenFeeDestination: stakingTokenFeeDestination,
 
 		appstateInit: new(sync.Once),
 	}
@@ -53,56 +84,88 @@ func NewKeeper(cdc codec.BinaryMarshaler, key sdk.StoreKey, keyIndices sdk.Store
 	return k
 }
 
+// A route from the source denomination of an execution plan through a sequence of passive orders.
+type route struct {
+	// Units of the route's last denomination paid per unit of the source denomination
+	cost   sdk.Dec
+	orders []*types.Order
+}
+
+func (r route) visits(denom string) bool {
+	for _, o := range r.orders {
+		if o.Destination.Denom == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Find the cheapest route of at most MaxHops passive orders that buys SourceDenom with DestinationDenom. Only the best
+// order of each instrument is considered. Each hop extends the routes found by the previous one along every
+// instrument of the order book, so the search is bounded by MaxHops times the number of instruments.
 func (k *Keeper) createExecutionPlan(ctx sdk.Context, SourceDenom, DestinationDenom string) types.ExecutionPlan {
 	bestPlan := types.ExecutionPlan{
 		Price: sdk.NewDec(math.MaxInt64),
 	}
 
-	instruments := k.GetInstruments(ctx)
-
-	for _, firstInstrument := range instruments {
-		//_, firstDenom := types.MustParseInstrumentKey(firstIt.Key())
-
-		if firstInstrument.Source != SourceDenom {
+	var bestOrders []*types.Order
+	for _, instrument := range k.GetInstruments(ctx) {
+		// Routes never return to the source denomination nor continue past the destination denomination.
+		if instrument.Destination == SourceDenom || instrument.Source == DestinationDenom {
 			continue
 		}
 
-		// firstPassiveOrder := firstInstrument.Orders.LeftKey().(*types.Order)
-		firstPassiveOrder := k.getBestOrder(ctx, SourceDenom, firstInstrument.Destination)
-		if firstPassiveOrder == nil {
+		// Halted instruments cannot be part of a synthetic route either, nor can instruments that only trade in auctions.
+		if k.IsTradingHalted(ctx, instrument.Source, instrument.Destination) ||
+			k.IsBatchAuction(ctx, instrument.Source, instrument.Destination) {
 			continue
 		}
 
-		// Check direct price
-		if firstInstrument.Destination == DestinationDenom {
-			// Direct price is better than current plan
+		if o := k.getBestOrder(ctx, instrument.Source, instrument.Destination); o != nil {
+			bestOrders = append(bestOrders, o)
+		}
+	}
 
-			planPrice := sdk.OneDec().Quo(firstPassiveOrder.Price())
-			planPrice = planPrice.Add(sdk.NewDecWithPrec(1, sdk.Precision)) // Add floating point epsilon
-			if planPrice.LT(bestPlan.Price) {
-				bestPlan = types.ExecutionPlan{
-					Price:      planPrice,
-					FirstOrder: firstPassiveOrder,
-				}
+	cheapest := map[string]route{SourceDenom: {cost: sdk.OneDec()}}
+	previousHop := map[string]route{SourceDenom: cheapest[SourceDenom]}
+
+	maxHops := k.GetParams(ctx).MaxHops
+	for hop := uint32(0); hop < maxHops && len(previousHop) > 0; hop++ {
+		nextHop := make(map[string]route)
+
+		for _, o := range bestOrders {
+			r, found := previousHop[o.Source.Denom]
+			if !found || r.visits(o.Destination.Denom) {
+				continue
+			}
+
+			cost := r.cost.Mul(o.Price())
+			if c, found := cheapest[o.Destination.Denom]; found && !cost.LT(c.cost) {
+				continue
+			}
+			if c, found := nextHop[o.Destination.Denom]; found && !cost.LT(c.cost) {
+				continue
 			}
+
+			orders := make([]*types.Order, len(r.orders), len(r.orders)+1)
+			copy(orders, r.orders)
+			nextHop[o.Destination.Denom] = route{cost: cost, orders: append(orders, o)}
 		}
 
-		// Check synthetic price by going through two orders:
-		// (SourceDenom, X) -> (X, DestinationDenom)
-		secondPassiveOrder := k.getBestOrder(ctx, firstInstrument.Destination, DestinationDenom)
-		if secondPassiveOrder == nil {
-			continue
+		for denom, r := range nextHop {
+			cheapest[denom] = r
 		}
+		previousHop = nextHop
+	}
 
-		planPrice := sdk.OneDec().Quo(firstPassiveOrder.Price().Mul(secondPassiveOrder.Price()))
+	if r, found := cheapest[DestinationDenom]; found {
+		planPrice := sdk.OneDec().Quo(r.cost)
 		planPrice = planPrice.Add(sdk.NewDecWithPrec(1, sdk.Precision)) // Add floating point epsilon
 
-		if planPrice.LT(bestPlan.Price) {
-			bestPlan = types.ExecutionPlan{
-				Price:       planPrice,
-				FirstOrder:  firstPassiveOrder,
-				SecondOrder: secondPassiveOrder,
-			}
+		bestPlan = types.ExecutionPlan{
+			Price:  planPrice,
+			Orders: r.orders,
 		}
 	}
 
@@ -136,23 +199,46 @@ func (k *Keeper) GetSrcFromSlippage(
 	source = source.Mul(sdk.NewDec(1).Add(maxSlippage))
 
 	slippageSource := sdk.NewCoin(srcDenom, source.RoundInt())
-	return slippageSource, nil
+	return k.roundToLotSize(ctx, slippageSource, dst.Denom), nil
 }
 
+// NewOrderSingle accepts an order like PlaceOrder, for callers that do not need its result.
 func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) error {
-	// save caller's event manager
-	retEvManager := ctx.EventManager()
+	_, err := k.PlaceOrder(ctx, aggressiveOrder)
+	return err
+}
 
+// PlaceOrder matches an order against the book and rests any remainder, reporting the status, fills and fee of the
+// order.
+func (k *Keeper) PlaceOrder(ctx sdk.Context, aggressiveOrder types.Order) (*types.OrderResult, error) {
 	// Use a fixed gas amount
 	ctx.GasMeter().ConsumeGas(gasPriceNewOrder, "NewOrderSingle")
 	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
 
+	result, err := k.executeOrder(ctx, aggressiveOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	// The trades may have moved the last price of instruments through the stop price of parked stop orders.
+	k.executeTriggeredStopOrders(ctx, maxTriggeredStopOrdersPerTx)
+	return result, nil
+}
+
+func (k *Keeper) executeOrder(ctx sdk.Context, aggressiveOrder types.Order) (*types.OrderResult, error) {
+	// save caller's event manager
+	retEvManager := ctx.EventManager()
+
 	// Set this to true to roll back any state changes made by the aggressive order. Used for FillOrKill orders.
 	KillOrder := false
+	// Set once the order has been accepted. The state changes of a rejected order are rolled back as well.
+	accepted := false
+	// Price band breaches are recorded outside the cache, so they count even when the order is killed.
+	parentCtx := ctx
 	ctx, commitTrade := ctx.CacheContext()
 
 	defer func() {
-		if KillOrder {
+		if !accepted || KillOrder {
 			return
 		}
 
@@ -160,25 +246,51 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 	}()
 
 	if err := aggressiveOrder.IsValid(); err != nil {
-		return err
+		return nil, err
+	}
+
+	if err := k.validateInstrumentRules(ctx, aggressiveOrder); err != nil {
+		return nil, err
+	}
+
+	if k.IsTradingHalted(ctx, aggressiveOrder.Source.Denom, aggressiveOrder.Destination.Denom) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrTradingHalted, "%v/%v", aggressiveOrder.Source.Denom, aggressiveOrder.Destination.Denom,
+		)
+	}
+
+	// Orders of batch auction instruments are matched at the end of the block, so they cannot be guaranteed to fill
+	// immediately nor to rest without taking liquidity.
+	batchAuction := k.IsBatchAuction(ctx, aggressiveOrder.Source.Denom, aggressiveOrder.Destination.Denom)
+	if batchAuction && (aggressiveOrder.TimeInForce == types.TimeInForce_FillOrKill || aggressiveOrder.PostOnly != types.PostOnlyMode_None) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrNotSupportedInBatchAuction, "%v/%v", aggressiveOrder.Source.Denom, aggressiveOrder.Destination.Denom,
+		)
 	}
 
 	if aggressiveOrder.IsFilled() {
-		return sdkerrors.Wrapf(
+		return nil, sdkerrors.Wrapf(
 			types.ErrInvalidPrice, "Order price is invalid: %s -> %s",
 			aggressiveOrder.Source, aggressiveOrder.Destination,
 		)
 	}
 
+	if aggressiveOrder.IsExpired(ctx.BlockTime(), ctx.BlockHeight()) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrInvalidExpiry, "Order expired before it could be accepted: %v %v",
+			aggressiveOrder.ExpireTime, aggressiveOrder.ExpireHeight,
+		)
+	}
+
 	owner, err := sdk.AccAddressFromBech32(aggressiveOrder.Owner)
 	if err != nil {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner")
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner")
 	}
 	spendableCoins := k.bk.SpendableCoins(ctx, owner)
 
 	// Verify account balance
 	if _, anyNegative := spendableCoins.SafeSub(sdk.NewCoins(aggressiveOrder.Source)); anyNegative {
-		return sdkerrors.Wrapf(
+		return nil, sdkerrors.Wrapf(
 			types.ErrAccountBalanceInsufficient,
 			"Account %v has insufficient balance to execute trade: %v < %v",
 			owner,
@@ -194,28 +306,126 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 	totalSourceDemand = totalSourceDemand.Add(aggressiveOrder.Source)
 	if _, anyNegative := spendableCoins.SafeSub(sdk.NewCoins(totalSourceDemand)); anyNegative {
 		// TODO Improve message
-		return sdkerrors.Wrapf(types.ErrAccountBalanceInsufficientForInstrument, "")
+		return nil, sdkerrors.Wrapf(types.ErrAccountBalanceInsufficientForInstrument, "")
 	}
 
 	// Verify uniqueness of client order id among active orders
 	if containsClientId(accountOrders, aggressiveOrder.ClientOrderID) {
-		return sdkerrors.Wrap(types.ErrNonUniqueClientOrderId, aggressiveOrder.ClientOrderID)
+		return nil, sdkerrors.Wrap(types.ErrNonUniqueClientOrderId, aggressiveOrder.ClientOrderID)
+	}
+
+	// A parked stop order will become an active order with its client order id once triggered
+	if k.GetStopOrderByOwnerAndClientOrderId(ctx, aggressiveOrder.Owner, aggressiveOrder.ClientOrderID) != nil {
+		return nil, sdkerrors.Wrap(types.ErrNonUniqueClientOrderId, aggressiveOrder.ClientOrderID)
+	}
+
+	// Immediate orders never rest on the book, unless they wait for the batch auction of their instrument.
+	immediate := aggressiveOrder.TimeInForce == types.TimeInForce_ImmediateOrCancel || aggressiveOrder.TimeInForce == types.TimeInForce_FillOrKill
+	if !immediate || batchAuction {
+		err := k.validateOrderLimits(ctx, owner, aggressiveOrder.Source.Denom, aggressiveOrder.Destination.Denom, accountOrders)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Verify that the destination asset actually exists on chain before creating an instrument
 	if !k.assetExists(ctx, aggressiveOrder.Destination) {
-		return sdkerrors.Wrap(types.ErrUnknownAsset, aggressiveOrder.Destination.Denom)
+		return nil, sdkerrors.Wrap(types.ErrUnknownAsset, aggressiveOrder.Destination.Denom)
 	}
 	k.registerMarketData(ctx, aggressiveOrder.Source.Denom, aggressiveOrder.Destination.Denom)
 	k.registerMarketData(ctx, aggressiveOrder.Destination.Denom, aggressiveOrder.Source.Denom)
 
+	if aggressiveOrder.PostOnly != types.PostOnlyMode_None {
+		if err := k.applyPostOnly(ctx, &aggressiveOrder); err != nil {
+			return nil, err
+		}
+	}
+
 	// Accept order
 	aggressiveOrder.ID = k.getNextOrderNumber(ctx)
 	types.EmitAcceptEvent(ctx, aggressiveOrder)
 
+	result := types.NewOrderResult(aggressiveOrder.ID, types.OrderStatus_Resting, aggressiveOrder.Source.Denom, aggressiveOrder.Destination.Denom)
+	matched := matchOutcome{fills: []types.Trade{}, fee: sdk.ZeroInt()}
+	if !batchAuction {
+		matched = k.matchOrder(ctx, parentCtx, &aggressiveOrder, k.transferTradedAmounts)
+	}
+
+	result.Fills = matched.fills
+	result.Fee = sdk.NewCoin(aggressiveOrder.Destination.Denom, matched.fee)
+	result.SourceFilled = sdk.NewCoin(aggressiveOrder.Source.Denom, aggressiveOrder.SourceFilled)
+	result.DestinationFilled = sdk.NewCoin(aggressiveOrder.Destination.Denom, aggressiveOrder.DestinationFilled)
+
+	if matched.selfTradeCanceled || matched.bandBreached {
+		result.Status = types.OrderStatus_Expired
+		if aggressiveOrder.TimeInForce == types.TimeInForce_FillOrKill {
+			KillOrder = true
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+		}
+		types.EmitExpireEvent(ctx, aggressiveOrder)
+	} else if aggressiveOrder.IsFilled() {
+		result.Status = types.OrderStatus_Filled
+		types.EmitExpireEvent(ctx, aggressiveOrder)
+	} else {
+		addToBook := true
+
+		switch {
+		case batchAuction:
+			// ImmediateOrCancel orders rest until the auction at the end of the block.
+		case aggressiveOrder.TimeInForce == types.TimeInForce_ImmediateOrCancel:
+			result.Status = types.OrderStatus_Expired
+			addToBook = false
+			types.EmitExpireEvent(ctx, aggressiveOrder)
+		case aggressiveOrder.TimeInForce == types.TimeInForce_FillOrKill:
+			KillOrder = true
+			addToBook = false
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			types.EmitExpireEvent(ctx, aggressiveOrder)
+		}
+
+		if addToBook {
+			aggressiveOrder.RefillSlice()
+			op := &aggressiveOrder
+			k.setOrder(ctx, op)
+
+			// NOTE This should be the only place that an order is added to the book!
+			// NOTE If this ceases to be true, move logic to func that cleans up all datastructures.
+		}
+	}
+
+	retEvManager.EmitEvents(ctx.EventManager().Events())
+	accepted = true
+
+	if KillOrder {
+		result = types.NewOrderResult(aggressiveOrder.ID, types.OrderStatus_Killed, aggressiveOrder.Source.Denom, aggressiveOrder.Destination.Denom)
+	}
+	return &result, nil
+}
+
+// The outcome of matching an aggressive order against the book.
+type matchOutcome struct {
+	fills []types.Trade
+	// Fee paid by the aggressive order, in its destination denomination.
+	fee sdk.Int
+	// Set when the remainder of the aggressive order is canceled to prevent a self-trade.
+	selfTradeCanceled bool
+	// Set when the remainder of the aggressive order is canceled as it would trade outside a price band.
+	bandBreached bool
+}
+
+// Settles a single fill between a passive and an aggressive order.
+type settleFunc func(ctx sdk.Context, sourceFilled, destinationFilled sdk.Coin, passiveAccountAddr, aggressiveAccountAddr string, passiveFee, aggressiveFee sdk.Int) error
+
+// Match the aggressive order against the book until it is filled, the spread is no longer crossed, or its remainder is
+// canceled. The passive orders, trade log and market data are updated in ctx and every fill is settled using settle.
+// Price band breaches are recorded in parentCtx.
+func (k *Keeper) matchOrder(ctx, parentCtx sdk.Context, aggressiveOrder *types.Order, settle settleFunc) matchOutcome {
+	params := k.GetParams(ctx)
+	out := matchOutcome{fills: []types.Trade{}, fee: sdk.ZeroInt()}
+	bandRefs := make(priceBandReferences)
 	for {
 		plan := k.createExecutionPlan(ctx, aggressiveOrder.Destination.Denom, aggressiveOrder.Source.Denom)
-		if plan.FirstOrder == nil {
+		if len(plan.Orders) == 0 {
 			break
 		}
 
@@ -239,14 +449,28 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 			break
 		}
 
+		if aggressiveOrder.SelfTradePrevention != types.SelfTradePrevention_None && plan.HasOwner(aggressiveOrder.Owner) {
+			if k.preventSelfTrade(ctx, aggressiveOrder, plan, stepDestinationFilled) {
+				out.selfTradeCanceled = true
+				break
+			}
+			continue
+		}
+
+		if band := k.breachedPriceBand(ctx, bandRefs, plan); band != nil {
+			k.recordPriceBandBreach(parentCtx, *band)
+			out.bandBreached = true
+			break
+		}
+
 		// Track aggressive fill for event
 		aggressiveSourceFilled := sdk.ZeroInt()
 		aggressiveDestinationFilled := sdk.ZeroInt()
+		aggressiveFee := sdk.ZeroInt()
 
-		for _, passiveOrder := range []*types.Order{plan.SecondOrder, plan.FirstOrder} {
-			if passiveOrder == nil {
-				continue
-			}
+		// Settle the plan from the order buying the aggressive order's source to the one selling its destination.
+		for i := len(plan.Orders) - 1; i >= 0; i-- {
+			passiveOrder := plan.Orders[i]
 
 			// Use the passive order's price in the market.
 			stepSourceFilled := stepDestinationFilled.Quo(passiveOrder.Price())
@@ -262,7 +486,7 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 
 				// Invariant check
 				if aggressiveOrder.SourceRemaining.LT(sdk.ZeroInt()) {
-					panic(fmt.Sprintf("Aggressive order's SourceRemaining field is less than zero. order: %v", aggressiveOrder))
+					panic(fmt.Sprintf("Aggressive order's SourceRemaining field is less than zero. order: %v", *aggressiveOrder))
 				}
 			}
 
@@ -275,17 +499,18 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 
 				// Invariant check
 				if aggressiveOrder.DestinationFilled.GT(aggressiveOrder.Destination.Amount) {
-					panic(fmt.Sprintf("Aggressive order's DestinationFilled field is greater than Destination.Amount. order: %v", aggressiveOrder))
+					panic(fmt.Sprintf("Aggressive order's DestinationFilled field is greater than Destination.Amount. order: %v", *aggressiveOrder))
 				}
 			}
 
 			passiveOrder.SourceRemaining = passiveOrder.SourceRemaining.Sub(stepSourceFilled.RoundInt())
+			passiveOrder.FillSlice(stepSourceFilled.RoundInt())
 			passiveOrder.SourceFilled = passiveOrder.SourceFilled.Add(stepSourceFilled.RoundInt())
 			passiveOrder.DestinationFilled = passiveOrder.DestinationFilled.Add(stepDestinationFilled.RoundInt())
 
 			// Invariant checks
 			if passiveOrder.SourceRemaining.LT(sdk.ZeroInt()) {
-				panic(fmt.Sprintf("Passive order's SourceRemaining field is less than zero. order: %v candidate: %v", aggressiveOrder, passiveOrder))
+				panic(fmt.Sprintf("Passive order's SourceRemaining field is less than zero. order: %v candidate: %v", *aggressiveOrder, passiveOrder))
 			}
 			if passiveOrder.DestinationFilled.GT(passiveOrder.Destination.Amount) {
 				panic(fmt.Sprintf("Passive order's DestinationFilled field is greater than Destination.Amount. order: %v", passiveOrder))
@@ -294,27 +519,49 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 			// Settle traded tokens
 			nextDestinationFilledCoin := sdk.NewCoin(passiveOrder.Destination.Denom, stepDestinationFilled.RoundInt())
 			nextSourceFilledCoin := sdk.NewCoin(passiveOrder.Source.Denom, stepSourceFilled.RoundInt())
-			if err := k.transferTradedAmounts(ctx, nextDestinationFilledCoin, nextSourceFilledCoin, passiveOrder.Owner, aggressiveOrder.Owner); err != nil {
+
+			makerFeeRate, takerFeeRate := params.FeeRates(passiveOrder.Source.Denom, passiveOrder.Destination.Denom)
+			passiveFee := types.TradingFee(nextDestinationFilledCoin.Amount, makerFeeRate)
+			// The aggressive order only pays a fee on the tokens it buys, not on the intermediate tokens of a synthetic trade.
+			stepAggressiveFee := sdk.ZeroInt()
+			if passiveOrder.Source.Denom == aggressiveOrder.Destination.Denom {
+				stepAggressiveFee = types.TradingFee(nextSourceFilledCoin.Amount, takerFeeRate)
+				aggressiveFee = aggressiveFee.Add(stepAggressiveFee)
+			}
+
+			// Store the passive order before settling, so its owner's other orders are adjusted to the filled amount.
+			switch {
+			case passiveOrder.IsFilled():
+				k.deleteOrder(ctx, passiveOrder)
+			case passiveOrder.IsSliceFilled():
+				k.refillSlice(ctx, passiveOrder)
+			default:
+				k.setOrder(ctx, passiveOrder)
+			}
+
+			if err := settle(ctx, nextDestinationFilledCoin, nextSourceFilledCoin, passiveOrder.Owner, aggressiveOrder.Owner, passiveFee, stepAggressiveFee); err != nil {
 				panic(err)
 			}
+			trade := k.logTrade(ctx, types.NewTrade(*passiveOrder, *aggressiveOrder, nextSourceFilledCoin, nextDestinationFilledCoin, ctx.BlockTime(), ctx.BlockHeight()))
+			out.fills = append(out.fills, trade)
 
-			types.EmitFillEvent(ctx, *passiveOrder, false, stepSourceFilled.RoundInt(), stepDestinationFilled.RoundInt())
+			types.EmitFillEvent(ctx, *passiveOrder, false, stepSourceFilled.RoundInt(), stepDestinationFilled.RoundInt(), passiveFee)
 
 			if passiveOrder.IsFilled() {
-				k.deleteOrder(ctx, passiveOrder)
 				types.EmitExpireEvent(ctx, *passiveOrder)
-			} else {
-				k.setOrder(ctx, passiveOrder)
 			}
 
 			// Register trades in market data
 			k.setMarketData(ctx, passiveOrder.Source.Denom, passiveOrder.Destination.Denom, passiveOrder.Price())
 			k.setMarketData(ctx, passiveOrder.Destination.Denom, passiveOrder.Source.Denom, sdk.NewDec(1).Quo(passiveOrder.Price()))
+			k.updateCandles(ctx, passiveOrder.Source.Denom, passiveOrder.Destination.Denom, passiveOrder.Price(), stepSourceFilled.RoundInt())
+			k.updateCandles(ctx, passiveOrder.Destination.Denom, passiveOrder.Source.Denom, sdk.NewDec(1).Quo(passiveOrder.Price()), stepDestinationFilled.RoundInt())
 
 			stepDestinationFilled = stepSourceFilled
 		}
 
-		types.EmitFillEvent(ctx, aggressiveOrder, true, aggressiveSourceFilled, aggressiveDestinationFilled)
+		types.EmitFillEvent(ctx, *aggressiveOrder, true, aggressiveSourceFilled, aggressiveDestinationFilled, aggressiveFee)
+		out.fee = out.fee.Add(aggressiveFee)
 
 		// Register trades in market data
 		k.setMarketData(ctx, aggressiveOrder.Source.Denom, aggressiveOrder.Destination.Denom, plan.Price)
@@ -325,34 +572,140 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 		}
 	}
 
-	if aggressiveOrder.IsFilled() {
-		types.EmitExpireEvent(ctx, aggressiveOrder)
-	} else {
-		addToBook := true
+	return out
+}
 
-		switch aggressiveOrder.TimeInForce {
-		case types.TimeInForce_ImmediateOrCancel:
-			addToBook = false
-			types.EmitExpireEvent(ctx, aggressiveOrder)
-		case types.TimeInForce_FillOrKill:
-			KillOrder = true
-			addToBook = false
-			ctx = ctx.WithEventManager(sdk.NewEventManager())
-			types.EmitExpireEvent(ctx, aggressiveOrder)
+// Apply the aggressive order's self-trade prevention mode to a plan that contains resting orders of the same owner.
+// Returns true if the remainder of the aggressive order is canceled.
+func (k *Keeper) preventSelfTrade(ctx sdk.Context, aggressiveOrder *types.Order, plan types.ExecutionPlan, stepDestinationFilled sdk.Dec) bool {
+	mode := aggressiveOrder.SelfTradePrevention
+
+	var resting []*types.Order
+	for _, o := range plan.Orders {
+		if o.Owner == aggressiveOrder.Owner {
+			resting = append(resting, o)
+			types.EmitSelfTradeEvent(ctx, *aggressiveOrder, *o)
 		}
+	}
 
-		if addToBook {
-			op := &aggressiveOrder
-			k.setOrder(ctx, op)
+	switch mode {
+	case types.SelfTradePrevention_CancelNewest:
+		return true
 
-			// NOTE This should be the only place that an order is added to the book!
-			// NOTE If this ceases to be true, move logic to func that cleans up all datastructures.
+	case types.SelfTradePrevention_CancelOldest, types.SelfTradePrevention_CancelBoth:
+		for _, o := range resting {
+			types.EmitExpireEvent(ctx, *o)
+			k.deleteOrder(ctx, o)
+		}
+		return mode == types.SelfTradePrevention_CancelBoth
+
+	case types.SelfTradePrevention_DecrementAndCancel:
+		// Walk the plan like the matching loop does to find the amounts each order would have traded.
+		sourceAmounts := make([]sdk.Int, len(plan.Orders))
+		destinationAmounts := make([]sdk.Int, len(plan.Orders))
+		for i := len(plan.Orders) - 1; i >= 0; i-- {
+			passiveOrder := plan.Orders[i]
+
+			stepSourceFilled := stepDestinationFilled.Quo(passiveOrder.Price())
+			if stepSourceFilled.LT(sdk.OneDec()) {
+				stepSourceFilled = sdk.OneDec()
+			}
+			if passiveOrder.Source.Denom == aggressiveOrder.Destination.Denom {
+				stepSourceFilled = sdk.MinDec(stepSourceFilled, aggressiveOrder.Destination.Amount.Sub(aggressiveOrder.DestinationFilled).ToDec())
+			}
+
+			sourceAmounts[i] = sdk.MinInt(stepSourceFilled.RoundInt(), passiveOrder.VisibleRemaining())
+			destinationAmounts[i] = stepDestinationFilled.RoundInt()
+			stepDestinationFilled = stepSourceFilled
 		}
+
+		aggressiveOrder.Source.Amount = aggressiveOrder.Source.Amount.Sub(destinationAmounts[len(plan.Orders)-1])
+		aggressiveOrder.SourceRemaining = aggressiveOrder.SourceRemaining.Sub(destinationAmounts[len(plan.Orders)-1])
+		aggressiveOrder.Destination.Amount = aggressiveOrder.Destination.Amount.Sub(sourceAmounts[0])
+
+		for i, o := range plan.Orders {
+			if o.Owner != aggressiveOrder.Owner {
+				continue
+			}
+
+			// The price of the order may change, so remove it from the priority index before updating it.
+			k.deleteOrder(ctx, o)
+
+			o.Source.Amount = o.Source.Amount.Sub(sourceAmounts[i])
+			o.SourceRemaining = o.SourceRemaining.Sub(sourceAmounts[i])
+			o.Destination.Amount = o.Destination.Amount.Sub(destinationAmounts[i])
+			o.FillSlice(sourceAmounts[i])
+
+			if isExhausted(*o) {
+				types.EmitExpireEvent(ctx, *o)
+				continue
+			}
+
+			if o.IsSliceFilled() {
+				k.refillSlice(ctx, o)
+			} else {
+				k.setOrder(ctx, o)
+			}
+			types.EmitUpdateEvent(ctx, *o)
+		}
+
+		return isExhausted(*aggressiveOrder)
 	}
 
-	retEvManager.EmitEvents(ctx.EventManager().Events())
+	return false
+}
 
-	return nil
+// Show the next slice of a resting iceberg order. The order moves behind the other orders at its price, but keeps its
+// id and client order id.
+func (k *Keeper) refillSlice(ctx sdk.Context, order *types.Order) {
+	k.deleteOrder(ctx, order)
+
+	order.RefillSlice()
+	order.Priority = k.getNextOrderNumber(ctx)
+	k.setOrder(ctx, order)
+}
+
+// Signals whether an order that was reduced to prevent a self-trade can no longer be executed.
+func isExhausted(o types.Order) bool {
+	if !o.SourceRemaining.IsPositive() || o.Destination.Amount.LTE(o.DestinationFilled) {
+		return true
+	}
+
+	return o.IsFilled()
+}
+
+// Ensure that a post-only order does not match any resting order, either directly or through a synthetic route.
+// Depending on the order's mode it is rejected or repriced one tick away from the best crossing price. Instruments
+// without a tick size are repriced by one unit of destination.
+func (k *Keeper) applyPostOnly(ctx sdk.Context, order *types.Order) error {
+	plan := k.createExecutionPlan(ctx, order.Destination.Denom, order.Source.Denom)
+	if len(plan.Orders) == 0 || order.Price().GT(plan.Price) {
+		return nil
+	}
+
+	if order.PostOnly == types.PostOnlyMode_Reject {
+		return sdkerrors.Wrapf(
+			types.ErrPostOnlyWouldCross, "Order price %v crosses the best available price %v",
+			order.Price(), plan.Price,
+		)
+	}
+
+	rules := k.GetInstrumentRules(ctx, order.Source.Denom, order.Destination.Denom)
+	if rules == nil || !rules.TickSize.IsPositive() {
+		order.Destination.Amount = plan.Price.MulInt(order.Source.Amount).TruncateInt().AddRaw(1)
+		return nil
+	}
+
+	dst, ok := rules.NextTickDestination(order.Source.Amount, plan.Price)
+	if !ok {
+		return sdkerrors.Wrapf(
+			types.ErrPostOnlyWouldCross, "Order price %v crosses the best available price %v and %v cannot be priced on the next tick",
+			order.Price(), plan.Price, order.Source,
+		)
+	}
+
+	order.Destination.Amount = dst
+	return k.validateInstrumentRules(ctx, *order)
 }
 
 // Check whether an asset even exists on the chain at the moment.
@@ -361,7 +714,7 @@ func (k Keeper) assetExists(ctx sdk.Context, asset sdk.Coin) bool {
 	return total.AmountOf(asset.Denom).GT(sdk.ZeroInt())
 }
 
-func (k *Keeper) CancelReplaceLimitOrder(ctx sdk.Context, newOrder types.Order, origClientOrderId string) error {
+func (k *Keeper) CancelReplaceLimitOrder(ctx sdk.Context, newOrder types.Order, origClientOrderId string) (*types.OrderResult, error) {
 	// Use a fixed gas amount
 	ctx.GasMeter().ConsumeGas(gasPriceCancelReplaceOrder, "CancelReplaceOrder")
 	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
@@ -369,12 +722,12 @@ func (k *Keeper) CancelReplaceLimitOrder(ctx sdk.Context, newOrder types.Order,
 	origOrder := k.GetOrderByOwnerAndClientOrderId(ctx, newOrder.Owner, origClientOrderId)
 
 	if origOrder == nil {
-		return sdkerrors.Wrap(types.ErrClientOrderIdNotFound, origClientOrderId)
+		return nil, sdkerrors.Wrap(types.ErrClientOrderIdNotFound, origClientOrderId)
 	}
 
 	// Verify that instrument is the same.
 	if origOrder.Source.Denom != newOrder.Source.Denom || origOrder.Destination.Denom != newOrder.Destination.Denom {
-		return sdkerrors.Wrap(
+		return nil, sdkerrors.Wrap(
 			types.ErrOrderInstrumentChanged, fmt.Sprintf(
 				"source %s != %s Or dest %s != %s", origOrder.Source,
 				newOrder.Source,
@@ -384,7 +737,7 @@ func (k *Keeper) CancelReplaceLimitOrder(ctx sdk.Context, newOrder types.Order,
 	}
 
 	if origOrder.ClientOrderID == newOrder.ClientOrderID {
-		return sdkerrors.Wrap(
+		return nil, sdkerrors.Wrap(
 			types.ErrInvalidClientOrderId,
 			fmt.Sprintf("ClientOrderId is already in use"),
 		)
@@ -392,20 +745,106 @@ func (k *Keeper) CancelReplaceLimitOrder(ctx sdk.Context, newOrder types.Order,
 
 	// Has the previous order already achieved the goal on the source side?
 	if origOrder.SourceFilled.GTE(newOrder.Source.Amount) {
-		return sdkerrors.Wrap(types.ErrNoSourceRemaining, "")
+		return nil, sdkerrors.Wrap(types.ErrNoSourceRemaining, "")
 	}
 
-	k.deleteOrder(ctx, origOrder)
-	types.EmitExpireEvent(ctx, *origOrder)
+	// The replacement keeps the time in force of the original order, which decides whether the tick size applies.
+	replacement := newOrder
+	replacement.TimeInForce = origOrder.TimeInForce
+	if err := k.validateInstrumentRules(ctx, replacement); err != nil {
+		return nil, err
+	}
 
 	// Adjust remaining according to how much of the replaced order was filled:
 	newOrder.SourceFilled = origOrder.SourceFilled
 	newOrder.SourceRemaining = newOrder.Source.Amount.Sub(newOrder.SourceFilled)
 	newOrder.DestinationFilled = origOrder.DestinationFilled
 
+	// The replacement keeps the time in force of the original order. GTT and GTB orders may supply a new expiry.
 	newOrder.TimeInForce = origOrder.TimeInForce
+	if newOrder.ExpireTime == nil || origOrder.TimeInForce != types.TimeInForce_GoodTillTime {
+		newOrder.ExpireTime = origOrder.ExpireTime
+	}
+	if newOrder.ExpireHeight == 0 || origOrder.TimeInForce != types.TimeInForce_GoodTillBlock {
+		newOrder.ExpireHeight = origOrder.ExpireHeight
+	}
+
+	if isAmendment(*origOrder, newOrder) {
+		return k.amendOrder(ctx, origOrder, newOrder)
+	}
+
+	k.deleteOrder(ctx, origOrder)
+	types.EmitExpireEvent(ctx, *origOrder)
+
+	return k.PlaceOrder(ctx, newOrder)
+}
+
+// A replacement that keeps the price and display quantity of the original order and only reduces its size cannot match
+// any resting order, so it is applied to the original order in place.
+func isAmendment(origOrder, newOrder types.Order) bool {
+	if origOrder.IsIceberg() != newOrder.IsIceberg() {
+		return false
+	}
+	if newOrder.IsIceberg() && !newOrder.DisplayQuantity.Equal(origOrder.DisplayQuantity) {
+		return false
+	}
+
+	return newOrder.Price().Equal(origOrder.Price()) && newOrder.Source.Amount.LT(origOrder.Source.Amount)
+}
+
+// Reduce a resting order to the size of its replacement. The order keeps its id and its place among the orders at its
+// price.
+func (k *Keeper) amendOrder(ctx sdk.Context, origOrder *types.Order, newOrder types.Order) (*types.OrderResult, error) {
+	if err := newOrder.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if k.IsTradingHalted(ctx, newOrder.Source.Denom, newOrder.Destination.Denom) {
+		return nil, sdkerrors.Wrapf(types.ErrTradingHalted, "%v/%v", newOrder.Source.Denom, newOrder.Destination.Denom)
+	}
+
+	if newOrder.IsExpired(ctx.BlockTime(), ctx.BlockHeight()) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrInvalidExpiry, "Order expired before it could be accepted: %v %v",
+			newOrder.ExpireTime, newOrder.ExpireHeight,
+		)
+	}
+
+	if k.GetOrderByOwnerAndClientOrderId(ctx, newOrder.Owner, newOrder.ClientOrderID) != nil ||
+		k.GetStopOrderByOwnerAndClientOrderId(ctx, newOrder.Owner, newOrder.ClientOrderID) != nil {
+		return nil, sdkerrors.Wrap(types.ErrNonUniqueClientOrderId, newOrder.ClientOrderID)
+	}
+
+	amended := *origOrder
+	amended.ClientOrderID = newOrder.ClientOrderID
+	amended.Source = newOrder.Source
+	amended.Destination = newOrder.Destination
+	amended.ExpireTime = newOrder.ExpireTime
+	amended.ExpireHeight = newOrder.ExpireHeight
+	amended.PostOnly = newOrder.PostOnly
+	amended.SelfTradePrevention = newOrder.SelfTradePrevention
+
+	// The remainder may already be limited by the account balance.
+	amended.SourceRemaining = sdk.MinInt(newOrder.SourceRemaining, origOrder.SourceRemaining)
+	if amended.IsIceberg() {
+		amended.DisplayRemaining = sdk.MinInt(origOrder.DisplayRemaining, amended.SourceRemaining)
+	}
+
+	if amended.IsFilled() {
+		return nil, sdkerrors.Wrapf(
+			types.ErrInvalidPrice, "Order price is invalid: %s -> %s",
+			amended.Source, amended.Destination,
+		)
+	}
+
+	k.deleteOrder(ctx, origOrder)
+	k.setOrder(ctx, &amended)
+	types.EmitUpdateEvent(ctx, amended)
 
-	return k.NewOrderSingle(ctx, newOrder)
+	result := types.NewOrderResult(amended.ID, types.OrderStatus_Resting, amended.Source.Denom, amended.Destination.Denom)
+	result.SourceFilled = sdk.NewCoin(amended.Source.Denom, amended.SourceFilled)
+	result.DestinationFilled = sdk.NewCoin(amended.Destination.Denom, amended.DestinationFilled)
+	return &result, nil
 }
 
 func (k *Keeper) GetOrderByOwnerAndClientOrderId(ctx sdk.Context, owner, clientOrderId string) *types.Order {
@@ -427,6 +866,18 @@ func (k *Keeper) GetOrderByOwnerAndClientOrderId(ctx sdk.Context, owner, clientO
 	return o
 }
 
+// GetOrderByID returns the resting order with the given id, or nil if there is none.
+func (k *Keeper) GetOrderByID(ctx sdk.Context, orderId uint64) *types.Order {
+	ownerKey := ctx.KVStore(k.keyIndices).Get(types.GetOrderIDKey(orderId))
+	if ownerKey == nil {
+		return nil
+	}
+
+	o := &types.Order{}
+	k.cdc.MustUnmarshalBinaryBare(ctx.KVStore(k.key).Get(ownerKey), o)
+	return o
+}
+
 func (k *Keeper) CancelOrder(ctx sdk.Context, owner sdk.AccAddress, clientOrderId string) error {
 	// Use a fixed gas amount
 	ctx.GasMeter().ConsumeGas(gasPriceCancelOrder, "CancelOrder")
@@ -436,7 +887,7 @@ func (k *Keeper) CancelOrder(ctx sdk.Context, owner sdk.AccAddress, clientOrderI
 	order := k.GetOrderByOwnerAndClientOrderId(ctx, owner.String(), clientOrderId)
 
 	if order == nil {
-		return sdkerrors.Wrap(types.ErrClientOrderIdNotFound, clientOrderId)
+		return k.cancelStopOrder(ctx, owner, clientOrderId)
 	}
 
 	types.EmitExpireEvent(ctx, *order)
@@ -445,29 +896,100 @@ func (k *Keeper) CancelOrder(ctx sdk.Context, owner sdk.AccAddress, clientOrderI
 	return nil
 }
 
-// Update any orders that can no longer be filled with the account's balance.
-func (k *Keeper) accountChanged(ctx sdk.Context, accounts []sdk.AccAddress) {
+// CancelAllOrders cancels the resting orders of owner, optionally only those selling srcDenom or buying dstDenom.
+// Empty denominations match any denomination. Stop orders that have not been triggered are not affected.
+func (k *Keeper) CancelAllOrders(ctx sdk.Context, owner sdk.AccAddress, srcDenom, dstDenom string) error {
+	// Use a fixed gas amount
+	ctx.GasMeter().ConsumeGas(gasPriceCancelAllOrders, "CancelAllOrders")
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	for _, order := range k.GetOrdersByOwner(ctx, owner) {
+		if srcDenom != "" && order.Source.Denom != srcDenom {
+			continue
+		}
+
+		if dstDenom != "" && order.Destination.Denom != dstDenom {
+			continue
+		}
+
+		types.EmitExpireEvent(ctx, *order)
+		k.deleteOrder(ctx, order)
+	}
+
+	return nil
+}
+
+// Update any orders that can no longer be filled with the account's balance. Only the orders selling one of the
+// transferred denominations are revisited, as the balances of other denominations are unchanged.
+func (k *Keeper) accountChanged(ctx sdk.Context, accounts []sdk.AccAddress, amt sdk.Coins) {
 	for _, acc := range accounts {
-		orders := k.GetOrdersByOwner(ctx, acc)
-		for _, order := range orders {
-			spendableCoins := k.bk.SpendableCoins(ctx, acc)
-			denomBalance := spendableCoins.AmountOf(order.Source.Denom)
-
-			origSourceRemaining := order.SourceRemaining
-			order.SourceRemaining = order.Source.Amount.Sub(order.SourceFilled)
-			order.SourceRemaining = sdk.MinInt(order.SourceRemaining, denomBalance)
-
-			if order.SourceRemaining.IsZero() {
-				types.EmitExpireEvent(ctx, *order)
-				k.deleteOrder(ctx, order)
-			} else if !origSourceRemaining.Equal(order.SourceRemaining) {
-				types.EmitUpdateEvent(ctx, *order)
-				k.setOrder(ctx, order)
+		var spendableCoins sdk.Coins
+		spendableLoaded := false
+
+		for _, coin := range amt {
+			orders := k.getOrdersByOwnerAndDenom(ctx, acc.String(), coin.Denom)
+			if len(orders) == 0 {
+				continue
+			}
+
+			if !spendableLoaded {
+				spendableCoins = k.bk.SpendableCoins(ctx, acc)
+				spendableLoaded = true
+			}
+			denomBalance := spendableCoins.AmountOf(coin.Denom)
+
+			// The orders of an instrument share the balance, older orders first, so the account never offers more than it
+			// can spend in any instrument.
+			allocated := make(map[string]sdk.Int)
+
+			for _, order := range orders {
+				used, found := allocated[order.Destination.Denom]
+				if !found {
+					used = sdk.ZeroInt()
+				}
+
+				origSourceRemaining := order.SourceRemaining
+				order.SourceRemaining = order.Source.Amount.Sub(order.SourceFilled)
+				order.SourceRemaining = sdk.MinInt(order.SourceRemaining, denomBalance.Sub(used))
+				allocated[order.Destination.Denom] = used.Add(order.SourceRemaining)
+
+				if order.SourceRemaining.IsZero() {
+					types.EmitExpireEvent(ctx, *order)
+					k.deleteOrder(ctx, order)
+				} else if !origSourceRemaining.Equal(order.SourceRemaining) {
+					types.EmitUpdateEvent(ctx, *order)
+					k.setOrder(ctx, order)
+				}
 			}
 		}
 	}
 }
 
+// Returns the resting orders of owner that sell denom, sorted by order id.
+func (k Keeper) getOrdersByOwnerAndDenom(ctx sdk.Context, owner, denom string) (res []*types.Order) {
+	var (
+		store    = ctx.KVStore(k.key)
+		idxStore = ctx.KVStore(k.keyIndices)
+	)
+
+	it := sdk.KVStorePrefixIterator(idxStore, types.GetOwnerDenomKeyByDenom(owner, denom))
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		o := new(types.Order)
+		k.cdc.MustUnmarshalBinaryBare(store.Get(it.Value()), o)
+
+		// Skip the orders of denominations that extend denom with a slash
+		if o.Source.Denom != denom {
+			continue
+		}
+
+		res = append(res, o)
+	}
+
+	return
+}
+
 func (k Keeper) setOrder(ctx sdk.Context, order *types.Order) {
 	var (
 		store    = ctx.KVStore(k.key)
@@ -479,8 +1001,27 @@ func (k Keeper) setOrder(ctx sdk.Context, order *types.Order) {
 	ownerKey := types.GetOwnerKey(order.Owner, order.ClientOrderID)
 	store.Set(ownerKey, orderbz)
 
-	priorityKey := types.GetPriorityKey(order.Source.Denom, order.Destination.Denom, order.Price(), order.ID)
+	priorityKey := types.GetPriorityKey(order.Source.Denom, order.Destination.Denom, order.Price(), order.TimePriority())
 	idxStore.Set(priorityKey, orderbz)
+
+	idxStore.Set(types.GetOrderIDKey(order.ID), ownerKey)
+	idxStore.Set(types.GetOwnerDenomKey(order.Owner, order.Source.Denom, order.ID), ownerKey)
+
+	if expireKey := getExpireKey(order); expireKey != nil {
+		idxStore.Set(expireKey, ownerKey)
+	}
+}
+
+// Returns the key of the order in the expiry index, or nil if the order does not expire.
+func getExpireKey(order *types.Order) []byte {
+	switch order.TimeInForce {
+	case types.TimeInForce_GoodTillTime:
+		return types.GetExpireTimeKey(*order.ExpireTime, order.ID)
+	case types.TimeInForce_GoodTillBlock:
+		return types.GetExpireHeightKey(order.ExpireHeight, order.ID)
+	}
+
+	return nil
 }
 
 func (k Keeper) GetInstrument(ctx sdk.Context, src, dst string) *types.MarketData {
@@ -566,8 +1107,15 @@ func (k *Keeper) deleteOrder(ctx sdk.Context, order *types.Order) {
 	ownerKey := types.GetOwnerKey(order.Owner, order.ClientOrderID)
 	store.Delete(ownerKey)
 
-	priorityKey := types.GetPriorityKey(order.Source.Denom, order.Destination.Denom, order.Price(), order.ID)
+	priorityKey := types.GetPriorityKey(order.Source.Denom, order.Destination.Denom, order.Price(), order.TimePriority())
 	idxStore.Delete(priorityKey)
+
+	idxStore.Delete(types.GetOrderIDKey(order.ID))
+	idxStore.Delete(types.GetOwnerDenomKey(order.Owner, order.Source.Denom, order.ID))
+
+	if expireKey := getExpireKey(order); expireKey != nil {
+		idxStore.Delete(expireKey)
+	}
 }
 
 func (k Keeper) getBestOrder(ctx sdk.Context, src, dst string) *types.Order {
@@ -643,32 +1191,42 @@ func getOrdersSourceDemand(orders []*types.Order, src, dst string) sdk.Coin {
 	return sdk.NewCoin(src, sumSourceRemaining)
 }
 
-func (k Keeper) transferTradedAmounts(ctx sdk.Context, sourceFilled, destinationFilled sdk.Coin, passiveAccountAddr, aggressiveAccountAddr string) error {
+// transferTradedAmounts settles a trade, deducting the passive fee from the sourceFilled tokens received by the passive
+// account and the aggressive fee from the destinationFilled tokens received by the aggressive account.
+func (k Keeper) transferTradedAmounts(ctx sdk.Context, sourceFilled, destinationFilled sdk.Coin, passiveAccountAddr, aggressiveAccountAddr string, passiveFee, aggressiveFee sdk.Int) error {
 	inputs := []banktypes.Input{
 		{Address: aggressiveAccountAddr, Coins: sdk.NewCoins(sourceFilled)},
 		{Address: passiveAccountAddr, Coins: sdk.NewCoins(destinationFilled)},
 	}
 
 	outputs := []banktypes.Output{
-		{Address: aggressiveAccountAddr, Coins: sdk.NewCoins(destinationFilled)},
-		{Address: passiveAccountAddr, Coins: sdk.NewCoins(sourceFilled)},
+		{Address: aggressiveAccountAddr, Coins: sdk.NewCoins(sdk.NewCoin(destinationFilled.Denom, destinationFilled.Amount.Sub(aggressiveFee)))},
+		{Address: passiveAccountAddr, Coins: sdk.NewCoins(sdk.NewCoin(sourceFilled.Denom, sourceFilled.Amount.Sub(passiveFee)))},
+	}
+
+	for _, fee := range []sdk.Coin{sdk.NewCoin(sourceFilled.Denom, passiveFee), sdk.NewCoin(destinationFilled.Denom, aggressiveFee)} {
+		if fee.IsPositive() {
+			outputs = append(outputs, banktypes.Output{Address: k.feeDestination(ctx, fee.Denom).String(), Coins: sdk.NewCoins(fee)})
+		}
 	}
 
 	return k.bk.InputOutputCoins(ctx, inputs, outputs)
 }
 
-func (k Keeper) getNextOrderNumber(ctx sdk.Context) uint64 {
-	var orderID uint64
-	store := ctx.KVStore(k.key)
-	bz := store.Get(types.GetOrderIDGeneratorKey())
-	if bz == nil {
-		orderID = 0
-	} else {
-		orderID = sdk.BigEndianToUint64(bz)
+// Trading fees are split like transaction fees: the staking token goes to the fee collector and stablecoins to the buyback module.
+func (k Keeper) feeDestination(ctx sdk.Context, denom string) sdk.AccAddress {
+	if denom == k.sk.BondDenom(ctx) {
+		return authtypes.NewModuleAddress(k.stakingTokenFeeDestination)
 	}
 
-	bz = sdk.Uint64ToBigEndian(orderID + 1)
-	store.Set(types.GetOrderIDGeneratorKey(), bz)
+	return authtypes.NewModuleAddress(k.coinTokenFeeDestination)
+}
+
+func (k Keeper) getNextOrderNumber(ctx sdk.Context) uint64 {
+	orderID := k.peekNextOrderNumber(ctx)
+
+	bz := sdk.Uint64ToBigEndian(orderID + 1)
+	ctx.KVStore(k.key).Set(types.GetOrderIDGeneratorKey(), bz)
 	return orderID
 }
 
@@ -682,8 +1240,9 @@ func (k Keeper) registerMarketData(ctx sdk.Context, src, dst string) {
 	}
 
 	md := types.MarketData{
-		Source:      src,
-		Destination: dst,
+		Source:          src,
+		Destination:     dst,
+		CumulativePrice: sdk.ZeroDec(),
 	}
 
 	bz := k.cdc.MustMarshalBinaryBare(&md)
@@ -695,9 +1254,18 @@ func (k Keeper) setMarketData(ctx sdk.Context, src, dst string, price sdk.Dec) {
 	idxStore := ctx.KVStore(k.keyIndices)
 	timestamp := ctx.BlockTime()
 
-	md := types.MarketData{Source: src, Destination: dst, LastPrice: &price, Timestamp: &timestamp}
+	// Accumulate the previous price for the time it held
+	cumulativePrice := sdk.ZeroDec()
+	if prev := k.GetInstrument(ctx, src, dst); prev != nil {
+		cumulativePrice = prev.CumulativePriceAt(timestamp)
+	}
+
+	md := types.MarketData{Source: src, Destination: dst, LastPrice: &price, Timestamp: &timestamp, CumulativePrice: cumulativePrice}
 	key := types.GetMarketDataKey(src, dst)
 
 	bz := k.cdc.MustMarshalBinaryBare(&md)
 	idxStore.Set(key, bz)
+	k.recordPriceHistory(ctx, md)
+
+	k.triggerStopOrders(ctx, src, dst, price)
 }
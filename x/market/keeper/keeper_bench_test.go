@@ -0,0 +1,87 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"sort"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+// go test -run NONE -bench BenchmarkAccountChanged ./x/market/keeper/
+func BenchmarkAccountChanged(b *testing.B) {
+	ctx, k, ak, bk := createTestComponents(b)
+
+	params := k.GetParams(ctx)
+	params.MaxOpenOrders, params.MaxInstrumentOpenOrders = 0, 0
+	k.SetParams(ctx, params)
+
+	// An exchange account with resting orders in several denominations, none of which match
+	acc := createAccount(ctx, ak, bk, randomAddress(), "1000000eur,1000000usd,1000000chf,1000000gbp")
+	for i := 0; i < 500; i++ {
+		for _, o := range [][2]string{{"100eur", "150usd"}, {"100usd", "150chf"}, {"100chf", "150gbp"}, {"100gbp", "150eur"}} {
+			require.NoError(b, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc, o[0], o[1])))
+		}
+	}
+
+	listeners := []struct {
+		name     string
+		listener func(sdk.Context, []sdk.AccAddress, sdk.Coins)
+	}{
+		{"all orders", accountChangedAllOrders(k)},
+		{"orders by denomination", k.accountChanged},
+	}
+
+	for _, deposit := range []string{"10eur", "10jpy"} {
+		for _, l := range listeners {
+			b.Run(deposit+"/"+l.name, func(b *testing.B) {
+				accounts := []sdk.AccAddress{acc.GetAddress()}
+				for i := 0; i < b.N; i++ {
+					l.listener(ctx, accounts, coins(deposit))
+				}
+			})
+		}
+	}
+}
+
+// The balance listener before orders were indexed by owner and source denomination, for comparison.
+func accountChangedAllOrders(k *Keeper) func(sdk.Context, []sdk.AccAddress, sdk.Coins) {
+	return func(ctx sdk.Context, accounts []sdk.AccAddress, _ sdk.Coins) {
+		for _, acc := range accounts {
+			orders := k.GetOrdersByOwner(ctx, acc)
+			spendableCoins := k.bk.SpendableCoins(ctx, acc)
+
+			sort.Slice(orders, func(i, j int) bool {
+				return orders[i].ID < orders[j].ID
+			})
+			allocated := make(map[instrumentKey]sdk.Int)
+
+			for _, order := range orders {
+				instrument := instrumentKey{order.Source.Denom, order.Destination.Denom}
+				used, found := allocated[instrument]
+				if !found {
+					used = sdk.ZeroInt()
+				}
+				denomBalance := spendableCoins.AmountOf(order.Source.Denom).Sub(used)
+
+				origSourceRemaining := order.SourceRemaining
+				order.SourceRemaining = order.Source.Amount.Sub(order.SourceFilled)
+				order.SourceRemaining = sdk.MinInt(order.SourceRemaining, denomBalance)
+				allocated[instrument] = used.Add(order.SourceRemaining)
+
+				if order.SourceRemaining.IsZero() {
+					types.EmitExpireEvent(ctx, *order)
+					k.deleteOrder(ctx, order)
+				} else if !origSourceRemaining.Equal(order.SourceRemaining) {
+					types.EmitUpdateEvent(ctx, *order)
+					k.setOrder(ctx, order)
+				}
+			}
+		}
+	}
+}
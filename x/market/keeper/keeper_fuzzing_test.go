@@ -49,6 +49,11 @@ func TestFuzzing1(t *testing.T) {
 
 	ctx, k, ak, bk := createTestComponents(t)
 
+	// Each account places far more orders than the default open order limits allow.
+	params := k.GetParams(ctx)
+	params.MaxOpenOrders, params.MaxInstrumentOpenOrders = 0, 0
+	k.SetParams(ctx, params)
+
 	var (
 		acc1 = createAccount(ctx, ak, bk, randomAddress(), "1000000000eur")
 		acc2 = createAccount(ctx, ak, bk, randomAddress(), "1000000000usd")
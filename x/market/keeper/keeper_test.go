@@ -21,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
@@ -285,13 +286,13 @@ func TestCancelReplaceMarketOrderZeroSlippage(t *testing.T) {
 	require.NoError(t, err)
 
 	// The order id has been re-used from the previous order, which causes an error
-	err = k.CancelReplaceLimitOrder(ctx, order, clientID)
+	_, err = k.CancelReplaceLimitOrder(ctx, order, clientID)
 	require.True(t, types.ErrInvalidClientOrderId.Is(err), "Unexpected error \"%v\"", err)
 
 	newClientID := cid()
 	order.ClientOrderID = newClientID
 
-	err = k.CancelReplaceLimitOrder(ctx, order, clientID)
+	_, err = k.CancelReplaceLimitOrder(ctx, order, clientID)
 	require.NoError(t, err)
 
 	expOrder := &types.Order{
@@ -305,6 +306,8 @@ func TestCancelReplaceMarketOrderZeroSlippage(t *testing.T) {
 		SourceFilled:      sdk.ZeroInt(),
 		Destination:       dest,
 		DestinationFilled: sdk.ZeroInt(),
+		DisplayQuantity:   sdk.ZeroInt(),
+		DisplayRemaining:  sdk.ZeroInt(),
 		Created:           ctx.BlockTime(),
 	}
 	require.NoError(t, err)
@@ -399,7 +402,7 @@ func TestCancelReplaceMarketOrder100Slippage(t *testing.T) {
 	)
 	require.NoError(t, err)
 
-	err = k.CancelReplaceLimitOrder(ctx, newOrder, clientID)
+	_, err = k.CancelReplaceLimitOrder(ctx, newOrder, clientID)
 	require.NoError(t, err)
 	expOrder := &types.Order{
 		ID:            3,
@@ -412,6 +415,8 @@ func TestCancelReplaceMarketOrder100Slippage(t *testing.T) {
 		SourceFilled:      sdk.ZeroInt(),
 		Destination:       mcrm.Destination,
 		DestinationFilled: sdk.ZeroInt(),
+		DisplayQuantity:   sdk.ZeroInt(),
+		DisplayRemaining:  sdk.ZeroInt(),
 		Created:           ctx.BlockTime(),
 	}
 	require.NoError(t, err)
@@ -632,6 +637,60 @@ func TestImmediateOrCancel(t *testing.T) {
 	require.Equal(t, coins("19gbp,1eur"), bal1)
 }
 
+func TestPlaceOrderResult(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	require.NoError(t, k.SetFees(ctx, testAuthority, 10, 20, nil))
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	res, err := k.PlaceOrder(ctx, order(ctx.BlockTime(), acc1, "5000eur", "5000usd"))
+	require.NoError(t, err)
+	require.Equal(t, types.OrderStatus_Resting, res.Status)
+	require.Equal(t, "0eur", res.SourceFilled.String())
+	require.Empty(t, res.Fills)
+	makerOrderID := res.OrderID
+
+	res, err = k.PlaceOrder(ctx, order(ctx.BlockTime(), acc2, "2000usd", "2000eur"))
+	require.NoError(t, err)
+	require.Equal(t, types.OrderStatus_Filled, res.Status)
+	require.Greater(t, res.OrderID, makerOrderID)
+	require.Equal(t, "2000usd", res.SourceFilled.String())
+	require.Equal(t, "2000eur", res.DestinationFilled.String())
+	require.Equal(t, "4eur", res.Fee.String())
+	require.Len(t, res.Fills, 1)
+	require.Equal(t, makerOrderID, res.Fills[0].MakerOrderID)
+	require.Equal(t, res.OrderID, res.Fills[0].TakerOrderID)
+	require.Equal(t, k.GetAllTrades(ctx), res.Fills)
+
+	// The unfilled remainder of an immediate order expires
+	ioc, err := types.NewOrder(ctx.BlockTime(), types.TimeInForce_ImmediateOrCancel, coin("4000usd"), coin("4000eur"), acc2.GetAddress(), cid())
+	require.NoError(t, err)
+	res, err = k.PlaceOrder(ctx, ioc)
+	require.NoError(t, err)
+	require.Equal(t, types.OrderStatus_Expired, res.Status)
+	require.Equal(t, "3000usd", res.SourceFilled.String())
+	require.Len(t, res.Fills, 1)
+
+	// A killed order reports no fills
+	fok, err := types.NewOrder(ctx.BlockTime(), types.TimeInForce_FillOrKill, coin("100usd"), coin("100eur"), acc2.GetAddress(), cid())
+	require.NoError(t, err)
+	res, err = k.PlaceOrder(ctx, fok)
+	require.NoError(t, err)
+	require.Equal(t, types.OrderStatus_Killed, res.Status)
+	require.Equal(t, "0usd", res.SourceFilled.String())
+	require.Empty(t, res.Fills)
+
+	// Replacements report the new order
+	orig := order(ctx.BlockTime(), acc1, "1000eur", "1200usd")
+	require.NoError(t, k.NewOrderSingle(ctx, orig))
+	replacement := order(ctx.BlockTime(), acc1, "1000eur", "1100usd")
+	res, err = k.CancelReplaceLimitOrder(ctx, replacement, orig.ClientOrderID)
+	require.NoError(t, err)
+	require.Equal(t, types.OrderStatus_Resting, res.Status)
+	require.Equal(t, k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), replacement.ClientOrderID).ID, res.OrderID)
+}
+
 func TestInsufficientGas(t *testing.T) {
 	ctx, k, ak, bk := createTestComponents(t)
 
@@ -901,6 +960,46 @@ func TestCancelOrders1(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestKeeperCancelAllOrders(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur,10000usd")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	for _, o := range []types.Order{
+		order(ctx.BlockTime(), acc1, "1000eur", "1200usd"),
+		order(ctx.BlockTime(), acc1, "1000eur", "1300usd"),
+		order(ctx.BlockTime(), acc1, "1000eur", "1100chf"),
+		order(ctx.BlockTime(), acc1, "1000usd", "1100chf"),
+		order(ctx.BlockTime(), acc2, "1000eur", "1200usd"),
+	} {
+		require.NoError(t, k.NewOrderSingle(ctx, o))
+	}
+
+	gasMeter := sdk.NewGasMeter(math.MaxUint64)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.CancelAllOrders(ctx.WithGasMeter(gasMeter), acc1.GetAddress(), "eur", "usd"))
+	require.Equal(t, gasPriceCancelAllOrders, gasMeter.GasConsumed())
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 2)
+
+	expired := 0
+	for _, ev := range ctx.EventManager().Events() {
+		for _, attr := range ev.Attributes {
+			if string(attr.Key) == types.AttributeKeyAction && string(attr.Value) == "expire" {
+				expired++
+			}
+		}
+	}
+	require.Equal(t, 2, expired)
+
+	require.NoError(t, k.CancelAllOrders(ctx, acc1.GetAddress(), "", "chf"))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+
+	// Other accounts are not affected
+	require.Len(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()), 1)
+	require.NoError(t, k.CancelAllOrders(ctx, acc2.GetAddress(), "", ""))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()))
+}
+
 func TestKeeperCancelReplaceLimitOrder(t *testing.T) {
 	ctx, k, ak, bk := createTestComponents(t)
 	acc1 := createAccount(ctx, ak, bk, randomAddress(), "20000eur")
@@ -916,7 +1015,7 @@ func TestKeeperCancelReplaceLimitOrder(t *testing.T) {
 	gasMeter := sdk.NewGasMeter(math.MaxUint64)
 	order2cid := cid()
 	order2, _ := types.NewOrder(ctx.BlockTime(), types.TimeInForce_GoodTillCancel, coin("5000eur"), coin("17000usd"), acc1.GetAddress(), order2cid)
-	err = k.CancelReplaceLimitOrder(ctx.WithGasMeter(gasMeter), order2, order1cid)
+	_, err = k.CancelReplaceLimitOrder(ctx.WithGasMeter(gasMeter), order2, order1cid)
 	require.NoError(t, err)
 	require.Equal(t, gasPriceCancelReplaceOrder, gasMeter.GasConsumed())
 
@@ -931,12 +1030,12 @@ func TestKeeperCancelReplaceLimitOrder(t *testing.T) {
 
 	order3, _ := types.NewOrder(ctx.BlockTime(), types.TimeInForce_GoodTillCancel, coin("500chf"), coin("1700usd"), acc1.GetAddress(), cid())
 	// Wrong client order id for previous order submitted.
-	err = k.CancelReplaceLimitOrder(ctx, order3, order1cid)
+	_, err = k.CancelReplaceLimitOrder(ctx, order3, order1cid)
 	require.True(t, types.ErrClientOrderIdNotFound.Is(err))
 
 	// Changing instrument of order
 	gasMeter = sdk.NewGasMeter(math.MaxUint64)
-	err = k.CancelReplaceLimitOrder(ctx.WithGasMeter(gasMeter), order3, order2cid)
+	_, err = k.CancelReplaceLimitOrder(ctx.WithGasMeter(gasMeter), order3, order2cid)
 	require.True(t, types.ErrOrderInstrumentChanged.Is(err))
 	require.Equal(t, gasPriceCancelReplaceOrder, gasMeter.GasConsumed())
 
@@ -960,7 +1059,7 @@ func TestKeeperCancelReplaceLimitOrder(t *testing.T) {
 	// CancelReplace and verify that previously filled amount is subtracted from the resulting order
 	order4cid := cid()
 	order4, _ := types.NewOrder(ctx.BlockTime(), types.TimeInForce_GoodTillCancel, coin("10000eur"), coin("35050usd"), acc1.GetAddress(), order4cid)
-	err = k.CancelReplaceLimitOrder(ctx, order4, order2cid)
+	_, err = k.CancelReplaceLimitOrder(ctx, order4, order2cid)
 	require.NoError(t, err)
 
 	{
@@ -978,7 +1077,7 @@ func TestKeeperCancelReplaceLimitOrder(t *testing.T) {
 	require.NoError(t, err)
 
 	order6 := order(ctx.BlockTime(), acc1, "8000eur", "30000usd")
-	err = k.CancelReplaceLimitOrder(ctx, order6, order4cid)
+	_, err = k.CancelReplaceLimitOrder(ctx, order6, order4cid)
 	require.True(t, types.ErrNoSourceRemaining.Is(err))
 
 	require.True(t, totalSupply.Sub(snapshotAccounts(ctx, bk)).IsZero())
@@ -999,7 +1098,7 @@ func TestKeeperCancelReplaceMarketOrder(t *testing.T) {
 	gasMeter := sdk.NewGasMeter(math.MaxUint64)
 	order2cid := cid()
 	order2, _ := types.NewOrder(ctx.BlockTime(), types.TimeInForce_GoodTillCancel, coin("5000eur"), coin("17000usd"), acc1.GetAddress(), order2cid)
-	err = k.CancelReplaceLimitOrder(ctx.WithGasMeter(gasMeter), order2, order1cid)
+	_, err = k.CancelReplaceLimitOrder(ctx.WithGasMeter(gasMeter), order2, order1cid)
 	require.NoError(t, err)
 	require.Equal(t, gasPriceCancelReplaceOrder, gasMeter.GasConsumed())
 
@@ -1014,12 +1113,12 @@ func TestKeeperCancelReplaceMarketOrder(t *testing.T) {
 
 	order3, _ := types.NewOrder(ctx.BlockTime(), types.TimeInForce_GoodTillCancel, coin("500chf"), coin("1700usd"), acc1.GetAddress(), cid())
 	// Wrong client order id for previous order submitted.
-	err = k.CancelReplaceLimitOrder(ctx, order3, order1cid)
+	_, err = k.CancelReplaceLimitOrder(ctx, order3, order1cid)
 	require.True(t, types.ErrClientOrderIdNotFound.Is(err))
 
 	// Changing instrument of order
 	gasMeter = sdk.NewGasMeter(math.MaxUint64)
-	err = k.CancelReplaceLimitOrder(ctx.WithGasMeter(gasMeter), order3, order2cid)
+	_, err = k.CancelReplaceLimitOrder(ctx.WithGasMeter(gasMeter), order3, order2cid)
 	require.True(t, types.ErrOrderInstrumentChanged.Is(err))
 	require.Equal(t, gasPriceCancelReplaceOrder, gasMeter.GasConsumed())
 
@@ -1043,7 +1142,7 @@ func TestKeeperCancelReplaceMarketOrder(t *testing.T) {
 	// CancelReplace and verify that previously filled amount is subtracted from the resulting order
 	order4cid := cid()
 	order4, _ := types.NewOrder(ctx.BlockTime(), types.TimeInForce_GoodTillCancel, coin("10000eur"), coin("35050usd"), acc1.GetAddress(), order4cid)
-	err = k.CancelReplaceLimitOrder(ctx, order4, order2cid)
+	_, err = k.CancelReplaceLimitOrder(ctx, order4, order2cid)
 	require.NoError(t, err)
 
 	{
@@ -1061,12 +1160,73 @@ func TestKeeperCancelReplaceMarketOrder(t *testing.T) {
 	require.NoError(t, err)
 
 	order6 := order(ctx.BlockTime(), acc1, "8000eur", "30000usd")
-	err = k.CancelReplaceLimitOrder(ctx, order6, order4cid)
+	_, err = k.CancelReplaceLimitOrder(ctx, order6, order4cid)
 	require.True(t, types.ErrNoSourceRemaining.Is(err))
 
 	require.True(t, totalSupply.Sub(snapshotAccounts(ctx, bk)).IsZero())
 }
 
+func TestCancelReplaceAmendKeepsPriority(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	orig := order(ctx.BlockTime(), acc1, "1000eur", "1200usd")
+	require.NoError(t, k.NewOrderSingle(ctx, orig))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "1000eur", "1200usd")))
+	origID := k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), orig.ClientOrderID).ID
+
+	// Partially fill the original order
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "120usd", "100eur")))
+
+	// Shrinking the order at the same price keeps its id and place in the book
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	amended := order(ctx.BlockTime(), acc1, "500eur", "600usd")
+	res, err := k.CancelReplaceLimitOrder(ctx, amended, orig.ClientOrderID)
+	require.NoError(t, err)
+	require.Equal(t, types.OrderStatus_Resting, res.Status)
+	require.Equal(t, origID, res.OrderID)
+	require.Equal(t, "100eur", res.SourceFilled.String())
+	require.True(t, findEventAttr(ctx, "update"))
+	require.False(t, findEventAttr(ctx, "expire"))
+
+	require.Nil(t, k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), orig.ClientOrderID))
+	o := k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), amended.ClientOrderID)
+	require.NotNil(t, o)
+	require.Equal(t, origID, o.ID)
+	require.Equal(t, sdk.NewInt(400), o.SourceRemaining)
+
+	// The amended order is still matched before the order of acc2
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "480usd", "400eur")))
+	require.Equal(t, "9500eur,600usd", bk.GetAllBalances(ctx, acc1.GetAddress()).String())
+	require.Equal(t, "10000eur", bk.GetAllBalances(ctx, acc2.GetAddress()).String())
+	require.Nil(t, k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), amended.ClientOrderID))
+
+	// A price change or a larger size is placed as a new order
+	acc2Order := k.GetOrdersByOwner(ctx, acc2.GetAddress())[0]
+	larger := order(ctx.BlockTime(), acc2, "2000eur", "2400usd")
+	res, err = k.CancelReplaceLimitOrder(ctx, larger, acc2Order.ClientOrderID)
+	require.NoError(t, err)
+	require.Greater(t, res.OrderID, acc2Order.ID)
+
+	repriced := order(ctx.BlockTime(), acc2, "1000eur", "1300usd")
+	res2, err := k.CancelReplaceLimitOrder(ctx, repriced, larger.ClientOrderID)
+	require.NoError(t, err)
+	require.Greater(t, res2.OrderID, res.OrderID)
+
+	// The new client order id must not be in use
+	other := order(ctx.BlockTime(), acc2, "1000eur", "1500usd")
+	require.NoError(t, k.NewOrderSingle(ctx, other))
+	reused := order(ctx.BlockTime(), acc2, "500eur", "650usd")
+	reused.ClientOrderID = other.ClientOrderID
+	_, err = k.CancelReplaceLimitOrder(ctx, reused, repriced.ClientOrderID)
+	require.ErrorIs(t, err, types.ErrNonUniqueClientOrderId)
+
+	msg, broken := AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+}
+
 func TestOrdersChangeWithAccountBalance(t *testing.T) {
 	ctx, k, ak, bk := createTestComponents(t)
 	acc := createAccount(ctx, ak, bk, randomAddress(), "15000eur")
@@ -1114,6 +1274,86 @@ func TestOrdersChangeWithAccountBalance(t *testing.T) {
 	require.True(t, totalSupply.Sub(snapshotAccounts(ctx, bk)).IsZero())
 }
 
+func TestTransfersOfOtherDenominations(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc := createAccount(ctx, ak, bk, randomAddress(), "10000eur,5000usd")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "")
+
+	o1 := order(ctx.BlockTime(), acc, "8000eur", "9600usd")
+	o2 := order(ctx.BlockTime(), acc, "5000usd", "4000chf")
+	for _, o := range []types.Order{o1, o2} {
+		require.NoError(t, k.NewOrderSingle(ctx, o))
+	}
+
+	// Reduce the eur balance without notifying the market
+	require.NoError(t, bk.SetBalances(ctx, acc.GetAddress(), coins("2000eur,5000usd")))
+
+	remaining := func(o types.Order) string {
+		return k.GetOrderByOwnerAndClientOrderId(ctx, acc.GetAddress().String(), o.ClientOrderID).SourceRemaining.String()
+	}
+
+	// Only the orders selling the transferred denomination are revisited
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, bk.SendCoins(ctx, acc.GetAddress(), acc2.GetAddress(), coins("1000usd")))
+	require.Equal(t, "8000", remaining(o1))
+	require.Equal(t, "4000", remaining(o2))
+	require.True(t, findEventAttr(ctx, "update"))
+
+	require.NoError(t, bk.SendCoins(ctx, acc.GetAddress(), acc2.GetAddress(), coins("1000eur")))
+	require.Equal(t, "1000", remaining(o1))
+	require.Equal(t, "4000", remaining(o2))
+}
+
+func TestOrdersShareAccountBalance(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "")
+
+	o1 := order(ctx.BlockTime(), acc, "4000eur", "4800usd")
+	o2 := order(ctx.BlockTime(), acc, "6000eur", "7200usd")
+	o3 := order(ctx.BlockTime(), acc, "10000eur", "11000chf")
+	for _, o := range []types.Order{o1, o2, o3} {
+		require.NoError(t, k.NewOrderSingle(ctx, o))
+	}
+
+	// The oldest order of an instrument keeps its remaining amount
+	require.NoError(t, bk.SendCoins(ctx, acc.GetAddress(), acc2.GetAddress(), coins("3000eur")))
+
+	remaining := func(o types.Order) string {
+		return k.GetOrderByOwnerAndClientOrderId(ctx, acc.GetAddress().String(), o.ClientOrderID).SourceRemaining.String()
+	}
+	require.Equal(t, "4000", remaining(o1))
+	require.Equal(t, "3000", remaining(o2))
+	require.Equal(t, "7000", remaining(o3))
+
+	require.NoError(t, bk.SendCoins(ctx, acc2.GetAddress(), acc.GetAddress(), coins("3000eur")))
+	require.Equal(t, "6000", remaining(o2))
+	require.Equal(t, "10000", remaining(o3))
+}
+
+func TestPassiveFillSharesAccountBalance(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "1000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "1000usd")
+
+	o1 := order(ctx.BlockTime(), acc1, "600eur", "720usd")
+	o2 := order(ctx.BlockTime(), acc1, "400eur", "520usd")
+	require.NoError(t, k.NewOrderSingle(ctx, o1))
+	require.NoError(t, k.NewOrderSingle(ctx, o2))
+
+	// The balance left after the fill is shared with the filled amount of the older order already deducted
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "360usd", "300eur")))
+
+	remaining := func(o types.Order) string {
+		return k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), o.ClientOrderID).SourceRemaining.String()
+	}
+	require.Equal(t, "300", remaining(o1))
+	require.Equal(t, "400", remaining(o2))
+
+	msg, broken := AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+}
+
 func TestUnknownAsset(t *testing.T) {
 	ctx, k1, ak, bk := createTestComponents(t)
 
@@ -1265,6 +1505,73 @@ func TestSyntheticInstruments2(t *testing.T) {
 	require.True(t, totalSupply.Sub(snapshotAccounts(ctx, bk)).IsZero())
 }
 
+func TestSyntheticInstrumentsMultiHop(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "1000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "1000chf")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "1000gbp")
+	acc4 := createAccount(ctx, ak, bk, randomAddress(), "1200usd")
+
+	totalSupply := snapshotAccounts(ctx, bk)
+
+	// eur -> chf -> gbp -> usd
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "1000eur", "1000chf")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "1000chf", "1000gbp")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "1000gbp", "1200usd")))
+
+	// The route needs three hops
+	params := k.GetParams(ctx)
+	params.MaxHops = 2
+	k.SetParams(ctx, params)
+	require.Nil(t, k.GetBestPrice(ctx, "usd", "eur"))
+
+	params.MaxHops = 3
+	k.SetParams(ctx, params)
+	require.NotNil(t, k.GetBestPrice(ctx, "usd", "eur"))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc4, "1200usd", "1000eur")))
+
+	require.Equal(t, "1000chf", bk.GetAllBalances(ctx, acc1.GetAddress()).String())
+	require.Equal(t, "1000gbp", bk.GetAllBalances(ctx, acc2.GetAddress()).String())
+	require.Equal(t, "1200usd", bk.GetAllBalances(ctx, acc3.GetAddress()).String())
+	require.Equal(t, "1000eur", bk.GetAllBalances(ctx, acc4.GetAddress()).String())
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc4.GetAddress()))
+
+	// Every leg is settled and reported separately
+	fills := 0
+	for _, ev := range ctx.EventManager().Events() {
+		for _, attr := range ev.Attributes {
+			if string(attr.Key) == types.AttributeKeyAction && string(attr.Value) == "fill" {
+				fills++
+			}
+		}
+	}
+	require.Equal(t, 4, fills)
+	require.Len(t, k.GetAllTrades(ctx), 3)
+
+	// Ensure that all tokens are accounted for.
+	require.True(t, totalSupply.Sub(snapshotAccounts(ctx, bk)).IsZero())
+}
+
+func TestSyntheticInstrumentsBestRoute(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "1000eur,1000chf")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "1000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "100chf")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100chf", "110usd")))
+
+	// Buying through chf is cheaper than the direct order
+	o, err := types.NewOrder(ctx.BlockTime(), types.TimeInForce_ImmediateOrCancel, coin("120usd"), coin("100eur"), acc2.GetAddress(), cid())
+	require.NoError(t, err)
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+
+	require.Equal(t, "100eur,890usd", bk.GetAllBalances(ctx, acc2.GetAddress()).String())
+	require.Equal(t, "1000chf,900eur,110usd", bk.GetAllBalances(ctx, acc1.GetAddress()).String())
+}
+
 func TestDestinationCapacity(t *testing.T) {
 	ctx, k, ak, bk := createTestComponents(t)
 
@@ -1417,6 +1724,308 @@ func TestTimeInForceIO(t *testing.T) {
 
 }
 
+func TestPostOnlyReject(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+
+	o := postOnlyOrder(ctx, acc2, "120usd", "100eur", types.PostOnlyMode_Reject)
+	err := k.NewOrderSingle(ctx, o)
+	require.True(t, types.ErrPostOnlyWouldCross.Is(err))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()))
+
+	acc1Orders := k.GetOrdersByOwner(ctx, acc1.GetAddress())
+	require.Len(t, acc1Orders, 1)
+	require.True(t, acc1Orders[0].SourceFilled.IsZero())
+
+	// An order that does not cross is added to the book
+	o = postOnlyOrder(ctx, acc2, "120usd", "101eur", types.PostOnlyMode_Reject)
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+	require.Len(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()), 1)
+	require.Equal(t, "10000usd", bk.GetAllBalances(ctx, acc2.GetAddress()).String())
+}
+
+func TestPostOnlyRejectSynthetic(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "6500usd")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "4500chf")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "500eur", "542chf")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "1000chf", "1028usd")))
+
+	// Only crosses the book through usd -> chf -> eur
+	o := postOnlyOrder(ctx, acc2, "1000usd", "897eur", types.PostOnlyMode_Reject)
+	err := k.NewOrderSingle(ctx, o)
+	require.True(t, types.ErrPostOnlyWouldCross.Is(err))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()))
+}
+
+func TestRejectedOrderLeavesNoState(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "6500usd")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "4500chf")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "500eur", "542chf")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "1000chf", "1028usd")))
+	require.Nil(t, k.GetInstrument(ctx, "usd", "eur"))
+
+	// The order is rejected after its instrument has been registered
+	o := postOnlyOrder(ctx, acc2, "1000usd", "897eur", types.PostOnlyMode_Reject)
+	require.True(t, types.ErrPostOnlyWouldCross.Is(k.NewOrderSingle(ctx, o)))
+	require.Nil(t, k.GetInstrument(ctx, "usd", "eur"))
+	require.Nil(t, k.GetInstrument(ctx, "eur", "usd"))
+}
+
+func TestPostOnlyReprice(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+
+	o := postOnlyOrder(ctx, acc2, "120usd", "90eur", types.PostOnlyMode_Reprice)
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+
+	acc2Orders := k.GetOrdersByOwner(ctx, acc2.GetAddress())
+	require.Len(t, acc2Orders, 1)
+	require.Equal(t, "101eur", acc2Orders[0].Destination.String())
+	require.True(t, acc2Orders[0].SourceFilled.IsZero())
+
+	acc1Orders := k.GetOrdersByOwner(ctx, acc1.GetAddress())
+	require.Len(t, acc1Orders, 1)
+	require.True(t, acc1Orders[0].SourceFilled.IsZero())
+
+	// Orders that do not cross are not repriced
+	o = postOnlyOrder(ctx, acc2, "120usd", "110eur", types.PostOnlyMode_Reprice)
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+	require.Equal(t, "110eur", k.GetOrderByOwnerAndClientOrderId(ctx, o.Owner, o.ClientOrderID).Destination.String())
+}
+
+func TestPostOnlyRepriceTickSize(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	rules := types.NewInstrumentRules("usd", "eur", sdk.NewDecWithPrec(1, 2), sdk.ZeroInt(), sdk.ZeroInt())
+	require.NoError(t, k.SetInstrumentRules(ctx, testAuthority, rules))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+
+	// The best price is 0.8333 eur per usd, so the order moves to 0.84
+	o := postOnlyOrder(ctx, acc2, "100usd", "50eur", types.PostOnlyMode_Reprice)
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+	require.Equal(t, "84eur", k.GetOrderByOwnerAndClientOrderId(ctx, o.Owner, o.ClientOrderID).Destination.String())
+
+	// 120usd at 0.84 does not buy a whole amount of eur
+	o = postOnlyOrder(ctx, acc2, "120usd", "90eur", types.PostOnlyMode_Reprice)
+	require.True(t, types.ErrPostOnlyWouldCross.Is(k.NewOrderSingle(ctx, o)))
+	require.Len(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()), 1)
+}
+
+func TestPostOnlyInvalidTimeInForce(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	o, err := types.NewOrder(ctx.BlockTime(), types.TimeInForce_ImmediateOrCancel, coin("100eur"), coin("120usd"), acc1.GetAddress(), cid())
+	require.NoError(t, err)
+	o.PostOnly = types.PostOnlyMode_Reject
+
+	err = k.NewOrderSingle(ctx, o)
+	require.True(t, types.ErrInvalidPostOnlyMode.Is(err))
+}
+
+func TestIcebergOrder(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	iceberg := icebergOrder(ctx, acc1, "300eur", "360usd", 100)
+	require.NoError(t, k.NewOrderSingle(ctx, iceberg))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100eur", "120usd")))
+
+	// The visible slice of the iceberg order fills first. Its refill is queued behind the order of acc2.
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "180usd", "150eur")))
+
+	stored := k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), iceberg.ClientOrderID)
+	require.NotNil(t, stored)
+	require.Equal(t, "200", stored.SourceRemaining.String())
+	require.Equal(t, "100", stored.DisplayRemaining.String())
+	require.Equal(t, "100", stored.VisibleRemaining().String())
+	require.Greater(t, stored.TimePriority(), stored.ID)
+
+	acc2Orders := k.GetOrdersByOwner(ctx, acc2.GetAddress())
+	require.Len(t, acc2Orders, 1)
+	require.Equal(t, "50", acc2Orders[0].SourceRemaining.String())
+
+	// A large order sweeps the reserve slice by slice
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "360usd", "250eur")))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()))
+	require.Equal(t, "9700eur,360usd", bk.GetAllBalances(ctx, acc1.GetAddress()).String())
+
+	msg, broken := AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestIcebergOrderInvalid(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	o := icebergOrder(ctx, acc1, "100eur", "120usd", 200)
+	require.True(t, types.ErrInvalidDisplayQuantity.Is(k.NewOrderSingle(ctx, o)))
+
+	o = icebergOrder(ctx, acc1, "100eur", "120usd", 10)
+	o.TimeInForce = types.TimeInForce_ImmediateOrCancel
+	require.True(t, types.ErrInvalidDisplayQuantity.Is(k.NewOrderSingle(ctx, o)))
+
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+}
+
+func TestSelfTradeNone(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur,10000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "120usd", "100eur")))
+
+	// Without self-trade prevention the orders match each other
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+	require.False(t, findEventAttr(ctx, "self_trade"))
+	require.Equal(t, "10000eur,10000usd", bk.GetAllBalances(ctx, acc1.GetAddress()).String())
+}
+
+func TestSelfTradeCancelNewest(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur,10000usd")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	resting := order(ctx.BlockTime(), acc1, "100eur", "120usd")
+	require.NoError(t, k.NewOrderSingle(ctx, resting))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100eur", "120usd")))
+
+	o := selfTradeOrder(ctx, acc1, "240usd", "200eur", types.SelfTradePrevention_CancelNewest)
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+	require.True(t, findEventAttr(ctx, "self_trade"))
+
+	// The incoming order is canceled before it trades and the resting orders are left untouched
+	acc1Orders := k.GetOrdersByOwner(ctx, acc1.GetAddress())
+	require.Len(t, acc1Orders, 1)
+	require.Equal(t, resting.ClientOrderID, acc1Orders[0].ClientOrderID)
+	require.True(t, acc1Orders[0].SourceFilled.IsZero())
+
+	acc2Orders := k.GetOrdersByOwner(ctx, acc2.GetAddress())
+	require.Len(t, acc2Orders, 1)
+	require.True(t, acc2Orders[0].SourceFilled.IsZero())
+	require.Equal(t, "10000eur,10000usd", bk.GetAllBalances(ctx, acc1.GetAddress()).String())
+}
+
+func TestSelfTradeCancelOldest(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur,10000usd")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100eur", "120usd")))
+
+	// A fill-or-kill order that cannot be filled rolls back the cancellation of the resting order
+	fok := selfTradeOrder(ctx, acc1, "240usd", "200eur", types.SelfTradePrevention_CancelOldest)
+	fok.TimeInForce = types.TimeInForce_FillOrKill
+	require.NoError(t, k.NewOrderSingle(ctx, fok))
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 1)
+	require.Len(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()), 1)
+
+	// The resting order of acc1 is canceled and the incoming order trades with acc2 instead
+	o := selfTradeOrder(ctx, acc1, "120usd", "100eur", types.SelfTradePrevention_CancelOldest)
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+	require.True(t, findEventAttr(ctx, "self_trade"))
+
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()))
+	require.Equal(t, "10100eur,9880usd", bk.GetAllBalances(ctx, acc1.GetAddress()).String())
+	require.Equal(t, "9900eur,120usd", bk.GetAllBalances(ctx, acc2.GetAddress()).String())
+}
+
+func TestSelfTradeCancelBoth(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur,10000usd")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100eur", "120usd")))
+
+	o := selfTradeOrder(ctx, acc1, "120usd", "100eur", types.SelfTradePrevention_CancelBoth)
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+	require.True(t, findEventAttr(ctx, "self_trade"))
+
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+	acc2Orders := k.GetOrdersByOwner(ctx, acc2.GetAddress())
+	require.Len(t, acc2Orders, 1)
+	require.True(t, acc2Orders[0].SourceFilled.IsZero())
+	require.Equal(t, "10000eur,10000usd", bk.GetAllBalances(ctx, acc1.GetAddress()).String())
+}
+
+func TestSelfTradeDecrementAndCancel(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur,10000usd")
+
+	resting := order(ctx.BlockTime(), acc1, "100eur", "120usd")
+	require.NoError(t, k.NewOrderSingle(ctx, resting))
+
+	// The smaller incoming order is used up and the resting order is reduced by the same amount
+	o := selfTradeOrder(ctx, acc1, "60usd", "50eur", types.SelfTradePrevention_DecrementAndCancel)
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+	require.True(t, findEventAttr(ctx, "self_trade"))
+
+	acc1Orders := k.GetOrdersByOwner(ctx, acc1.GetAddress())
+	require.Len(t, acc1Orders, 1)
+	require.Equal(t, resting.ClientOrderID, acc1Orders[0].ClientOrderID)
+	require.Equal(t, "50eur", acc1Orders[0].Source.String())
+	require.Equal(t, "50", acc1Orders[0].SourceRemaining.String())
+	require.Equal(t, "60usd", acc1Orders[0].Destination.String())
+
+	// The larger incoming order cancels the resting order and rests on the book with the remainder
+	o = selfTradeOrder(ctx, acc1, "240usd", "200eur", types.SelfTradePrevention_DecrementAndCancel)
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+
+	acc1Orders = k.GetOrdersByOwner(ctx, acc1.GetAddress())
+	require.Len(t, acc1Orders, 1)
+	require.Equal(t, o.ClientOrderID, acc1Orders[0].ClientOrderID)
+	require.Equal(t, "180usd", acc1Orders[0].Source.String())
+	require.Equal(t, "150eur", acc1Orders[0].Destination.String())
+	require.True(t, acc1Orders[0].SourceFilled.IsZero())
+
+	require.Equal(t, "10000eur,10000usd", bk.GetAllBalances(ctx, acc1.GetAddress()).String())
+	msg, broken := AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestSelfTradeSynthetic(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur,6500usd")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "4500chf")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "500eur", "542chf")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "1000chf", "1028usd")))
+
+	// Only crosses the book through usd -> chf -> eur, where acc1 sells eur
+	o := selfTradeOrder(ctx, acc1, "1000usd", "880eur", types.SelfTradePrevention_CancelOldest)
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+	require.True(t, findEventAttr(ctx, "self_trade"))
+
+	acc1Orders := k.GetOrdersByOwner(ctx, acc1.GetAddress())
+	require.Len(t, acc1Orders, 1)
+	require.Equal(t, o.ClientOrderID, acc1Orders[0].ClientOrderID)
+	require.True(t, acc1Orders[0].SourceFilled.IsZero())
+
+	acc3Orders := k.GetOrdersByOwner(ctx, acc3.GetAddress())
+	require.Len(t, acc3Orders, 1)
+	require.True(t, acc3Orders[0].SourceFilled.IsZero())
+}
+
 func TestGetNextOrderNumber(t *testing.T) {
 	ctx, k, _, _ := createTestComponents(t)
 	require.Equal(t, uint64(0), k.getNextOrderNumber(ctx)) // starts with 0
@@ -1424,11 +2033,11 @@ func TestGetNextOrderNumber(t *testing.T) {
 	require.Equal(t, uint64(2), k.getNextOrderNumber(ctx)) // increments counter
 }
 
-func createTestComponents(t *testing.T) (sdk.Context, *Keeper, authkeeper.AccountKeeper, *embank.ProxyKeeper) {
+func createTestComponents(t testing.TB) (sdk.Context, *Keeper, authkeeper.AccountKeeper, *embank.ProxyKeeper) {
 	return createTestComponentsWithEncoding(t, MakeTestEncodingConfig())
 }
 
-func createTestComponentsWithEncoding(t *testing.T, encConfig simappparams.EncodingConfig) (sdk.Context, *Keeper, authkeeper.AccountKeeper, *embank.ProxyKeeper) {
+func createTestComponentsWithEncoding(t testing.TB, encConfig simappparams.EncodingConfig) (sdk.Context, *Keeper, authkeeper.AccountKeeper, *embank.ProxyKeeper) {
 	t.Helper()
 
 	var (
@@ -1450,6 +2059,7 @@ func createTestComponentsWithEncoding(t *testing.T, encConfig simappparams.Encod
 	ms.MountStoreWithDB(keyIndices, sdk.StoreTypeMemory, db2)
 	ms.MountStoreWithDB(keyAuthCap, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
 	ms.MountStoreWithDB(keyBank, sdk.StoreTypeIAVL, db)
 
 	err := ms.LoadLatestVersion()
@@ -1471,10 +2081,36 @@ func createTestComponentsWithEncoding(t *testing.T, encConfig simappparams.Encod
 
 	bk.SetSupply(ctx, banktypes.NewSupply(coins("1eur,1usd,1chf,1jpy,1gbp,1ngm")))
 
-	marketKeeper := NewKeeper(encConfig.Marshaler, keyMarket, keyIndices, ak, wrappedBank)
+	marketKeeper := NewKeeper(
+		encConfig.Marshaler, keyMarket, keyIndices, pk.Subspace(types.ModuleName), ak, wrappedBank,
+		mockStakingKeeper{}, mockAuthorityKeeper{}, testBuybackAccount, authtypes.FeeCollectorName,
+	)
+	marketKeeper.SetParams(ctx, types.DefaultParams())
 	return ctx, marketKeeper, ak, wrappedBank
 }
 
+const (
+	testStakingDenom   = "ngm"
+	testBuybackAccount = "buyback"
+)
+
+var testAuthority = randomAddress()
+
+type mockStakingKeeper struct{}
+
+func (mockStakingKeeper) BondDenom(sdk.Context) string {
+	return testStakingDenom
+}
+
+type mockAuthorityKeeper struct{}
+
+func (mockAuthorityKeeper) ValidateAuthority(_ sdk.Context, address sdk.AccAddress) error {
+	if !address.Equals(testAuthority) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, address.String())
+	}
+	return nil
+}
+
 func MakeTestEncodingConfig() simappparams.EncodingConfig {
 	cdc := codec.NewLegacyAmino()
 	interfaceRegistry := codectypes.NewInterfaceRegistry()
@@ -1518,6 +2154,69 @@ func coins(s string) sdk.Coins {
 	return coins
 }
 
+func TestTypedEvents(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	require.NoError(t, k.SetFees(ctx, testAuthority, 0, 20, nil))
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	o1 := order(ctx.BlockTime(), acc1, "1000eur", "1200usd")
+	require.NoError(t, k.NewOrderSingle(ctx, o1))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	o2 := order(ctx.BlockTime(), acc2, "1800usd", "1500eur")
+	o2.TimeInForce = types.TimeInForce_ImmediateOrCancel
+	require.NoError(t, k.NewOrderSingle(ctx, o2))
+
+	events, err := types.ParseTypedEvents(ctx.EventManager().ABCIEvents())
+	require.NoError(t, err)
+	require.Len(t, events, 5)
+
+	accepted, ok := events[0].(*types.EventOrderAccepted)
+	require.True(t, ok)
+	require.Equal(t, acc2.GetAddress().String(), accepted.Owner)
+	require.Equal(t, o2.ClientOrderID, accepted.ClientOrderId)
+	require.Equal(t, "1800usd", accepted.Source.String())
+	require.True(t, ctx.BlockTime().Equal(accepted.Created))
+
+	var fills []*types.EventOrderFilled
+	for _, ev := range events[1:] {
+		if fill, ok := ev.(*types.EventOrderFilled); ok {
+			fills = append(fills, fill)
+		}
+	}
+	require.Len(t, fills, 2)
+	for _, fill := range fills {
+		if fill.Aggressive {
+			require.Equal(t, o2.ClientOrderID, fill.ClientOrderId)
+			require.Equal(t, "1200usd", fill.SourceFilled.String())
+			require.Equal(t, "1000eur", fill.DestinationFilled.String())
+			require.Equal(t, "2eur", fill.Fee.String())
+		} else {
+			require.Equal(t, o1.ClientOrderID, fill.ClientOrderId)
+			require.Equal(t, "1000eur", fill.SourceFilled.String())
+			require.Equal(t, "0usd", fill.Fee.String())
+		}
+	}
+
+	// The remainder of the IOC order is not added to the book
+	expired, ok := events[len(events)-1].(*types.EventOrderExpired)
+	require.True(t, ok)
+	require.Equal(t, "600usd", expired.SourceRemaining.String())
+	require.Equal(t, "1000eur", expired.DestinationFilled.String())
+
+	// Legacy events are still emitted, but are not typed events
+	require.True(t, findEventAttr(ctx, "fill"))
+	for _, ev := range ctx.EventManager().ABCIEvents() {
+		if ev.Type == types.EventTypeMarket {
+			require.False(t, types.IsTypedEvent(ev))
+			_, err := types.ParseTypedEvent(ev)
+			require.Error(t, err)
+		}
+	}
+}
+
 func order(createdTm time.Time, account authtypes.AccountI, src, dst string) types.Order {
 	o, err := types.NewOrder(
 		createdTm, types.TimeInForce_GoodTillCancel, coin(src), coin(dst),
@@ -1530,6 +2229,24 @@ func order(createdTm time.Time, account authtypes.AccountI, src, dst string) typ
 	return o
 }
 
+func postOnlyOrder(ctx sdk.Context, account authtypes.AccountI, src, dst string, mode types.PostOnlyMode) types.Order {
+	o := order(ctx.BlockTime(), account, src, dst)
+	o.PostOnly = mode
+	return o
+}
+
+func icebergOrder(ctx sdk.Context, account authtypes.AccountI, src, dst string, display int64) types.Order {
+	o := order(ctx.BlockTime(), account, src, dst)
+	o.DisplayQuantity = sdk.NewInt(display)
+	return o
+}
+
+func selfTradeOrder(ctx sdk.Context, account authtypes.AccountI, src, dst string, mode types.SelfTradePrevention) types.Order {
+	o := order(ctx.BlockTime(), account, src, dst)
+	o.SelfTradePrevention = mode
+	return o
+}
+
 func createAccount(ctx sdk.Context, ak authkeeper.AccountKeeper, bk bankkeeper.SendKeeper, address sdk.AccAddress, balance string) authtypes.AccountI {
 	acc := ak.NewAccountWithAddress(ctx, address)
 	if err := bk.SetBalances(ctx, address, coins(balance)); err != nil {
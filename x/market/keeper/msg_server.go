@@ -11,10 +11,19 @@ import (
 var _ types.MsgServer = msgServer{}
 
 type marketKeeper interface {
-	NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) error
+	PlaceOrder(ctx sdk.Context, aggressiveOrder types.Order) (*types.OrderResult, error)
 	CancelOrder(ctx sdk.Context, owner sdk.AccAddress, clientOrderId string) error
-	CancelReplaceLimitOrder(ctx sdk.Context, newOrder types.Order, origClientOrderId string) error
+	CancelAllOrders(ctx sdk.Context, owner sdk.AccAddress, srcDenom, dstDenom string) error
+	CancelReplaceLimitOrder(ctx sdk.Context, newOrder types.Order, origClientOrderId string) (*types.OrderResult, error)
 	GetSrcFromSlippage(ctx sdk.Context, srcDenom string, dst sdk.Coin, maxSlippage sdk.Dec) (sdk.Coin, error)
+	AddStopOrder(ctx sdk.Context, stopOrder types.StopOrder) error
+	SetFees(ctx sdk.Context, authority sdk.AccAddress, makerFee, takerFee uint32, instrumentFees []types.InstrumentFees) error
+	SetInstrumentRules(ctx sdk.Context, authority sdk.AccAddress, rules types.InstrumentRules) error
+	HaltTrading(ctx sdk.Context, authority sdk.AccAddress, halt types.TradingHalt, cancelOrders bool) error
+	ResumeTrading(ctx sdk.Context, authority sdk.AccAddress, halt types.TradingHalt) error
+	SetPriceBand(ctx sdk.Context, authority sdk.AccAddress, band types.PriceBand) error
+	SetOrderLimits(ctx sdk.Context, authority sdk.AccAddress, maxOpenOrders, maxInstrumentOpenOrders uint32, accountLimits []types.AccountOrderLimits) error
+	SetBatchAuction(ctx sdk.Context, authority sdk.AccAddress, auction types.BatchAuction, enabled bool) error
 }
 type msgServer struct {
 	k marketKeeper
@@ -31,17 +40,25 @@ func (m msgServer) AddLimitOrder(c context.Context, msg *types.MsgAddLimitOrder)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner")
 	}
 
-	order, err := types.NewOrder(ctx.BlockTime(), msg.TimeInForce, msg.Source, msg.Destination, owner, msg.ClientOrderId)
+	order, err := types.NewOrderWithExpiry(
+		ctx.BlockTime(), msg.TimeInForce, msg.Source, msg.Destination, owner, msg.ClientOrderId,
+		msg.ExpireTime, msg.ExpireHeight,
+	)
 	if err != nil {
 		return nil, err
 	}
+	order.PostOnly = msg.PostOnly
+	order.SelfTradePrevention = msg.SelfTradePrevention
+	if !msg.DisplayQuantity.IsNil() {
+		order.DisplayQuantity = msg.DisplayQuantity
+	}
 
-	err = m.k.NewOrderSingle(ctx, order)
+	result, err := m.k.PlaceOrder(ctx, order)
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.MsgAddLimitOrderResponse{}, nil
+	return &types.MsgAddLimitOrderResponse{Result: *result}, nil
 }
 
 func (m msgServer) AddMarketOrder(c context.Context, msg *types.MsgAddMarketOrder) (*types.MsgAddMarketOrderResponse, error) {
@@ -55,15 +72,20 @@ func (m msgServer) AddMarketOrder(c context.Context, msg *types.MsgAddMarketOrde
 	}
 
 	limitMsg := &types.MsgAddLimitOrder{
-		Owner:         msg.Owner,
-		ClientOrderId: msg.ClientOrderId,
-		TimeInForce:   msg.TimeInForce,
-		Source:        slippageSource,
-		Destination:   msg.Destination,
+		Owner:               msg.Owner,
+		ClientOrderId:       msg.ClientOrderId,
+		TimeInForce:         msg.TimeInForce,
+		Source:              slippageSource,
+		Destination:         msg.Destination,
+		SelfTradePrevention: msg.SelfTradePrevention,
 	}
 
-	_, err = m.AddLimitOrder(c, limitMsg)
-	return &types.MsgAddMarketOrderResponse{}, err
+	res, err := m.AddLimitOrder(c, limitMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddMarketOrderResponse{Result: res.Result}, nil
 }
 
 func (m msgServer) CancelOrder(c context.Context, msg *types.MsgCancelOrder) (*types.MsgCancelOrderResponse, error) {
@@ -82,23 +104,46 @@ func (m msgServer) CancelOrder(c context.Context, msg *types.MsgCancelOrder) (*t
 	return &types.MsgCancelOrderResponse{}, nil
 }
 
+func (m msgServer) CancelAllOrders(c context.Context, msg *types.MsgCancelAllOrders) (*types.MsgCancelAllOrdersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner")
+	}
+
+	err = m.k.CancelAllOrders(ctx, owner, msg.Source, msg.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelAllOrdersResponse{}, nil
+}
+
 func (m msgServer) CancelReplaceLimitOrder(c context.Context, msg *types.MsgCancelReplaceLimitOrder) (*types.MsgCancelReplaceLimitOrderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	owner, err := sdk.AccAddressFromBech32(msg.Owner)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner")
 	}
-	order, err := types.NewOrder(ctx.BlockTime(), msg.TimeInForce, msg.Source, msg.Destination, owner, msg.NewClientOrderId)
+	order, err := types.NewOrderWithExpiry(
+		ctx.BlockTime(), msg.TimeInForce, msg.Source, msg.Destination, owner, msg.NewClientOrderId,
+		msg.ExpireTime, msg.ExpireHeight,
+	)
 	if err != nil {
 		return nil, err
 	}
+	order.PostOnly = msg.PostOnly
+	order.SelfTradePrevention = msg.SelfTradePrevention
+	if !msg.DisplayQuantity.IsNil() {
+		order.DisplayQuantity = msg.DisplayQuantity
+	}
 
-	err = m.k.CancelReplaceLimitOrder(ctx, order, msg.OrigClientOrderId)
+	result, err := m.k.CancelReplaceLimitOrder(ctx, order, msg.OrigClientOrderId)
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.MsgCancelReplaceLimitOrderResponse{}, nil
+	return &types.MsgCancelReplaceLimitOrderResponse{Result: *result}, nil
 }
 
 func (m msgServer) CancelReplaceMarketOrder(c context.Context, msg *types.MsgCancelReplaceMarketOrder) (*types.MsgCancelReplaceMarketOrderResponse, error) {
@@ -116,14 +161,148 @@ func (m msgServer) CancelReplaceMarketOrder(c context.Context, msg *types.MsgCan
 	}
 
 	limitMsg := &types.MsgCancelReplaceLimitOrder{
-		Owner:             msg.Owner,
-		OrigClientOrderId: msg.OrigClientOrderId,
-		NewClientOrderId:  msg.NewClientOrderId,
-		TimeInForce:       msg.TimeInForce,
-		Source:            slippageSource,
-		Destination:       msg.Destination,
+		Owner:               msg.Owner,
+		OrigClientOrderId:   msg.OrigClientOrderId,
+		NewClientOrderId:    msg.NewClientOrderId,
+		TimeInForce:         msg.TimeInForce,
+		Source:              slippageSource,
+		Destination:         msg.Destination,
+		SelfTradePrevention: msg.SelfTradePrevention,
+	}
+
+	res, err := m.CancelReplaceLimitOrder(c, limitMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelReplaceMarketOrderResponse{Result: res.Result}, nil
+}
+
+func (m msgServer) AddStopOrder(c context.Context, msg *types.MsgAddStopOrder) (*types.MsgAddStopOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner")
+	}
+
+	stopOrder, err := types.NewStopOrder(
+		ctx.BlockTime(), msg.OrderType, msg.TimeInForce, msg.Source, msg.Destination,
+		msg.StopPrice, msg.MaxSlippage, owner, msg.ClientOrderId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.k.AddStopOrder(ctx, stopOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddStopOrderResponse{}, nil
+}
+
+func (m msgServer) SetFees(c context.Context, msg *types.MsgSetFees) (*types.MsgSetFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "authority")
+	}
+
+	err = m.k.SetFees(ctx, authority, msg.MakerFee, msg.TakerFee, msg.InstrumentFees)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetFeesResponse{}, nil
+}
+
+func (m msgServer) SetInstrumentRules(c context.Context, msg *types.MsgSetInstrumentRules) (*types.MsgSetInstrumentRulesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "authority")
+	}
+
+	rules := types.NewInstrumentRules(msg.Source, msg.Destination, msg.TickSize, msg.MinOrderSize, msg.LotSize)
+	err = m.k.SetInstrumentRules(ctx, authority, rules)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetInstrumentRulesResponse{}, nil
+}
+
+func (m msgServer) HaltTrading(c context.Context, msg *types.MsgHaltTrading) (*types.MsgHaltTradingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "authority")
+	}
+
+	err = m.k.HaltTrading(ctx, authority, msg.Halt, msg.CancelOrders)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgHaltTradingResponse{}, nil
+}
+
+func (m msgServer) ResumeTrading(c context.Context, msg *types.MsgResumeTrading) (*types.MsgResumeTradingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "authority")
+	}
+
+	err = m.k.ResumeTrading(ctx, authority, msg.Halt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResumeTradingResponse{}, nil
+}
+
+func (m msgServer) SetPriceBand(c context.Context, msg *types.MsgSetPriceBand) (*types.MsgSetPriceBandResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "authority")
+	}
+
+	err = m.k.SetPriceBand(ctx, authority, msg.Band)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetPriceBandResponse{}, nil
+}
+
+func (m msgServer) SetOrderLimits(c context.Context, msg *types.MsgSetOrderLimits) (*types.MsgSetOrderLimitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "authority")
+	}
+
+	err = m.k.SetOrderLimits(ctx, authority, msg.MaxOpenOrders, msg.MaxInstrumentOpenOrders, msg.AccountOrderLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetOrderLimitsResponse{}, nil
+}
+
+func (m msgServer) SetBatchAuction(c context.Context, msg *types.MsgSetBatchAuction) (*types.MsgSetBatchAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "authority")
+	}
+
+	err = m.k.SetBatchAuction(ctx, authority, msg.Auction, msg.Enabled)
+	if err != nil {
+		return nil, err
 	}
 
-	_, err = m.CancelReplaceLimitOrder(c, limitMsg)
-	return &types.MsgCancelReplaceMarketOrderResponse{}, err
+	return &types.MsgSetBatchAuctionResponse{}, nil
 }
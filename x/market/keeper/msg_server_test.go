@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -16,8 +17,9 @@ import (
 
 func TestAddLimitOrder(t *testing.T) {
 	var (
-		ownerAddr = randomAccAddress()
-		gotOrder  types.Order
+		ownerAddr  = randomAccAddress()
+		gotOrder   types.Order
+		mockResult = types.NewOrderResult(7, types.OrderStatus_Filled, "eeur", "alx")
 	)
 
 	keeper := marketKeeperMock{}
@@ -25,7 +27,7 @@ func TestAddLimitOrder(t *testing.T) {
 
 	specs := map[string]struct {
 		req       *types.MsgAddLimitOrder
-		mockFn    func(ctx sdk.Context, aggressiveOrder types.Order) error
+		mockFn    func(ctx sdk.Context, aggressiveOrder types.Order) (*types.OrderResult, error)
 		expErr    bool
 		expEvents sdk.Events
 		expOrder  types.Order
@@ -38,7 +40,7 @@ func TestAddLimitOrder(t *testing.T) {
 				Source:        sdk.Coin{Denom: "eeur", Amount: sdk.OneInt()},
 				Destination:   sdk.Coin{Denom: "alx", Amount: sdk.OneInt()},
 			},
-			mockFn: func(ctx sdk.Context, aggressiveOrder types.Order) error {
+			mockFn: func(ctx sdk.Context, aggressiveOrder types.Order) (*types.OrderResult, error) {
 				gotOrder = aggressiveOrder
 				ctx.EventManager().EmitEvents([]sdk.Event{
 					{
@@ -46,7 +48,7 @@ func TestAddLimitOrder(t *testing.T) {
 						Attributes: []abcitypes.EventAttribute{{Key: []byte("foo"), Value: []byte("bar")}},
 					},
 				})
-				return nil
+				return &mockResult, nil
 			},
 			expEvents: sdk.Events{{
 				Type:       "testing",
@@ -61,6 +63,8 @@ func TestAddLimitOrder(t *testing.T) {
 				SourceFilled:      sdk.ZeroInt(),
 				Destination:       sdk.Coin{Denom: "alx", Amount: sdk.OneInt()},
 				DestinationFilled: sdk.ZeroInt(),
+				DisplayQuantity:   sdk.ZeroInt(),
+				DisplayRemaining:  sdk.ZeroInt(),
 			},
 		},
 		"owner missing": {
@@ -90,23 +94,24 @@ func TestAddLimitOrder(t *testing.T) {
 				Source:        sdk.Coin{Denom: "eeur", Amount: sdk.OneInt()},
 				Destination:   sdk.Coin{Denom: "alx", Amount: sdk.OneInt()},
 			},
-			mockFn: func(ctx sdk.Context, aggressiveOrder types.Order) error {
-				return errors.New("testing")
+			mockFn: func(ctx sdk.Context, aggressiveOrder types.Order) (*types.OrderResult, error) {
+				return nil, errors.New("testing")
 			},
 			expErr: true,
 		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
-			keeper.NewOrderSingleFn = spec.mockFn
+			keeper.PlaceOrderFn = spec.mockFn
 			eventManager := sdk.NewEventManager()
 			ctx := sdk.Context{}.WithContext(context.Background()).WithEventManager(eventManager)
-			_, gotErr := svr.AddLimitOrder(sdk.WrapSDKContext(ctx), spec.req)
+			res, gotErr := svr.AddLimitOrder(sdk.WrapSDKContext(ctx), spec.req)
 			if spec.expErr {
 				require.Error(t, gotErr)
 				return
 			}
 			require.NoError(t, gotErr)
+			assert.Equal(t, mockResult, res.Result)
 			assert.Equal(t, spec.expEvents, eventManager.Events())
 			assert.Equal(t, spec.expOrder, gotOrder)
 		})
@@ -120,6 +125,7 @@ func TestAddMarketOrder(t *testing.T) {
 		gotDst         sdk.Coin
 		gotMaxSlippage sdk.Dec
 		gotOrder       types.Order
+		mockResult     = types.NewOrderResult(7, types.OrderStatus_Filled, "eeur", "alx")
 	)
 
 	keeper := marketKeeperMock{}
@@ -127,7 +133,7 @@ func TestAddMarketOrder(t *testing.T) {
 
 	specs := map[string]struct {
 		req                      *types.MsgAddMarketOrder
-		mockAddLimitOrderFn      func(ctx sdk.Context, aggressiveOrder types.Order) error
+		mockAddLimitOrderFn      func(ctx sdk.Context, aggressiveOrder types.Order) (*types.OrderResult, error)
 		mockGetSrcFromSlippageFn func(ctx sdk.Context, srcDenom string, dst sdk.Coin, maxSlippage sdk.Dec) (sdk.Coin, error)
 		expErr                   bool
 		expSrc                   sdk.Coin
@@ -148,7 +154,7 @@ func TestAddMarketOrder(t *testing.T) {
 				gotDst, gotMaxSlippage = dst, maxSlippage
 				return gotSrc, nil
 			},
-			mockAddLimitOrderFn: func(ctx sdk.Context, aggressiveOrder types.Order) error {
+			mockAddLimitOrderFn: func(ctx sdk.Context, aggressiveOrder types.Order) (*types.OrderResult, error) {
 				gotOrder = aggressiveOrder
 				ctx.EventManager().EmitEvents([]sdk.Event{
 					{
@@ -156,7 +162,7 @@ func TestAddMarketOrder(t *testing.T) {
 						Attributes: []abcitypes.EventAttribute{{Key: []byte("foo"), Value: []byte("bar")}},
 					},
 				})
-				return nil
+				return &mockResult, nil
 			},
 			expEvents: sdk.Events{{
 				Type:       "testing",
@@ -172,6 +178,8 @@ func TestAddMarketOrder(t *testing.T) {
 				SourceFilled:      sdk.ZeroInt(),
 				Destination:       sdk.Coin{Denom: "alx", Amount: sdk.OneInt()},
 				DestinationFilled: sdk.ZeroInt(),
+				DisplayQuantity:   sdk.ZeroInt(),
+				DisplayRemaining:  sdk.ZeroInt(),
 			},
 		},
 		"owner missing": {
@@ -231,8 +239,8 @@ func TestAddMarketOrder(t *testing.T) {
 				gotSrc = sdk.NewCoin(srcDenom, sdk.OneInt())
 				return gotSrc, nil
 			},
-			mockAddLimitOrderFn: func(ctx sdk.Context, aggressiveOrder types.Order) error {
-				return errors.New("testing")
+			mockAddLimitOrderFn: func(ctx sdk.Context, aggressiveOrder types.Order) (*types.OrderResult, error) {
+				return nil, errors.New("testing")
 			},
 			expErr: true,
 		},
@@ -240,15 +248,16 @@ func TestAddMarketOrder(t *testing.T) {
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			keeper.GetSrcFromSlippageFn = spec.mockGetSrcFromSlippageFn
-			keeper.NewOrderSingleFn = spec.mockAddLimitOrderFn
+			keeper.PlaceOrderFn = spec.mockAddLimitOrderFn
 			eventManager := sdk.NewEventManager()
 			ctx := sdk.Context{}.WithContext(context.Background()).WithEventManager(eventManager)
-			_, gotErr := svr.AddMarketOrder(sdk.WrapSDKContext(ctx), spec.req)
+			res, gotErr := svr.AddMarketOrder(sdk.WrapSDKContext(ctx), spec.req)
 			if spec.expErr {
 				require.Error(t, gotErr)
 				return
 			}
 			require.NoError(t, gotErr)
+			assert.Equal(t, mockResult, res.Result)
 			require.Equal(t, spec.expOrder.String(), gotOrder.String())
 			assert.Equal(t, spec.expEvents, eventManager.Events())
 			assert.Equal(t, spec.expSrc, gotSrc)
@@ -334,11 +343,78 @@ func TestCancelOrder(t *testing.T) {
 		})
 	}
 }
+func TestCancelAllOrders(t *testing.T) {
+	var (
+		ownerAddr      = randomAccAddress()
+		gotOwner       sdk.AccAddress
+		gotSrc, gotDst string
+	)
+
+	keeper := marketKeeperMock{}
+	svr := NewMsgServerImpl(&keeper)
+
+	specs := map[string]struct {
+		req    *types.MsgCancelAllOrders
+		mockFn func(ctx sdk.Context, owner sdk.AccAddress, srcDenom, dstDenom string) error
+		expErr bool
+	}{
+		"all good": {
+			req: &types.MsgCancelAllOrders{
+				Owner:       ownerAddr.String(),
+				Source:      "eur",
+				Destination: "usd",
+			},
+			mockFn: func(ctx sdk.Context, owner sdk.AccAddress, srcDenom, dstDenom string) error {
+				gotOwner, gotSrc, gotDst = owner, srcDenom, dstDenom
+				return nil
+			},
+		},
+		"no filters": {
+			req: &types.MsgCancelAllOrders{
+				Owner: ownerAddr.String(),
+			},
+			mockFn: func(ctx sdk.Context, owner sdk.AccAddress, srcDenom, dstDenom string) error {
+				gotOwner, gotSrc, gotDst = owner, srcDenom, dstDenom
+				return nil
+			},
+		},
+		"owner missing": {
+			req:    &types.MsgCancelAllOrders{},
+			expErr: true,
+		},
+		"processing failure": {
+			req: &types.MsgCancelAllOrders{
+				Owner: ownerAddr.String(),
+			},
+			mockFn: func(ctx sdk.Context, owner sdk.AccAddress, srcDenom, dstDenom string) error {
+				return errors.New("testing")
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeper.CancelAllOrdersFn = spec.mockFn
+			ctx := sdk.Context{}.WithContext(context.Background())
+			_, gotErr := svr.CancelAllOrders(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, ownerAddr, gotOwner)
+			assert.Equal(t, spec.req.Source, gotSrc)
+			assert.Equal(t, spec.req.Destination, gotDst)
+		})
+	}
+}
+
 func TestCancelReplaceLimitOrder(t *testing.T) {
 	var (
 		ownerAddr            = randomAccAddress()
 		gotOrder             types.Order
 		gotOrigClientOrderId string
+		mockResult           = types.NewOrderResult(7, types.OrderStatus_Filled, "eeur", "alx")
 	)
 
 	keeper := marketKeeperMock{}
@@ -346,7 +422,7 @@ func TestCancelReplaceLimitOrder(t *testing.T) {
 
 	specs := map[string]struct {
 		req       *types.MsgCancelReplaceLimitOrder
-		mockFn    func(ctx sdk.Context, newOrder types.Order, origClientOrderId string) error
+		mockFn    func(ctx sdk.Context, newOrder types.Order, origClientOrderId string) (*types.OrderResult, error)
 		expErr    bool
 		expEvents sdk.Events
 		expOrder  types.Order
@@ -360,7 +436,7 @@ func TestCancelReplaceLimitOrder(t *testing.T) {
 				Source:            sdk.Coin{Denom: "eeur", Amount: sdk.OneInt()},
 				Destination:       sdk.Coin{Denom: "alx", Amount: sdk.OneInt()},
 			},
-			mockFn: func(ctx sdk.Context, newOrder types.Order, origClientOrderId string) error {
+			mockFn: func(ctx sdk.Context, newOrder types.Order, origClientOrderId string) (*types.OrderResult, error) {
 				gotOrder, gotOrigClientOrderId = newOrder, origClientOrderId
 				ctx.EventManager().EmitEvents([]sdk.Event{
 					{
@@ -368,7 +444,7 @@ func TestCancelReplaceLimitOrder(t *testing.T) {
 						Attributes: []abcitypes.EventAttribute{{Key: []byte("foo"), Value: []byte("bar")}},
 					},
 				})
-				return nil
+				return &mockResult, nil
 			},
 			expEvents: sdk.Events{{
 				Type:       "testing",
@@ -383,6 +459,8 @@ func TestCancelReplaceLimitOrder(t *testing.T) {
 				SourceFilled:      sdk.ZeroInt(),
 				Destination:       sdk.Coin{Denom: "alx", Amount: sdk.OneInt()},
 				DestinationFilled: sdk.ZeroInt(),
+				DisplayQuantity:   sdk.ZeroInt(),
+				DisplayRemaining:  sdk.ZeroInt(),
 			},
 		},
 		"Time In Force invalid": {
@@ -423,8 +501,8 @@ func TestCancelReplaceLimitOrder(t *testing.T) {
 				Source:            sdk.Coin{Denom: "eeur", Amount: sdk.OneInt()},
 				Destination:       sdk.Coin{Denom: "alx", Amount: sdk.OneInt()},
 			},
-			mockFn: func(ctx sdk.Context, newOrder types.Order, origClientOrderId string) error {
-				return errors.New("testing")
+			mockFn: func(ctx sdk.Context, newOrder types.Order, origClientOrderId string) (*types.OrderResult, error) {
+				return nil, errors.New("testing")
 			},
 			expErr: true,
 		},
@@ -434,12 +512,13 @@ func TestCancelReplaceLimitOrder(t *testing.T) {
 			keeper.CancelReplaceLimitOrderFn = spec.mockFn
 			eventManager := sdk.NewEventManager()
 			ctx := sdk.Context{}.WithContext(context.Background()).WithEventManager(eventManager)
-			_, gotErr := svr.CancelReplaceLimitOrder(sdk.WrapSDKContext(ctx), spec.req)
+			res, gotErr := svr.CancelReplaceLimitOrder(sdk.WrapSDKContext(ctx), spec.req)
 			if spec.expErr {
 				require.Error(t, gotErr)
 				return
 			}
 			require.NoError(t, gotErr)
+			assert.Equal(t, mockResult, res.Result)
 			assert.Equal(t, spec.expEvents, eventManager.Events())
 			assert.Equal(t, spec.expOrder, gotOrder)
 			assert.Equal(t, spec.req.OrigClientOrderId, gotOrigClientOrderId)
@@ -453,6 +532,7 @@ func TestCancelReplaceMarketOrder(t *testing.T) {
 		gotOrder             types.Order
 		gotSrc               sdk.Coin
 		gotOrigClientOrderId string
+		mockResult           = types.NewOrderResult(7, types.OrderStatus_Filled, "eeur", "alx")
 	)
 
 	keeper := marketKeeperMock{}
@@ -461,7 +541,7 @@ func TestCancelReplaceMarketOrder(t *testing.T) {
 	specs := map[string]struct {
 		req                           *types.MsgCancelReplaceMarketOrder
 		mockGetSrcFromSlippageFn      func(ctx sdk.Context, srcDenom string, dst sdk.Coin, maxSlippage sdk.Dec) (sdk.Coin, error)
-		mockCancelReplaceLimitOrderFn func(ctx sdk.Context, newOrder types.Order, origClientOrderId string) error
+		mockCancelReplaceLimitOrderFn func(ctx sdk.Context, newOrder types.Order, origClientOrderId string) (*types.OrderResult, error)
 		expErr                        bool
 		expEvents                     sdk.Events
 		expSrc                        sdk.Coin
@@ -481,7 +561,7 @@ func TestCancelReplaceMarketOrder(t *testing.T) {
 				gotSrc = sdk.NewCoin(srcDenom, sdk.OneInt())
 				return gotSrc, nil
 			},
-			mockCancelReplaceLimitOrderFn: func(ctx sdk.Context, newOrder types.Order, origClientOrderId string) error {
+			mockCancelReplaceLimitOrderFn: func(ctx sdk.Context, newOrder types.Order, origClientOrderId string) (*types.OrderResult, error) {
 				gotOrder, gotOrigClientOrderId = newOrder, origClientOrderId
 				ctx.EventManager().EmitEvents([]sdk.Event{
 					{
@@ -489,7 +569,7 @@ func TestCancelReplaceMarketOrder(t *testing.T) {
 						Attributes: []abcitypes.EventAttribute{{Key: []byte("foo"), Value: []byte("bar")}},
 					},
 				})
-				return nil
+				return &mockResult, nil
 			},
 			expEvents: sdk.Events{{
 				Type:       "testing",
@@ -505,6 +585,8 @@ func TestCancelReplaceMarketOrder(t *testing.T) {
 				SourceFilled:      sdk.ZeroInt(),
 				Destination:       sdk.Coin{Denom: "alx", Amount: sdk.OneInt()},
 				DestinationFilled: sdk.ZeroInt(),
+				DisplayQuantity:   sdk.ZeroInt(),
+				DisplayRemaining:  sdk.ZeroInt(),
 			},
 		},
 		"Time In Force invalid": {
@@ -585,12 +667,13 @@ func TestCancelReplaceMarketOrder(t *testing.T) {
 			keeper.CancelReplaceLimitOrderFn = spec.mockCancelReplaceLimitOrderFn
 			eventManager := sdk.NewEventManager()
 			ctx := sdk.Context{}.WithContext(context.Background()).WithEventManager(eventManager)
-			_, gotErr := svr.CancelReplaceMarketOrder(sdk.WrapSDKContext(ctx), spec.req)
+			res, gotErr := svr.CancelReplaceMarketOrder(sdk.WrapSDKContext(ctx), spec.req)
 			if spec.expErr {
 				require.Error(t, gotErr)
 				return
 			}
 			require.NoError(t, gotErr)
+			assert.Equal(t, mockResult, res.Result)
 			assert.Equal(t, spec.expEvents, eventManager.Events())
 			assert.Equal(t, spec.expSrc.String(), gotSrc.String())
 			assert.Equal(t, spec.expOrder, gotOrder)
@@ -599,12 +682,434 @@ func TestCancelReplaceMarketOrder(t *testing.T) {
 	}
 }
 
+func TestSetFees(t *testing.T) {
+	var (
+		authority    = randomAccAddress()
+		gotAuthority sdk.AccAddress
+		gotMakerFee  uint32
+		gotTakerFee  uint32
+		gotFees      []types.InstrumentFees
+	)
+
+	keeper := marketKeeperMock{}
+	svr := NewMsgServerImpl(&keeper)
+
+	instrumentFees := []types.InstrumentFees{{Source: "eur", Destination: "usd", MakerFee: 1, TakerFee: 2}}
+
+	specs := map[string]struct {
+		req         *types.MsgSetFees
+		mockFn      func(ctx sdk.Context, authority sdk.AccAddress, makerFee, takerFee uint32, instrumentFees []types.InstrumentFees) error
+		expErr      bool
+		expMakerFee uint32
+		expTakerFee uint32
+	}{
+		"all good": {
+			req: &types.MsgSetFees{
+				Authority:      authority.String(),
+				MakerFee:       10,
+				TakerFee:       20,
+				InstrumentFees: instrumentFees,
+			},
+			mockFn: func(ctx sdk.Context, authority sdk.AccAddress, makerFee, takerFee uint32, instrumentFees []types.InstrumentFees) error {
+				gotAuthority, gotMakerFee, gotTakerFee, gotFees = authority, makerFee, takerFee, instrumentFees
+				return nil
+			},
+			expMakerFee: 10,
+			expTakerFee: 20,
+		},
+		"authority missing": {
+			req: &types.MsgSetFees{
+				MakerFee: 10,
+			},
+			expErr: true,
+		},
+		"processing failure": {
+			req: &types.MsgSetFees{
+				Authority: authority.String(),
+			},
+			mockFn: func(ctx sdk.Context, authority sdk.AccAddress, makerFee, takerFee uint32, instrumentFees []types.InstrumentFees) error {
+				return errors.New("testing")
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeper.SetFeesFn = spec.mockFn
+			ctx := sdk.Context{}.WithContext(context.Background())
+			_, gotErr := svr.SetFees(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, authority, gotAuthority)
+			assert.Equal(t, spec.expMakerFee, gotMakerFee)
+			assert.Equal(t, spec.expTakerFee, gotTakerFee)
+			assert.Equal(t, instrumentFees, gotFees)
+		})
+	}
+}
+
+func TestSetInstrumentRules(t *testing.T) {
+	var (
+		authority    = randomAccAddress()
+		gotAuthority sdk.AccAddress
+		gotRules     types.InstrumentRules
+	)
+
+	keeper := marketKeeperMock{}
+	svr := NewMsgServerImpl(&keeper)
+
+	specs := map[string]struct {
+		req      *types.MsgSetInstrumentRules
+		mockFn   func(ctx sdk.Context, authority sdk.AccAddress, rules types.InstrumentRules) error
+		expErr   bool
+		expRules types.InstrumentRules
+	}{
+		"all good": {
+			req: &types.MsgSetInstrumentRules{
+				Authority:    authority.String(),
+				Source:       "eur",
+				Destination:  "usd",
+				TickSize:     sdk.NewDecWithPrec(1, 2),
+				MinOrderSize: sdk.NewInt(100),
+				LotSize:      sdk.NewInt(10),
+			},
+			mockFn: func(ctx sdk.Context, authority sdk.AccAddress, rules types.InstrumentRules) error {
+				gotAuthority, gotRules = authority, rules
+				return nil
+			},
+			expRules: types.NewInstrumentRules("eur", "usd", sdk.NewDecWithPrec(1, 2), sdk.NewInt(100), sdk.NewInt(10)),
+		},
+		"authority missing": {
+			req: &types.MsgSetInstrumentRules{
+				Source:      "eur",
+				Destination: "usd",
+			},
+			expErr: true,
+		},
+		"processing failure": {
+			req: &types.MsgSetInstrumentRules{
+				Authority:    authority.String(),
+				Source:       "eur",
+				Destination:  "usd",
+				TickSize:     sdk.ZeroDec(),
+				MinOrderSize: sdk.ZeroInt(),
+				LotSize:      sdk.ZeroInt(),
+			},
+			mockFn: func(ctx sdk.Context, authority sdk.AccAddress, rules types.InstrumentRules) error {
+				return errors.New("testing")
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeper.SetInstrumentRulesFn = spec.mockFn
+			ctx := sdk.Context{}.WithContext(context.Background())
+			_, gotErr := svr.SetInstrumentRules(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, authority, gotAuthority)
+			assert.Equal(t, spec.expRules, gotRules)
+		})
+	}
+}
+
+func TestHaltTrading(t *testing.T) {
+	var (
+		authority       = randomAccAddress()
+		gotAuthority    sdk.AccAddress
+		gotHalt         types.TradingHalt
+		gotCancelOrders bool
+	)
+
+	keeper := marketKeeperMock{}
+	svr := NewMsgServerImpl(&keeper)
+
+	specs := map[string]struct {
+		req    *types.MsgHaltTrading
+		mockFn func(ctx sdk.Context, authority sdk.AccAddress, halt types.TradingHalt, cancelOrders bool) error
+		expErr bool
+	}{
+		"all good": {
+			req: &types.MsgHaltTrading{
+				Authority:    authority.String(),
+				Halt:         types.NewInstrumentHalt("eur", "usd"),
+				CancelOrders: true,
+			},
+			mockFn: func(ctx sdk.Context, authority sdk.AccAddress, halt types.TradingHalt, cancelOrders bool) error {
+				gotAuthority, gotHalt, gotCancelOrders = authority, halt, cancelOrders
+				return nil
+			},
+		},
+		"authority missing": {
+			req:    &types.MsgHaltTrading{Halt: types.NewInstrumentHalt("eur", "usd")},
+			expErr: true,
+		},
+		"processing failure": {
+			req: &types.MsgHaltTrading{
+				Authority: authority.String(),
+				Halt:      types.NewInstrumentHalt("eur", "usd"),
+			},
+			mockFn: func(ctx sdk.Context, authority sdk.AccAddress, halt types.TradingHalt, cancelOrders bool) error {
+				return errors.New("testing")
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeper.HaltTradingFn = spec.mockFn
+			ctx := sdk.Context{}.WithContext(context.Background())
+			_, gotErr := svr.HaltTrading(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, authority, gotAuthority)
+			assert.Equal(t, spec.req.Halt, gotHalt)
+			assert.True(t, gotCancelOrders)
+		})
+	}
+}
+
+func TestResumeTrading(t *testing.T) {
+	var (
+		authority    = randomAccAddress()
+		gotAuthority sdk.AccAddress
+		gotHalt      types.TradingHalt
+	)
+
+	keeper := marketKeeperMock{}
+	svr := NewMsgServerImpl(&keeper)
+
+	specs := map[string]struct {
+		req    *types.MsgResumeTrading
+		mockFn func(ctx sdk.Context, authority sdk.AccAddress, halt types.TradingHalt) error
+		expErr bool
+	}{
+		"all good": {
+			req: &types.MsgResumeTrading{Authority: authority.String(), Halt: types.NewDenomHalt("eur")},
+			mockFn: func(ctx sdk.Context, authority sdk.AccAddress, halt types.TradingHalt) error {
+				gotAuthority, gotHalt = authority, halt
+				return nil
+			},
+		},
+		"authority missing": {
+			req:    &types.MsgResumeTrading{Halt: types.NewDenomHalt("eur")},
+			expErr: true,
+		},
+		"processing failure": {
+			req: &types.MsgResumeTrading{Authority: authority.String(), Halt: types.NewDenomHalt("eur")},
+			mockFn: func(ctx sdk.Context, authority sdk.AccAddress, halt types.TradingHalt) error {
+				return errors.New("testing")
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeper.ResumeTradingFn = spec.mockFn
+			ctx := sdk.Context{}.WithContext(context.Background())
+			_, gotErr := svr.ResumeTrading(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, authority, gotAuthority)
+			assert.Equal(t, spec.req.Halt, gotHalt)
+		})
+	}
+}
+
+func TestSetPriceBand(t *testing.T) {
+	var (
+		authority    = randomAccAddress()
+		gotAuthority sdk.AccAddress
+		gotBand      types.PriceBand
+	)
+
+	keeper := marketKeeperMock{}
+	svr := NewMsgServerImpl(&keeper)
+
+	band := types.NewPriceBand("eur", "usd", sdk.NewDecWithPrec(1, 1), sdk.ZeroDec(), 3, time.Minute, time.Hour)
+	specs := map[string]struct {
+		req    *types.MsgSetPriceBand
+		mockFn func(ctx sdk.Context, authority sdk.AccAddress, band types.PriceBand) error
+		expErr bool
+	}{
+		"all good": {
+			req: &types.MsgSetPriceBand{Authority: authority.String(), Band: band},
+			mockFn: func(ctx sdk.Context, authority sdk.AccAddress, band types.PriceBand) error {
+				gotAuthority, gotBand = authority, band
+				return nil
+			},
+		},
+		"authority missing": {
+			req:    &types.MsgSetPriceBand{Band: band},
+			expErr: true,
+		},
+		"processing failure": {
+			req: &types.MsgSetPriceBand{Authority: authority.String(), Band: band},
+			mockFn: func(ctx sdk.Context, authority sdk.AccAddress, band types.PriceBand) error {
+				return errors.New("testing")
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeper.SetPriceBandFn = spec.mockFn
+			ctx := sdk.Context{}.WithContext(context.Background())
+			_, gotErr := svr.SetPriceBand(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, authority, gotAuthority)
+			assert.Equal(t, spec.req.Band, gotBand)
+		})
+	}
+}
+
+func TestSetOrderLimits(t *testing.T) {
+	var (
+		authority                  = randomAccAddress()
+		gotAuthority               sdk.AccAddress
+		gotMaxOpenOrders           uint32
+		gotMaxInstrumentOpenOrders uint32
+		gotAccountLimits           []types.AccountOrderLimits
+	)
+
+	keeper := marketKeeperMock{}
+	svr := NewMsgServerImpl(&keeper)
+
+	accountLimits := []types.AccountOrderLimits{{Account: randomAccAddress().String(), MaxOpenOrders: 1000, MaxInstrumentOpenOrders: 500}}
+
+	specs := map[string]struct {
+		req    *types.MsgSetOrderLimits
+		mockFn func(ctx sdk.Context, authority sdk.AccAddress, maxOpenOrders, maxInstrumentOpenOrders uint32, accountLimits []types.AccountOrderLimits) error
+		expErr bool
+	}{
+		"all good": {
+			req: &types.MsgSetOrderLimits{
+				Authority:               authority.String(),
+				MaxOpenOrders:           100,
+				MaxInstrumentOpenOrders: 20,
+				AccountOrderLimits:      accountLimits,
+			},
+			mockFn: func(ctx sdk.Context, authority sdk.AccAddress, maxOpenOrders, maxInstrumentOpenOrders uint32, accountLimits []types.AccountOrderLimits) error {
+				gotAuthority, gotMaxOpenOrders, gotMaxInstrumentOpenOrders, gotAccountLimits = authority, maxOpenOrders, maxInstrumentOpenOrders, accountLimits
+				return nil
+			},
+		},
+		"authority missing": {
+			req:    &types.MsgSetOrderLimits{MaxOpenOrders: 100},
+			expErr: true,
+		},
+		"processing failure": {
+			req: &types.MsgSetOrderLimits{Authority: authority.String()},
+			mockFn: func(ctx sdk.Context, authority sdk.AccAddress, maxOpenOrders, maxInstrumentOpenOrders uint32, accountLimits []types.AccountOrderLimits) error {
+				return errors.New("testing")
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeper.SetOrderLimitsFn = spec.mockFn
+			ctx := sdk.Context{}.WithContext(context.Background())
+			_, gotErr := svr.SetOrderLimits(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, authority, gotAuthority)
+			assert.Equal(t, uint32(100), gotMaxOpenOrders)
+			assert.Equal(t, uint32(20), gotMaxInstrumentOpenOrders)
+			assert.Equal(t, accountLimits, gotAccountLimits)
+		})
+	}
+}
+
+func TestSetBatchAuction(t *testing.T) {
+	var (
+		authority    = randomAccAddress()
+		gotAuthority sdk.AccAddress
+		gotAuction   types.BatchAuction
+		gotEnabled   bool
+	)
+
+	keeper := marketKeeperMock{}
+	svr := NewMsgServerImpl(&keeper)
+
+	auction := types.NewBatchAuction("eur", "usd")
+
+	specs := map[string]struct {
+		req    *types.MsgSetBatchAuction
+		mockFn func(ctx sdk.Context, authority sdk.AccAddress, auction types.BatchAuction, enabled bool) error
+		expErr bool
+	}{
+		"all good": {
+			req: &types.MsgSetBatchAuction{Authority: authority.String(), Auction: auction, Enabled: true},
+			mockFn: func(ctx sdk.Context, authority sdk.AccAddress, auction types.BatchAuction, enabled bool) error {
+				gotAuthority, gotAuction, gotEnabled = authority, auction, enabled
+				return nil
+			},
+		},
+		"authority missing": {
+			req:    &types.MsgSetBatchAuction{Auction: auction, Enabled: true},
+			expErr: true,
+		},
+		"processing failure": {
+			req: &types.MsgSetBatchAuction{Authority: authority.String(), Auction: auction},
+			mockFn: func(ctx sdk.Context, authority sdk.AccAddress, auction types.BatchAuction, enabled bool) error {
+				return errors.New("testing")
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeper.SetBatchAuctionFn = spec.mockFn
+			ctx := sdk.Context{}.WithContext(context.Background())
+			_, gotErr := svr.SetBatchAuction(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, authority, gotAuthority)
+			assert.Equal(t, auction, gotAuction)
+			assert.True(t, gotEnabled)
+		})
+	}
+}
+
 type marketKeeperMock struct {
 	NewMarketOrderWithSlippageFn func(ctx sdk.Context, srcDenom string, dst sdk.Coin, maxSlippage sdk.Dec, owner sdk.AccAddress, timeInForce types.TimeInForce, clientOrderId string) error
-	NewOrderSingleFn             func(ctx sdk.Context, aggressiveOrder types.Order) error
+	PlaceOrderFn                 func(ctx sdk.Context, aggressiveOrder types.Order) (*types.OrderResult, error)
 	CancelOrderFn                func(ctx sdk.Context, owner sdk.AccAddress, clientOrderId string) error
-	CancelReplaceLimitOrderFn    func(ctx sdk.Context, newOrder types.Order, origClientOrderId string) error
+	CancelAllOrdersFn            func(ctx sdk.Context, owner sdk.AccAddress, srcDenom, dstDenom string) error
+	CancelReplaceLimitOrderFn    func(ctx sdk.Context, newOrder types.Order, origClientOrderId string) (*types.OrderResult, error)
 	GetSrcFromSlippageFn         func(ctx sdk.Context, srcDenom string, dst sdk.Coin, maxSlippage sdk.Dec) (sdk.Coin, error)
+	AddStopOrderFn               func(ctx sdk.Context, stopOrder types.StopOrder) error
+	SetFeesFn                    func(ctx sdk.Context, authority sdk.AccAddress, makerFee, takerFee uint32, instrumentFees []types.InstrumentFees) error
+	SetInstrumentRulesFn         func(ctx sdk.Context, authority sdk.AccAddress, rules types.InstrumentRules) error
+	HaltTradingFn                func(ctx sdk.Context, authority sdk.AccAddress, halt types.TradingHalt, cancelOrders bool) error
+	ResumeTradingFn              func(ctx sdk.Context, authority sdk.AccAddress, halt types.TradingHalt) error
+	SetPriceBandFn               func(ctx sdk.Context, authority sdk.AccAddress, band types.PriceBand) error
+	SetOrderLimitsFn             func(ctx sdk.Context, authority sdk.AccAddress, maxOpenOrders, maxInstrumentOpenOrders uint32, accountLimits []types.AccountOrderLimits) error
+	SetBatchAuctionFn            func(ctx sdk.Context, authority sdk.AccAddress, auction types.BatchAuction, enabled bool) error
 }
 
 func (m marketKeeperMock) NewMarketOrderWithSlippage(ctx sdk.Context, srcDenom string, dst sdk.Coin, maxSlippage sdk.Dec, owner sdk.AccAddress, timeInForce types.TimeInForce, clientOrderId string) error {
@@ -614,11 +1119,11 @@ func (m marketKeeperMock) NewMarketOrderWithSlippage(ctx sdk.Context, srcDenom s
 	return m.NewMarketOrderWithSlippageFn(ctx, srcDenom, dst, maxSlippage, owner, timeInForce, clientOrderId)
 }
 
-func (m marketKeeperMock) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) error {
-	if m.NewOrderSingleFn == nil {
+func (m marketKeeperMock) PlaceOrder(ctx sdk.Context, aggressiveOrder types.Order) (*types.OrderResult, error) {
+	if m.PlaceOrderFn == nil {
 		panic("not expected to be called")
 	}
-	return m.NewOrderSingleFn(ctx, aggressiveOrder)
+	return m.PlaceOrderFn(ctx, aggressiveOrder)
 }
 
 func (m marketKeeperMock) CancelOrder(ctx sdk.Context, owner sdk.AccAddress, clientOrderId string) error {
@@ -628,7 +1133,14 @@ func (m marketKeeperMock) CancelOrder(ctx sdk.Context, owner sdk.AccAddress, cli
 	return m.CancelOrderFn(ctx, owner, clientOrderId)
 }
 
-func (m marketKeeperMock) CancelReplaceLimitOrder(ctx sdk.Context, newOrder types.Order, origClientOrderId string) error {
+func (m marketKeeperMock) CancelAllOrders(ctx sdk.Context, owner sdk.AccAddress, srcDenom, dstDenom string) error {
+	if m.CancelAllOrdersFn == nil {
+		panic("not expected to be called")
+	}
+	return m.CancelAllOrdersFn(ctx, owner, srcDenom, dstDenom)
+}
+
+func (m marketKeeperMock) CancelReplaceLimitOrder(ctx sdk.Context, newOrder types.Order, origClientOrderId string) (*types.OrderResult, error) {
 	if m.CancelReplaceLimitOrderFn == nil {
 		panic("not expected to be called")
 	}
@@ -642,6 +1154,62 @@ func (m marketKeeperMock) GetSrcFromSlippage(ctx sdk.Context, srcDenom string, d
 	return m.GetSrcFromSlippageFn(ctx, srcDenom, dst, maxSlippage)
 }
 
+func (m marketKeeperMock) AddStopOrder(ctx sdk.Context, stopOrder types.StopOrder) error {
+	if m.AddStopOrderFn == nil {
+		panic("not expected to be called")
+	}
+	return m.AddStopOrderFn(ctx, stopOrder)
+}
+
+func (m marketKeeperMock) SetFees(ctx sdk.Context, authority sdk.AccAddress, makerFee, takerFee uint32, instrumentFees []types.InstrumentFees) error {
+	if m.SetFeesFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SetFeesFn(ctx, authority, makerFee, takerFee, instrumentFees)
+}
+
+func (m marketKeeperMock) SetInstrumentRules(ctx sdk.Context, authority sdk.AccAddress, rules types.InstrumentRules) error {
+	if m.SetInstrumentRulesFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SetInstrumentRulesFn(ctx, authority, rules)
+}
+
+func (m marketKeeperMock) HaltTrading(ctx sdk.Context, authority sdk.AccAddress, halt types.TradingHalt, cancelOrders bool) error {
+	if m.HaltTradingFn == nil {
+		panic("not expected to be called")
+	}
+	return m.HaltTradingFn(ctx, authority, halt, cancelOrders)
+}
+
+func (m marketKeeperMock) ResumeTrading(ctx sdk.Context, authority sdk.AccAddress, halt types.TradingHalt) error {
+	if m.ResumeTradingFn == nil {
+		panic("not expected to be called")
+	}
+	return m.ResumeTradingFn(ctx, authority, halt)
+}
+
+func (m marketKeeperMock) SetPriceBand(ctx sdk.Context, authority sdk.AccAddress, band types.PriceBand) error {
+	if m.SetPriceBandFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SetPriceBandFn(ctx, authority, band)
+}
+
+func (m marketKeeperMock) SetOrderLimits(ctx sdk.Context, authority sdk.AccAddress, maxOpenOrders, maxInstrumentOpenOrders uint32, accountLimits []types.AccountOrderLimits) error {
+	if m.SetOrderLimitsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SetOrderLimitsFn(ctx, authority, maxOpenOrders, maxInstrumentOpenOrders, accountLimits)
+}
+
+func (m marketKeeperMock) SetBatchAuction(ctx sdk.Context, authority sdk.AccAddress, auction types.BatchAuction, enabled bool) error {
+	if m.SetBatchAuctionFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SetBatchAuctionFn(ctx, authority, auction, enabled)
+}
+
 func randomAccAddress() sdk.AccAddress {
 	return rand.Bytes(sdk.AddrLen)
 }
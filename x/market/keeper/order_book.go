@@ -0,0 +1,191 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+const (
+	// Priority keys end with the big-endian time priority of the order, which follows the sortable price.
+	orderIdKeyLength = 8
+	// Synthetic levels cannot be paginated, so the number of levels returned at once is bounded.
+	maxSyntheticDepth = 100
+)
+
+// GetOrderBookLevels aggregates the resting orders of an instrument into price levels, best price first.
+// Only the first depth levels are considered, unless depth is zero. The levels within the depth are paginated, using
+// the sortable price of a level as the pagination key.
+func (k Keeper) GetOrderBookLevels(ctx sdk.Context, src, dst string, depth uint32, pageReq *query.PageRequest) ([]types.OrderBookLevel, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	offset, key, limit, countTotal := pageReq.Offset, pageReq.Key, pageReq.Limit, pageReq.CountTotal
+	if offset > 0 && key != nil {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "either offset or key is expected, got both")
+	}
+
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.keyIndices), types.GetPriorityKeyByInstrument(src, dst))
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	var (
+		levels   = make([]types.OrderBookLevel, 0)
+		levelKey []byte
+		total    uint64 // Levels seen from the best price
+		eligible uint64 // Levels seen from the pagination key
+		nextKey  []byte
+		current  *types.OrderBookLevel
+	)
+
+	for ; it.Valid(); it.Next() {
+		priceKey := it.Key()[:len(it.Key())-orderIdKeyLength]
+
+		if !bytes.Equal(priceKey, levelKey) {
+			if depth > 0 && total == uint64(depth) {
+				break
+			}
+
+			levelKey, current = priceKey, nil
+			total++
+
+			if key != nil && bytes.Compare(levelKey, key) < 0 {
+				continue
+			}
+
+			eligible++
+			if eligible <= offset {
+				continue
+			}
+
+			if eligible == offset+limit+1 {
+				nextKey = levelKey
+				if !countTotal {
+					break
+				}
+			}
+
+			if eligible <= offset+limit {
+				levels = append(levels, types.OrderBookLevel{SourceRemaining: sdk.ZeroInt()})
+				current = &levels[len(levels)-1]
+			}
+		}
+
+		if current == nil {
+			continue
+		}
+
+		order := new(types.Order)
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), order)
+
+		if current.OrderCount == 0 {
+			current.Price = order.Price()
+		}
+
+		current.SourceRemaining = current.SourceRemaining.Add(order.VisibleRemaining())
+		current.OrderCount++
+	}
+
+	res := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		res.Total = total
+	}
+
+	return levels, res, nil
+}
+
+// GetSyntheticOrderBookLevels returns the first depth price levels implied by routing source through an intermediate
+// denomination, i.e. by matching the (src, X) and (X, dst) books against each other. Best price first. Only routes
+// through a single intermediate denomination are considered, and the levels are not paginated.
+func (k Keeper) GetSyntheticOrderBookLevels(ctx sdk.Context, src, dst string, depth uint32) []types.OrderBookLevel {
+	levels := make([]types.OrderBookLevel, 0)
+
+	pageReq := &query.PageRequest{Limit: uint64(depth)}
+	for _, instrument := range k.GetInstruments(ctx) {
+		if instrument.Source != src || instrument.Destination == dst {
+			continue
+		}
+
+		first, _, _ := k.GetOrderBookLevels(ctx, src, instrument.Destination, depth, pageReq)
+		second, _, _ := k.GetOrderBookLevels(ctx, instrument.Destination, dst, depth, pageReq)
+
+		levels = append(levels, combineOrderBookLevels(first, second, instrument.Destination)...)
+	}
+
+	sort.SliceStable(levels, func(i, j int) bool {
+		return levels[i].Price.LT(levels[j].Price)
+	})
+
+	if len(levels) > int(depth) {
+		levels = levels[:depth]
+	}
+
+	return levels
+}
+
+// Match the levels of the first leg (src -> X) against those of the second leg (X -> dst). Each combination yields a
+// level priced at the product of both prices, holding the source amount that both legs can absorb.
+func combineOrderBookLevels(first, second []types.OrderBookLevel, intermediate string) (res []types.OrderBookLevel) {
+	if len(first) == 0 || len(second) == 0 {
+		return
+	}
+
+	var (
+		i, j = 0, 0
+		// Remaining source of the first leg and remaining intermediate of the second leg
+		rem1 = first[0].SourceRemaining.ToDec()
+		rem2 = second[0].SourceRemaining.ToDec()
+	)
+
+	for i < len(first) && j < len(second) {
+		p1, p2 := first[i].Price, second[j].Price
+
+		var amount sdk.Dec
+		if capacity := rem2.Quo(p1); rem1.LTE(capacity) {
+			amount = rem1
+			rem1 = sdk.ZeroDec()
+			rem2 = rem2.Sub(amount.Mul(p1))
+		} else {
+			amount = capacity
+			rem1 = rem1.Sub(amount)
+			rem2 = sdk.ZeroDec()
+		}
+
+		if source := amount.TruncateInt(); source.IsPositive() {
+			res = append(res, types.OrderBookLevel{
+				Price:           p1.Mul(p2),
+				SourceRemaining: source,
+				Intermediate:    intermediate,
+			})
+		}
+
+		if !rem1.IsPositive() {
+			if i++; i < len(first) {
+				rem1 = first[i].SourceRemaining.ToDec()
+			}
+		}
+
+		if !rem2.IsPositive() {
+			if j++; j < len(second) {
+				rem2 = second[j].SourceRemaining.ToDec()
+			}
+		}
+	}
+
+	return
+}
@@ -0,0 +1,67 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// SetOrderLimits replaces the open order limits on behalf of the authority.
+func (k *Keeper) SetOrderLimits(ctx sdk.Context, authority sdk.AccAddress, maxOpenOrders, maxInstrumentOpenOrders uint32, accountLimits []types.AccountOrderLimits) error {
+	if err := k.authority.ValidateAuthority(ctx, authority); err != nil {
+		return err
+	}
+
+	params := k.GetParams(ctx)
+	params.MaxOpenOrders, params.MaxInstrumentOpenOrders = maxOpenOrders, maxInstrumentOpenOrders
+	params.AccountOrderLimits = accountLimits
+
+	if err := params.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidOrderLimits, err.Error())
+	}
+
+	k.SetParams(ctx, params)
+	return nil
+}
+
+// Reject a new open order of owner in the src/dst instrument if the owner already has as many open orders as allowed,
+// in total or in the instrument. Both the orders resting on the book and the parked stop orders are open orders.
+func (k *Keeper) validateOrderLimits(ctx sdk.Context, owner sdk.AccAddress, src, dst string, accountOrders []*types.Order) error {
+	maxOpenOrders, maxInstrumentOpenOrders := k.GetParams(ctx).OrderLimits(owner.String())
+	if maxOpenOrders == 0 && maxInstrumentOpenOrders == 0 {
+		return nil
+	}
+
+	stopOrders := k.GetStopOrdersByOwner(ctx, owner)
+
+	openOrders := len(accountOrders) + len(stopOrders)
+	if maxOpenOrders > 0 && openOrders >= int(maxOpenOrders) {
+		return sdkerrors.Wrapf(types.ErrTooManyOpenOrders, "%v has %v open orders", owner, openOrders)
+	}
+
+	if maxInstrumentOpenOrders == 0 {
+		return nil
+	}
+
+	instrumentOrders := 0
+	for _, o := range accountOrders {
+		if o.Source.Denom == src && o.Destination.Denom == dst {
+			instrumentOrders++
+		}
+	}
+	for _, so := range stopOrders {
+		if so.Source.Denom == src && so.Destination.Denom == dst {
+			instrumentOrders++
+		}
+	}
+
+	if instrumentOrders >= int(maxInstrumentOpenOrders) {
+		return sdkerrors.Wrapf(types.ErrTooManyOpenOrders, "%v has %v open orders in %v/%v", owner, instrumentOrders, src, dst)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"testing"
+
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenOrderLimits(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	require.Error(t, k.SetOrderLimits(ctx, randomAddress(), 3, 2, nil))
+	invalid := []types.AccountOrderLimits{{Account: "invalid", MaxOpenOrders: 10}}
+	require.ErrorIs(t, k.SetOrderLimits(ctx, testAuthority, 3, 2, invalid), types.ErrInvalidOrderLimits)
+	require.NoError(t, k.SetOrderLimits(ctx, testAuthority, 3, 2, nil))
+
+	first := order(ctx.BlockTime(), acc, "100eur", "120usd")
+	require.NoError(t, k.NewOrderSingle(ctx, first))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc, "100eur", "130usd")))
+
+	err := k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc, "100eur", "140usd"))
+	require.ErrorIs(t, err, types.ErrTooManyOpenOrders)
+
+	// Immediate orders never rest on the book
+	ioc, err := types.NewOrder(ctx.BlockTime(), types.TimeInForce_ImmediateOrCancel, coin("100eur"), coin("140usd"), acc.GetAddress(), cid())
+	require.NoError(t, err)
+	require.NoError(t, k.NewOrderSingle(ctx, ioc))
+
+	// The instrument limit applies to each book separately, the account limit to all of them
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc, "100eur", "110chf")))
+	err = k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc, "100eur", "120chf"))
+	require.ErrorIs(t, err, types.ErrTooManyOpenOrders)
+
+	// A replacement takes the place of the original order
+	_, err = k.CancelReplaceLimitOrder(ctx, order(ctx.BlockTime(), acc, "100eur", "125usd"), first.ClientOrderID)
+	require.NoError(t, err)
+	require.Len(t, k.GetAllOrders(ctx), 3)
+
+	// Market makers can be granted higher limits
+	limits := []types.AccountOrderLimits{{Account: acc.GetAddress().String(), MaxOpenOrders: 0, MaxInstrumentOpenOrders: 4}}
+	require.NoError(t, k.SetOrderLimits(ctx, testAuthority, 3, 2, limits))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc, "100eur", "140usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc, "100eur", "150usd")))
+	err = k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc, "100eur", "160usd"))
+	require.ErrorIs(t, err, types.ErrTooManyOpenOrders)
+	require.Len(t, k.GetAllOrders(ctx), 5)
+
+	params := k.GetParams(ctx)
+	require.Equal(t, uint32(3), params.MaxOpenOrders)
+	require.Equal(t, uint32(2), params.MaxInstrumentOpenOrders)
+	require.Equal(t, limits, params.AccountOrderLimits)
+}
+
+func TestOpenOrderLimitsBatchAuction(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	require.NoError(t, k.SetOrderLimits(ctx, testAuthority, 10, 2, nil))
+	require.NoError(t, k.SetBatchAuction(ctx, testAuthority, types.NewBatchAuction("eur", "usd"), true))
+
+	// Immediate orders of an auction instrument rest until the end of the block
+	for i := 0; i < 2; i++ {
+		ioc, err := types.NewOrder(ctx.BlockTime(), types.TimeInForce_ImmediateOrCancel, coin("100eur"), coin("120usd"), acc.GetAddress(), cid())
+		require.NoError(t, err)
+		require.NoError(t, k.NewOrderSingle(ctx, ioc))
+	}
+
+	ioc, err := types.NewOrder(ctx.BlockTime(), types.TimeInForce_ImmediateOrCancel, coin("100eur"), coin("120usd"), acc.GetAddress(), cid())
+	require.NoError(t, err)
+	require.ErrorIs(t, k.NewOrderSingle(ctx, ioc), types.ErrTooManyOpenOrders)
+	require.Len(t, k.GetAllOrders(ctx), 2)
+
+	// They are no longer open once the auction has been cleared
+	EndBlocker(ctx, k)
+	require.Empty(t, k.GetAllOrders(ctx))
+	require.NoError(t, k.NewOrderSingle(ctx, ioc))
+}
+
+func TestOpenOrderLimitsStopOrders(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	require.NoError(t, k.SetOrderLimits(ctx, testAuthority, 3, 2, nil))
+
+	// Parked stop orders count toward the limits of their instrument
+	require.NoError(t, k.AddStopOrder(ctx, stopOrder(ctx, acc, types.StopOrderType_Limit, "100eur", "100usd", "1.1", "0")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc, "100eur", "120usd")))
+
+	err := k.AddStopOrder(ctx, stopOrder(ctx, acc, types.StopOrderType_Limit, "100eur", "100usd", "1.2", "0"))
+	require.ErrorIs(t, err, types.ErrTooManyOpenOrders)
+	err = k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc, "100eur", "130usd"))
+	require.ErrorIs(t, err, types.ErrTooManyOpenOrders)
+
+	// and toward the limit of the account
+	require.NoError(t, k.AddStopOrder(ctx, stopOrder(ctx, acc, types.StopOrderType_Limit, "100eur", "100chf", "1.1", "0")))
+	err = k.AddStopOrder(ctx, stopOrder(ctx, acc, types.StopOrderType_Limit, "100eur", "100gbp", "1.1", "0"))
+	require.ErrorIs(t, err, types.ErrTooManyOpenOrders)
+	require.Len(t, k.GetStopOrdersByOwner(ctx, acc.GetAddress()), 2)
+}
@@ -0,0 +1,43 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestGetParamsMissingKeys(t *testing.T) {
+	encConfig := MakeTestEncodingConfig()
+	keyParams, tkeyParams := sdk.NewKVStoreKey("params"), sdk.NewTransientStoreKey("transient_params")
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, tmproto.Header{ChainID: "test-chain"}, true, log.NewNopLogger())
+	pk := paramskeeper.NewKeeper(encConfig.Marshaler, encConfig.Amino, keyParams, tkeyParams)
+	k := Keeper{paramSpace: pk.Subspace(types.ModuleName).WithKeyTable(types.ParamKeyTable())}
+
+	// A chain upgraded in place has no market parameters yet
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	// Parameters added by a later version are missing next to the stored ones
+	k.paramSpace.Set(ctx, types.KeyMaxHops, uint32(2))
+	params := k.GetParams(ctx)
+	require.Equal(t, uint32(2), params.MaxHops)
+	require.Equal(t, types.DefaultCandleRetention, params.CandleRetention)
+	require.Equal(t, types.DefaultMaxOpenOrders, params.MaxOpenOrders)
+}
@@ -0,0 +1,157 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// SetPriceBand replaces the price band of an instrument on behalf of the authority. A band without a maximum deviation
+// is removed.
+func (k *Keeper) SetPriceBand(ctx sdk.Context, authority sdk.AccAddress, band types.PriceBand) error {
+	if err := k.authority.ValidateAuthority(ctx, authority); err != nil {
+		return err
+	}
+
+	if err := band.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidPriceBand, err.Error())
+	}
+
+	k.setPriceBand(ctx, band)
+	return nil
+}
+
+// GetPriceBand returns the price band of the instrument in either direction, or nil if its trades are not constrained.
+func (k *Keeper) GetPriceBand(ctx sdk.Context, src, dst string) *types.PriceBand {
+	bz := ctx.KVStore(k.key).Get(types.GetPriceBandKey(src, dst))
+	if bz == nil {
+		return nil
+	}
+
+	band := new(types.PriceBand)
+	k.cdc.MustUnmarshalBinaryBare(bz, band)
+	return band
+}
+
+func (k *Keeper) GetAllPriceBands(ctx sdk.Context) []types.PriceBand {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.GetPriceBandPrefix())
+	defer it.Close()
+
+	res := make([]types.PriceBand, 0)
+	for ; it.Valid(); it.Next() {
+		var band types.PriceBand
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &band)
+		res = append(res, band)
+	}
+
+	return res
+}
+
+func (k *Keeper) setPriceBand(ctx sdk.Context, band types.PriceBand) {
+	store := ctx.KVStore(k.key)
+
+	// Breaches counted under the previous band do not carry over.
+	store.Delete(types.GetPriceBandBreachesKey(band.Source, band.Destination))
+
+	key := types.GetPriceBandKey(band.Source, band.Destination)
+	if band.IsEmpty() {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, k.cdc.MustMarshalBinaryBare(&band))
+}
+
+// The price band of an instrument together with the price it is measured against.
+type priceBandReference struct {
+	band  *types.PriceBand
+	price sdk.Dec
+}
+
+// priceBandReferences holds the bands of the instruments an aggressive order trades in. The last price of an instrument
+// is captured before the order's first trade in it, so the order cannot walk the reference price along with the book.
+type priceBandReferences map[string]priceBandReference
+
+// Returns the band breached by any of the passive orders of the plan, or nil if all of them trade within their bands.
+func (k *Keeper) breachedPriceBand(ctx sdk.Context, refs priceBandReferences, plan types.ExecutionPlan) *types.PriceBand {
+	for _, o := range plan.Orders {
+		src, dst := o.Source.Denom, o.Destination.Denom
+
+		key := string(types.GetPriceBandKey(src, dst))
+		ref, found := refs[key]
+		if !found {
+			ref = priceBandReference{band: k.GetPriceBand(ctx, src, dst), price: sdk.ZeroDec()}
+			if ref.band != nil {
+				ref.price = k.priceBandReferencePrice(ctx, *ref.band)
+			}
+			refs[key] = ref
+		}
+
+		if ref.band != nil && ref.band.Breached(src, o.Price(), ref.price) {
+			return ref.band
+		}
+	}
+
+	return nil
+}
+
+// Returns the price the band is measured against: its fixed reference price if it has one, the last price of the
+// instrument otherwise. Zero if the instrument has not traded yet.
+func (k *Keeper) priceBandReferencePrice(ctx sdk.Context, band types.PriceBand) sdk.Dec {
+	if band.ReferencePrice.IsPositive() {
+		return band.ReferencePrice
+	}
+
+	if md := k.GetInstrument(ctx, band.Source, band.Destination); md != nil && md.LastPrice != nil {
+		return *md.LastPrice
+	}
+
+	return sdk.ZeroDec()
+}
+
+// Count a breach of the band within its breach window. Once the band has been breached too often, the instrument is
+// halted for the band's halt duration.
+func (k *Keeper) recordPriceBandBreach(ctx sdk.Context, band types.PriceBand) {
+	if band.MaxBreaches == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.key)
+	key := types.GetPriceBandBreachesKey(band.Source, band.Destination)
+	now := ctx.BlockTime()
+
+	var breaches types.PriceBandBreaches
+	if bz := store.Get(key); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &breaches)
+	}
+
+	if breaches.Count == 0 || !now.Before(breaches.WindowStart.Add(band.BreachWindow)) {
+		breaches = types.PriceBandBreaches{WindowStart: now}
+	}
+	breaches.Count++
+
+	if breaches.Count < band.MaxBreaches {
+		store.Set(key, k.cdc.MustMarshalBinaryBare(&breaches))
+		return
+	}
+
+	store.Delete(key)
+
+	halt := types.NewInstrumentHalt(band.Source, band.Destination)
+	expireTime := now.Add(band.HaltDuration)
+	halt.ExpireTime = &expireTime
+
+	// Do not shorten a halt that is already in place.
+	if existing := k.getTradingHalt(ctx, halt.Key()); existing != nil && existing.IsActive(now) {
+		if existing.ExpireTime == nil || !existing.ExpireTime.Before(expireTime) {
+			return
+		}
+	}
+
+	k.setTradingHalt(ctx, halt)
+	types.EmitPriceBandHaltEvent(ctx, halt)
+}
@@ -0,0 +1,134 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceBandStopsMatching(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	// Establish a last price of 1.2 usd per eur
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "120usd", "100eur")))
+
+	band := types.NewPriceBand("eur", "usd", sdk.NewDecWithPrec(1, 1), sdk.ZeroDec(), 0, 0, 0)
+	require.NoError(t, k.SetPriceBand(ctx, testAuthority, band))
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "1000eur", "1250usd")))
+	outside := order(ctx.BlockTime(), acc1, "1000eur", "1330usd")
+	require.NoError(t, k.NewOrderSingle(ctx, outside))
+
+	// 1.33 is within 10% of the last price after the first fill, but not of the last price before the order arrived
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	aggressive := order(ctx.BlockTime(), acc2, "3000usd", "2000eur")
+	require.NoError(t, k.NewOrderSingle(ctx, aggressive))
+	require.True(t, findEventAttr(ctx, "expire"))
+
+	require.Equal(t, "1100eur,8630usd", bk.GetAllBalances(ctx, acc2.GetAddress()).String())
+	require.Nil(t, k.GetOrderByOwnerAndClientOrderId(ctx, acc2.GetAddress().String(), aggressive.ClientOrderID))
+	require.NotNil(t, k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), outside.ClientOrderID))
+
+	// The band applies to both directions of the instrument. The last price is now 1.25 usd per eur.
+	bid := order(ctx.BlockTime(), acc2, "1000usd", "1000eur")
+	require.NoError(t, k.NewOrderSingle(ctx, bid))
+	ask := order(ctx.BlockTime(), acc1, "500eur", "400usd")
+	require.NoError(t, k.NewOrderSingle(ctx, ask))
+	require.Nil(t, k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), ask.ClientOrderID))
+	require.Len(t, k.GetAllOrders(ctx), 2)
+
+	msg, broken := AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+
+	// Without a band the order trades through the book
+	require.NoError(t, k.SetPriceBand(ctx, testAuthority, types.NewPriceBand("usd", "eur", sdk.ZeroDec(), sdk.ZeroDec(), 0, 0, 0)))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "500eur", "400usd")))
+	require.Equal(t, "600", k.GetOrderByOwnerAndClientOrderId(ctx, acc2.GetAddress().String(), bid.ClientOrderID).SourceRemaining.String())
+}
+
+func TestPriceBandHalt(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	band := types.NewPriceBand("eur", "usd", sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(12, 1), 2, time.Minute, time.Hour)
+	require.NoError(t, k.SetPriceBand(ctx, testAuthority, band))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "1000eur", "1500usd")))
+
+	buy := func(ctx sdk.Context, tif types.TimeInForce) {
+		o, err := types.NewOrder(ctx.BlockTime(), tif, coin("1500usd"), coin("1000eur"), acc2.GetAddress(), cid())
+		require.NoError(t, err)
+		require.NoError(t, k.NewOrderSingle(ctx, o))
+	}
+
+	// A killed order still counts as a breach
+	start := ctx.BlockTime()
+	buy(ctx, types.TimeInForce_FillOrKill)
+	require.Empty(t, k.GetAllTradingHalts(ctx))
+
+	// Breaches outside the window start a new one
+	ctx = ctx.WithBlockTime(start.Add(2 * time.Minute))
+	buy(ctx, types.TimeInForce_ImmediateOrCancel)
+	require.Empty(t, k.GetAllTradingHalts(ctx))
+
+	ctx = ctx.WithBlockTime(start.Add(150 * time.Second)).WithEventManager(sdk.NewEventManager())
+	buy(ctx, types.TimeInForce_ImmediateOrCancel)
+	require.True(t, findEventAttr(ctx, "price_band_halt"))
+	require.Equal(t, "10000usd", bk.GetAllBalances(ctx, acc2.GetAddress()).String())
+
+	halts := k.GetAllTradingHalts(ctx)
+	require.Len(t, halts, 1)
+	require.True(t, halts[0].Matches("usd", "eur"))
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), *halts[0].ExpireTime)
+
+	err := k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "1500usd", "1000eur"))
+	require.ErrorIs(t, err, types.ErrTradingHalted)
+
+	// The halt lifts at its expiry, even before it is removed
+	ctx = ctx.WithBlockTime(*halts[0].ExpireTime)
+	require.False(t, k.IsTradingHalted(ctx, "eur", "usd"))
+	BeginBlocker(ctx, k)
+	require.Empty(t, k.GetAllTradingHalts(ctx))
+
+	// A halt of the authority is not replaced by a temporary one
+	require.NoError(t, k.HaltTrading(ctx, testAuthority, types.NewInstrumentHalt("eur", "usd"), false))
+	k.recordPriceBandBreach(ctx, band)
+	k.recordPriceBandBreach(ctx, band)
+	BeginBlocker(ctx.WithBlockTime(ctx.BlockTime().Add(2*time.Hour)), k)
+	require.True(t, k.IsTradingHalted(ctx.WithBlockTime(ctx.BlockTime().Add(2*time.Hour)), "eur", "usd"))
+}
+
+func TestSetPriceBandAuthorization(t *testing.T) {
+	ctx, k, _, _ := createTestComponents(t)
+
+	band := types.NewPriceBand("eur", "usd", sdk.NewDecWithPrec(5, 2), sdk.ZeroDec(), 3, time.Minute, time.Hour)
+	require.Error(t, k.SetPriceBand(ctx, randomAddress(), band))
+	require.Nil(t, k.GetPriceBand(ctx, "eur", "usd"))
+
+	invalid := types.NewPriceBand("eur", "usd", sdk.NewDecWithPrec(5, 2), sdk.ZeroDec(), 3, 0, time.Hour)
+	require.ErrorIs(t, k.SetPriceBand(ctx, testAuthority, invalid), types.ErrInvalidPriceBand)
+
+	require.NoError(t, k.SetPriceBand(ctx, testAuthority, band))
+	require.Equal(t, &band, k.GetPriceBand(ctx, "eur", "usd"))
+	require.Equal(t, &band, k.GetPriceBand(ctx, "usd", "eur"))
+
+	res, err := k.PriceBands(sdk.WrapSDKContext(ctx), &types.QueryPriceBandsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.PriceBand{band}, res.Bands)
+
+	// A band without a maximum deviation removes the entry
+	empty := types.NewPriceBand("usd", "eur", sdk.ZeroDec(), sdk.ZeroDec(), 0, 0, 0)
+	require.NoError(t, k.SetPriceBand(ctx, testAuthority, empty))
+	require.Nil(t, k.GetPriceBand(ctx, "eur", "usd"))
+	require.Empty(t, k.GetAllPriceBands(ctx))
+}
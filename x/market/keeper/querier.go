@@ -101,7 +101,7 @@ func queryInstrument(ctx sdk.Context, k *Keeper, path []string, req abci.Request
 		orders = append(orders, types.QueryOrderResponse{
 			ID:              order.ID,
 			Owner:           order.Owner,
-			SourceRemaining: order.SourceRemaining.String(),
+			SourceRemaining: order.VisibleRemaining().String(),
 			Price:           order.Price(),
 			Created:         order.Created,
 		})
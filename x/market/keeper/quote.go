@@ -0,0 +1,107 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// The account that takes the place of the aggressive order in a quote. It holds no balance outside the simulation.
+var quoteAccount = authtypes.NewModuleAddress(types.ModuleName + "-quote")
+
+// GetQuote simulates an immediate-or-cancel order that sells at most source to buy dst. The quote only depends on the
+// book, fees, price bands and instrument rules: no account takes part and no balance is checked, so it can be requested
+// before an account is funded. The order is matched like any other order on a cached context that is discarded
+// afterwards, so no state is changed.
+func (k *Keeper) GetQuote(ctx sdk.Context, source, dst sdk.Coin) (*types.QueryQuoteResponse, error) {
+	if source.Denom == dst.Denom {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInstrument, "'%v/%v' is not a valid instrument", source.Denom, dst.Denom)
+	}
+
+	if k.IsTradingHalted(ctx, source.Denom, dst.Denom) {
+		return nil, sdkerrors.Wrapf(types.ErrTradingHalted, "%v/%v", source.Denom, dst.Denom)
+	}
+
+	source = k.roundToLotSize(ctx, source, dst.Denom)
+	if !source.IsPositive() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidLotSize, "Source %v is smaller than the lot size", source)
+	}
+
+	res := &types.QueryQuoteResponse{
+		SourceFilled:      sdk.NewCoin(source.Denom, sdk.ZeroInt()),
+		DestinationFilled: sdk.NewCoin(dst.Denom, sdk.ZeroInt()),
+		Fee:               sdk.NewCoin(dst.Denom, sdk.ZeroInt()),
+		AveragePrice:      sdk.ZeroDec(),
+		Route:             make([]types.QuoteLeg, 0),
+		Fills:             make([]types.Trade, 0),
+	}
+
+	// Orders of an auction instrument only trade at the end of the block.
+	if !k.IsBatchAuction(ctx, source.Denom, dst.Denom) {
+		order := types.Order{
+			TimeInForce:       types.TimeInForce_ImmediateOrCancel,
+			Source:            source,
+			SourceRemaining:   source.Amount,
+			SourceFilled:      sdk.ZeroInt(),
+			Destination:       dst,
+			DestinationFilled: sdk.ZeroInt(),
+		}
+
+		cacheCtx, _ := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+		matched := k.matchOrder(cacheCtx, cacheCtx, &order, k.settleQuote)
+
+		res.Fills = matched.fills
+		res.Fee = sdk.NewCoin(dst.Denom, matched.fee)
+		res.SourceFilled = sdk.NewCoin(source.Denom, order.SourceFilled)
+		res.DestinationFilled = sdk.NewCoin(dst.Denom, order.DestinationFilled)
+	}
+
+	for _, trade := range res.Fills {
+		// The maker receives what the simulated order sells into the leg and sells what it buys from the leg.
+		res.Route = addQuoteLeg(res.Route, trade.Destination, trade.Source)
+	}
+
+	res.DestinationRemaining = dst.Sub(res.DestinationFilled)
+	if res.SourceFilled.IsPositive() {
+		res.AveragePrice = res.DestinationFilled.Amount.ToDec().Quo(res.SourceFilled.Amount.ToDec())
+	}
+
+	for i, leg := range res.Route {
+		res.Route[i].Price = leg.Destination.Amount.ToDec().Quo(leg.Source.Amount.ToDec())
+	}
+
+	return res, nil
+}
+
+// Settle a simulated fill. The passive account pays the quote account, so the other orders of its owner are adjusted as
+// they would be. The quote account pays the passive account and the fees from what it bought on earlier legs of the
+// route. It cannot pay the quoted source, which is not part of the simulation.
+func (k Keeper) settleQuote(ctx sdk.Context, sourceFilled, destinationFilled sdk.Coin, passiveAccountAddr, _ string, passiveFee, aggressiveFee sdk.Int) error {
+	if k.bk.SpendableCoins(ctx, quoteAccount).IsAllGTE(sdk.NewCoins(sourceFilled)) {
+		return k.transferTradedAmounts(ctx, sourceFilled, destinationFilled, passiveAccountAddr, quoteAccount.String(), passiveFee, aggressiveFee)
+	}
+
+	inputs := []banktypes.Input{{Address: passiveAccountAddr, Coins: sdk.NewCoins(destinationFilled)}}
+	outputs := []banktypes.Output{{Address: quoteAccount.String(), Coins: sdk.NewCoins(destinationFilled)}}
+	return k.bk.InputOutputCoins(ctx, inputs, outputs)
+}
+
+// Add a fill to the leg of its instrument, appending a new leg if the route does not pass through the instrument yet.
+func addQuoteLeg(route []types.QuoteLeg, source, destination sdk.Coin) []types.QuoteLeg {
+	for i, leg := range route {
+		if leg.Source.Denom == source.Denom && leg.Destination.Denom == destination.Denom {
+			route[i].Source = leg.Source.Add(source)
+			route[i].Destination = leg.Destination.Add(destination)
+			return route
+		}
+	}
+
+	return append(route, types.QuoteLeg{Source: source, Destination: destination})
+}
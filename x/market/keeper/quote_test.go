@@ -0,0 +1,219 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuoteDirect(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	require.NoError(t, k.SetFees(ctx, testAuthority, 10, 20, nil))
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "5000eur", "6000usd")))
+	totalSupply := snapshotAccounts(ctx, bk)
+
+	res, err := k.GetQuote(ctx, coin("10000usd"), coin("2500eur"))
+	require.NoError(t, err)
+	require.Equal(t, "3000usd", res.SourceFilled.String())
+	require.Equal(t, "2500eur", res.DestinationFilled.String())
+	require.Equal(t, "0eur", res.DestinationRemaining.String())
+	require.Equal(t, "5eur", res.Fee.String())
+	require.Equal(t, "0.833333333333333333", res.AveragePrice.String())
+	require.Len(t, res.Fills, 1)
+	require.Equal(t, []types.QuoteLeg{
+		{Source: coin("3000usd"), Destination: coin("2500eur"), Price: sdk.MustNewDecFromStr("0.833333333333333333")},
+	}, res.Route)
+
+	// The simulation leaves the book, the balances and the trade log untouched
+	require.Equal(t, totalSupply, snapshotAccounts(ctx, bk))
+	require.Equal(t, "10000usd", bk.GetAllBalances(ctx, acc2.GetAddress()).String())
+	require.Len(t, k.GetAllOrders(ctx), 1)
+	require.Equal(t, "5000", k.GetAllOrders(ctx)[0].SourceRemaining.String())
+	require.Empty(t, k.GetAllTrades(ctx))
+}
+
+func TestQuoteThinBook(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "5000eur", "6000usd")))
+
+	res, err := k.GetQuote(ctx, coin("10000usd"), coin("8000eur"))
+	require.NoError(t, err)
+	require.Equal(t, "6000usd", res.SourceFilled.String())
+	require.Equal(t, "5000eur", res.DestinationFilled.String())
+	require.Equal(t, "3000eur", res.DestinationRemaining.String())
+	require.Equal(t, "0eur", res.Fee.String())
+	require.Equal(t, "0.833333333333333333", res.AveragePrice.String())
+
+	// An empty book quotes nothing
+	res, err = k.GetQuote(ctx, coin("10000usd"), coin("100chf"))
+	require.NoError(t, err)
+	require.Equal(t, "0usd", res.SourceFilled.String())
+	require.Equal(t, "100chf", res.DestinationRemaining.String())
+	require.True(t, res.AveragePrice.IsZero())
+	require.Empty(t, res.Route)
+	require.Empty(t, res.Fills)
+}
+
+func TestQuoteSynthetic(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "500eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "1000chf")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "500eur", "1000chf")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "1000chf", "1000usd")))
+
+	res, err := k.GetQuote(ctx, coin("5000usd"), coin("500eur"))
+	require.NoError(t, err)
+	require.Equal(t, "1000usd", res.SourceFilled.String())
+	require.Equal(t, "500eur", res.DestinationFilled.String())
+	require.Equal(t, "0eur", res.DestinationRemaining.String())
+	require.Equal(t, "0.500000000000000000", res.AveragePrice.String())
+	require.Len(t, res.Fills, 2)
+
+	require.ElementsMatch(t, []types.QuoteLeg{
+		{Source: coin("1000usd"), Destination: coin("1000chf"), Price: sdk.OneDec()},
+		{Source: coin("1000chf"), Destination: coin("500eur"), Price: sdk.NewDecWithPrec(5, 1)},
+	}, res.Route)
+
+	require.Len(t, k.GetAllOrders(ctx), 2)
+	require.Equal(t, "5000usd", bk.GetAllBalances(ctx, acc3.GetAddress()).String())
+}
+
+func TestQuoteMatchesExecution(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	require.NoError(t, k.SetFees(ctx, testAuthority, 10, 20, nil))
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "1000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "500eur")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "1000chf")
+	acc4 := createAccount(ctx, ak, bk, randomAddress(), "600eur")
+	acc5 := createAccount(ctx, ak, bk, randomAddress(), "2000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, icebergOrder(ctx, acc1, "1000eur", "1200usd", 100)))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "500eur", "650usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "1000chf", "1000usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc4, "600eur", "660chf")))
+
+	quote, err := k.GetQuote(ctx, coin("2000usd"), coin("1500eur"))
+	require.NoError(t, err)
+
+	ioc, err := types.NewOrder(ctx.BlockTime(), types.TimeInForce_ImmediateOrCancel, coin("2000usd"), coin("1500eur"), acc5.GetAddress(), cid())
+	require.NoError(t, err)
+	res, err := k.PlaceOrder(ctx, ioc)
+	require.NoError(t, err)
+
+	// The quote walks through the synthetic route and refills the iceberg order like the executed order
+	require.Equal(t, res.SourceFilled, quote.SourceFilled)
+	require.Equal(t, res.DestinationFilled, quote.DestinationFilled)
+	require.Equal(t, res.Fee, quote.Fee)
+	require.Len(t, quote.Fills, len(res.Fills))
+	for i, fill := range res.Fills {
+		require.Equal(t, fill.MakerOrderID, quote.Fills[i].MakerOrderID)
+		require.Equal(t, fill.Source, quote.Fills[i].Source)
+		require.Equal(t, fill.Destination, quote.Fills[i].Destination)
+	}
+	require.Len(t, quote.Route, 3)
+}
+
+func TestQuoteLimits(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "1000eur", "1200usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "1000eur", "1500usd")))
+
+	// The quote walks through the book until the destination is filled
+	res, err := k.GetQuote(ctx, coin("3000usd"), coin("1500eur"))
+	require.NoError(t, err)
+	require.Equal(t, "1950usd", res.SourceFilled.String())
+	require.Equal(t, "1500eur", res.DestinationFilled.String())
+	require.Len(t, res.Fills, 2)
+	require.Equal(t, k.GetOrdersByOwner(ctx, acc1.GetAddress())[0].ID, res.Fills[0].MakerOrderID)
+	require.Equal(t, k.GetOrdersByOwner(ctx, acc2.GetAddress())[0].ID, res.Fills[1].MakerOrderID)
+
+	// Orders priced beyond the limit are not used
+	res, err = k.GetQuote(ctx, coin("2400usd"), coin("2000eur"))
+	require.NoError(t, err)
+	require.Equal(t, "1000eur", res.DestinationFilled.String())
+	require.Equal(t, "1000eur", res.DestinationRemaining.String())
+
+	// Resting orders are left as they were
+	require.Equal(t, "1000", k.GetOrdersByOwner(ctx, acc1.GetAddress())[0].SourceRemaining.String())
+	require.Equal(t, "1000", k.GetOrdersByOwner(ctx, acc2.GetAddress())[0].SourceRemaining.String())
+
+	rules := types.NewInstrumentRules("usd", "eur", sdk.ZeroDec(), sdk.ZeroInt(), sdk.NewInt(100))
+	require.NoError(t, k.SetInstrumentRules(ctx, testAuthority, rules))
+	_, err = k.GetQuote(ctx, coin("50usd"), coin("1500eur"))
+	require.ErrorIs(t, err, types.ErrInvalidLotSize)
+
+	require.NoError(t, k.HaltTrading(ctx, testAuthority, types.TradingHalt{Denom: "eur"}, false))
+	_, err = k.GetQuote(ctx, coin("600usd"), coin("500eur"))
+	require.ErrorIs(t, err, types.ErrTradingHalted)
+}
+
+func TestQueryQuote(t *testing.T) {
+	enc := MakeTestEncodingConfig()
+	ctx, k, ak, bk := createTestComponentsWithEncoding(t, enc)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "5000eur", "6000usd")))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, enc.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, k)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	specs := map[string]struct {
+		req    *types.QueryQuoteRequest
+		expErr bool
+	}{
+		"all good": {
+			req: &types.QueryQuoteRequest{Source: coin("1500usd"), Destination: coin("1000eur")},
+		},
+		"invalid source": {
+			req:    &types.QueryQuoteRequest{Source: sdk.Coin{Denom: "1", Amount: sdk.NewInt(1500)}, Destination: coin("1000eur")},
+			expErr: true,
+		},
+		"zero source": {
+			req:    &types.QueryQuoteRequest{Source: coin("0usd"), Destination: coin("1000eur")},
+			expErr: true,
+		},
+		"zero destination": {
+			req:    &types.QueryQuoteRequest{Source: coin("1500usd"), Destination: coin("0eur")},
+			expErr: true,
+		},
+		"same denomination": {
+			req:    &types.QueryQuoteRequest{Source: coin("1500eur"), Destination: coin("1000eur")},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			res, err := queryClient.Quote(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "1200usd", res.SourceFilled.String())
+			require.Equal(t, "1000eur", res.DestinationFilled.String())
+		})
+	}
+}
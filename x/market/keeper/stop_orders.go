@@ -0,0 +1,231 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// AddStopOrder parks a stop order in the trigger index until the last traded price of its instrument reaches the stop price.
+// The owner's balance is not verified until the stop order is triggered and sent to the market.
+func (k *Keeper) AddStopOrder(ctx sdk.Context, stopOrder types.StopOrder) error {
+	// Use a fixed gas amount
+	ctx.GasMeter().ConsumeGas(gasPriceNewOrder, "AddStopOrder")
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	if err := stopOrder.IsValid(); err != nil {
+		return err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(stopOrder.Owner)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner")
+	}
+
+	// Verify uniqueness of client order id among active and stop orders
+	if k.GetOrderByOwnerAndClientOrderId(ctx, stopOrder.Owner, stopOrder.ClientOrderID) != nil ||
+		k.GetStopOrderByOwnerAndClientOrderId(ctx, stopOrder.Owner, stopOrder.ClientOrderID) != nil {
+		return sdkerrors.Wrap(types.ErrNonUniqueClientOrderId, stopOrder.ClientOrderID)
+	}
+
+	if !k.assetExists(ctx, stopOrder.Destination) {
+		return sdkerrors.Wrap(types.ErrUnknownAsset, stopOrder.Destination.Denom)
+	}
+
+	// A parked stop order is an open order of its owner until it is triggered or cancelled.
+	accountOrders := k.GetOrdersByOwner(ctx, owner)
+	if err := k.validateOrderLimits(ctx, owner, stopOrder.Source.Denom, stopOrder.Destination.Denom, accountOrders); err != nil {
+		return err
+	}
+
+	// The order sent by a stop-limit order is known up front and must follow the rules of the instrument.
+	if stopOrder.OrderType == types.StopOrderType_Limit {
+		order := types.Order{TimeInForce: stopOrder.TimeInForce, Source: stopOrder.Source, Destination: stopOrder.Destination}
+		if err := k.validateInstrumentRules(ctx, order); err != nil {
+			return err
+		}
+	}
+
+	md := k.GetInstrument(ctx, stopOrder.Source.Denom, stopOrder.Destination.Denom)
+	if md != nil && md.LastPrice != nil && stopOrder.IsTriggered(*md.LastPrice) {
+		return sdkerrors.Wrapf(
+			types.ErrStopPriceReached, "Last price %v is not above the stop price %v",
+			md.LastPrice, stopOrder.StopPrice,
+		)
+	}
+
+	stopOrder.ID = k.getNextOrderNumber(ctx)
+	k.setStopOrder(ctx, &stopOrder)
+	types.EmitStopAcceptEvent(ctx, stopOrder)
+
+	return nil
+}
+
+func (k *Keeper) GetStopOrderByOwnerAndClientOrderId(ctx sdk.Context, owner, clientOrderId string) *types.StopOrder {
+	store := ctx.KVStore(k.key)
+
+	bz := store.Get(types.GetStopOwnerKey(owner, clientOrderId))
+	if bz == nil {
+		return nil
+	}
+
+	so := &types.StopOrder{}
+	k.cdc.MustUnmarshalBinaryBare(bz, so)
+	return so
+}
+
+func (k Keeper) GetStopOrdersByOwner(ctx sdk.Context, owner sdk.AccAddress) (res []*types.StopOrder) {
+	store := ctx.KVStore(k.key)
+
+	it := sdk.KVStorePrefixIterator(store, types.GetStopOwnerKey(owner.String(), ""))
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		so := &types.StopOrder{}
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), so)
+		res = append(res, so)
+	}
+
+	return
+}
+
+// GetAllStopOrders returns every parked stop order, sorted by owner and client order id.
+func (k Keeper) GetAllStopOrders(ctx sdk.Context) (res []*types.StopOrder) {
+	store := ctx.KVStore(k.key)
+
+	it := sdk.KVStorePrefixIterator(store, types.GetStopOwnersPrefix())
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		so := &types.StopOrder{}
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), so)
+		res = append(res, so)
+	}
+
+	return
+}
+
+func (k *Keeper) cancelStopOrder(ctx sdk.Context, owner sdk.AccAddress, clientOrderId string) error {
+	stopOrder := k.GetStopOrderByOwnerAndClientOrderId(ctx, owner.String(), clientOrderId)
+	if stopOrder == nil {
+		return sdkerrors.Wrap(types.ErrClientOrderIdNotFound, clientOrderId)
+	}
+
+	types.EmitStopExpireEvent(ctx, *stopOrder)
+	k.deleteStopOrder(ctx, stopOrder)
+
+	return nil
+}
+
+func (k Keeper) setStopOrder(ctx sdk.Context, stopOrder *types.StopOrder) {
+	var (
+		store    = ctx.KVStore(k.key)
+		idxStore = ctx.KVStore(k.keyIndices)
+	)
+
+	ownerKey := types.GetStopOwnerKey(stopOrder.Owner, stopOrder.ClientOrderID)
+	store.Set(ownerKey, k.cdc.MustMarshalBinaryBare(stopOrder))
+
+	triggerKey := types.GetStopTriggerKey(stopOrder.Source.Denom, stopOrder.Destination.Denom, stopOrder.StopPrice, stopOrder.ID)
+	idxStore.Set(triggerKey, ownerKey)
+}
+
+func (k Keeper) deleteStopOrder(ctx sdk.Context, stopOrder *types.StopOrder) {
+	var (
+		store    = ctx.KVStore(k.key)
+		idxStore = ctx.KVStore(k.keyIndices)
+	)
+
+	store.Delete(types.GetStopOwnerKey(stopOrder.Owner, stopOrder.ClientOrderID))
+	idxStore.Delete(types.GetStopTriggerKey(stopOrder.Source.Denom, stopOrder.Destination.Denom, stopOrder.StopPrice, stopOrder.ID))
+	idxStore.Delete(types.GetStopTriggeredKey(stopOrder.ID))
+}
+
+// Move the stop orders of an instrument whose stop price has been reached by the last traded price to the triggered queue.
+// They are sent to the market once the order that moved the price has been executed.
+func (k Keeper) triggerStopOrders(ctx sdk.Context, src, dst string, lastPrice sdk.Dec) {
+	idxStore := ctx.KVStore(k.keyIndices)
+
+	start := types.GetStopTriggerKeyByPrice(src, dst, lastPrice)
+	end := sdk.PrefixEndBytes(types.GetStopTriggerKeyByInstrument(src, dst))
+
+	var triggerKeys, ownerKeys [][]byte
+
+	// Collect the triggered stop orders before modifying the store
+	it := idxStore.Iterator(start, end)
+	for ; it.Valid(); it.Next() {
+		triggerKeys = append(triggerKeys, it.Key())
+		ownerKeys = append(ownerKeys, it.Value())
+	}
+	it.Close()
+
+	store := ctx.KVStore(k.key)
+	for i, ownerKey := range ownerKeys {
+		stopOrder := new(types.StopOrder)
+		k.cdc.MustUnmarshalBinaryBare(store.Get(ownerKey), stopOrder)
+
+		idxStore.Delete(triggerKeys[i])
+		idxStore.Set(types.GetStopTriggeredKey(stopOrder.ID), ownerKey)
+		types.EmitTriggerEvent(ctx, *stopOrder, lastPrice)
+	}
+}
+
+// Send up to limit triggered stop orders to the market in the order they were placed. Their trades may trigger further
+// stop orders, which join the queue.
+func (k *Keeper) executeTriggeredStopOrders(ctx sdk.Context, limit int) {
+	for i := 0; i < limit; i++ {
+		stopOrder := k.nextTriggeredStopOrder(ctx)
+		if stopOrder == nil {
+			return
+		}
+
+		k.deleteStopOrder(ctx, stopOrder)
+
+		order, err := k.convertStopOrder(ctx, *stopOrder)
+		if err == nil {
+			_, err = k.executeOrder(ctx, order)
+		}
+
+		if err != nil {
+			ctx.Logger().Info("Triggered stop order was rejected", "stop_order_id", stopOrder.ID, "error", err)
+			types.EmitStopExpireEvent(ctx, *stopOrder)
+		}
+	}
+}
+
+func (k Keeper) nextTriggeredStopOrder(ctx sdk.Context) *types.StopOrder {
+	idxStore := ctx.KVStore(k.keyIndices)
+
+	it := sdk.KVStorePrefixIterator(idxStore, types.GetStopTriggeredPrefix())
+	defer it.Close()
+
+	if !it.Valid() {
+		return nil
+	}
+
+	stopOrder := new(types.StopOrder)
+	k.cdc.MustUnmarshalBinaryBare(ctx.KVStore(k.key).Get(it.Value()), stopOrder)
+	return stopOrder
+}
+
+// Create the limit order that a triggered stop order sends to the market.
+func (k Keeper) convertStopOrder(ctx sdk.Context, stopOrder types.StopOrder) (types.Order, error) {
+	owner, err := sdk.AccAddressFromBech32(stopOrder.Owner)
+	if err != nil {
+		return types.Order{}, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner")
+	}
+
+	source := stopOrder.Source
+	if stopOrder.OrderType == types.StopOrderType_Market {
+		source, err = k.GetSrcFromSlippage(ctx, stopOrder.Source.Denom, stopOrder.Destination, stopOrder.MaxSlippage)
+		if err != nil {
+			return types.Order{}, err
+		}
+	}
+
+	return types.NewOrder(ctx.BlockTime(), stopOrder.TimeInForce, source, stopOrder.Destination, owner, stopOrder.ClientOrderID)
+}
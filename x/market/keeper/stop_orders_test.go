@@ -0,0 +1,182 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStopLimitOrderTriggered(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	so := stopOrder(ctx, acc3, types.StopOrderType_Limit, "100eur", "100usd", "1.1", "0")
+	require.NoError(t, k.AddStopOrder(ctx, so))
+	require.Len(t, k.GetStopOrdersByOwner(ctx, acc3.GetAddress()), 1)
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc3.GetAddress()))
+
+	// Trade eur for usd at 1.2, which is above the stop price
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "60usd", "50eur")))
+	require.Len(t, k.GetStopOrdersByOwner(ctx, acc3.GetAddress()), 1)
+
+	// Trade eur for usd at 1.05
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "105usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "240usd", "150eur")))
+	require.True(t, findEventAttr(ctx, "trigger"))
+
+	require.Empty(t, k.GetStopOrdersByOwner(ctx, acc3.GetAddress()))
+	orders := k.GetOrdersByOwner(ctx, acc3.GetAddress())
+	require.Len(t, orders, 1)
+	require.Equal(t, so.ClientOrderID, orders[0].ClientOrderID)
+	require.Equal(t, "100usd", orders[0].Destination.String())
+
+	// The indices are emptied
+	for _, prefix := range [][]byte{types.GetStopTriggerKeyByInstrument("eur", "usd"), types.GetStopTriggeredPrefix()} {
+		it := sdk.KVStorePrefixIterator(ctx.KVStore(k.keyIndices), prefix)
+		require.False(t, it.Valid())
+		it.Close()
+	}
+}
+
+func TestStopOrderExecutedAgainstBook(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	// Establish a last price of 1.2
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "120usd", "100eur")))
+
+	so := stopOrder(ctx, acc3, types.StopOrderType_Market, "0eur", "90usd", "1.0", "0.1")
+	require.NoError(t, k.AddStopOrder(ctx, so))
+
+	// A resting bid for eur at 0.95 will fill the market order once it is triggered
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "190usd", "200eur")))
+
+	// Trade at 0.95
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "10eur", "9usd")))
+
+	require.Empty(t, k.GetStopOrdersByOwner(ctx, acc3.GetAddress()))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc3.GetAddress()))
+	require.Equal(t, "90", bk.GetBalance(ctx, acc3.GetAddress(), "usd").Amount.String())
+}
+
+func TestStopOrderRejectedWhenTriggered(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "50eur")
+
+	// The account cannot pay for the order once triggered
+	so := stopOrder(ctx, acc3, types.StopOrderType_Limit, "100eur", "100usd", "1.1", "0")
+	require.NoError(t, k.AddStopOrder(ctx, so))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "105usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "105usd", "100eur")))
+
+	require.True(t, findEventAttr(ctx, "trigger"))
+	require.True(t, findEventAttr(ctx, "expire_stop"))
+	require.Empty(t, k.GetStopOrdersByOwner(ctx, acc3.GetAddress()))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc3.GetAddress()))
+}
+
+func TestStopOrderCascadeBounded(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	const count = maxTriggeredStopOrdersPerTx + 2
+	for i := 0; i < count; i++ {
+		acc := createAccount(ctx, ak, bk, randomAddress(), "100eur")
+		require.NoError(t, k.AddStopOrder(ctx, stopOrder(ctx, acc, types.StopOrderType_Limit, "100eur", "100usd", "1.1", "0")))
+	}
+
+	// The trade triggers every stop order, but the transaction only sends a bounded number of them to the market
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "105usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "105usd", "100eur")))
+	require.Len(t, k.GetAllStopOrders(ctx), count-maxTriggeredStopOrdersPerTx)
+	require.Len(t, k.GetAllOrders(ctx), maxTriggeredStopOrdersPerTx)
+
+	// The rest are sent at the end of the block
+	EndBlocker(ctx, k)
+	require.Empty(t, k.GetAllStopOrders(ctx))
+	require.Len(t, k.GetAllOrders(ctx), count)
+}
+
+func TestStopPriceAlreadyReached(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "120usd", "100eur")))
+
+	so := stopOrder(ctx, acc1, types.StopOrderType_Limit, "100eur", "100usd", "1.2", "0")
+	require.True(t, types.ErrStopPriceReached.Is(k.AddStopOrder(ctx, so)))
+
+	so = stopOrder(ctx, acc1, types.StopOrderType_Limit, "100eur", "100usd", "1.19", "0")
+	require.NoError(t, k.AddStopOrder(ctx, so))
+}
+
+func TestStopOrderClientOrderIdUniqueness(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	o := order(ctx.BlockTime(), acc1, "100eur", "120usd")
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+
+	so := stopOrder(ctx, acc1, types.StopOrderType_Limit, "100eur", "100usd", "1.1", "0")
+	so.ClientOrderID = o.ClientOrderID
+	require.True(t, types.ErrNonUniqueClientOrderId.Is(k.AddStopOrder(ctx, so)))
+
+	so.ClientOrderID = cid()
+	require.NoError(t, k.AddStopOrder(ctx, so))
+
+	o = order(ctx.BlockTime(), acc1, "100eur", "130usd")
+	o.ClientOrderID = so.ClientOrderID
+	require.True(t, types.ErrNonUniqueClientOrderId.Is(k.NewOrderSingle(ctx, o)))
+}
+
+func TestCancelStopOrder(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	so := stopOrder(ctx, acc1, types.StopOrderType_Limit, "100eur", "100usd", "1.1", "0")
+	require.NoError(t, k.AddStopOrder(ctx, so))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), so.ClientOrderID))
+	require.True(t, findEventAttr(ctx, "expire_stop"))
+	require.Empty(t, k.GetStopOrdersByOwner(ctx, acc1.GetAddress()))
+
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.keyIndices), types.GetStopTriggerKeyByInstrument("eur", "usd"))
+	require.False(t, it.Valid())
+	it.Close()
+
+	require.True(t, types.ErrClientOrderIdNotFound.Is(k.CancelOrder(ctx, acc1.GetAddress(), so.ClientOrderID)))
+}
+
+func stopOrder(ctx sdk.Context, account authtypes.AccountI, orderType types.StopOrderType, src, dst, stopPrice, maxSlippage string) types.StopOrder {
+	so, err := types.NewStopOrder(
+		ctx.BlockTime(), orderType, types.TimeInForce_GoodTillCancel, coin(src), coin(dst),
+		sdk.MustNewDecFromStr(stopPrice), sdk.MustNewDecFromStr(maxSlippage), account.GetAddress(), cid(),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return so
+}
@@ -0,0 +1,133 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// Append a trade to the trade log, dropping the trades that no longer fit within the retention. Returns the trade with
+// its assigned id.
+func (k Keeper) logTrade(ctx sdk.Context, trade types.Trade) types.Trade {
+	trade.ID = k.getNextTradeNumber(ctx)
+	k.setTrade(ctx, &trade)
+
+	retention := k.GetParams(ctx).TradeRetention
+	if trade.ID+1 > retention {
+		k.pruneTrades(ctx, trade.ID+1-retention)
+	}
+
+	return trade
+}
+
+func (k Keeper) GetTrade(ctx sdk.Context, tradeId uint64) *types.Trade {
+	bz := ctx.KVStore(k.keyIndices).Get(types.GetTradeKey(tradeId))
+	if bz == nil {
+		return nil
+	}
+
+	trade := new(types.Trade)
+	k.cdc.MustUnmarshalBinaryBare(bz, trade)
+	return trade
+}
+
+// GetTradesByInstrument returns a page of the logged trades in which the maker sold src for dst, oldest first.
+func (k Keeper) GetTradesByInstrument(ctx sdk.Context, src, dst string, pageReq *query.PageRequest) ([]types.Trade, *query.PageResponse, error) {
+	return k.getTradesByIndex(ctx, types.GetTradeKeyByInstrument(src, dst), pageReq)
+}
+
+// GetTradesByAccount returns a page of the logged trades in which the account was maker or taker, oldest first.
+func (k Keeper) GetTradesByAccount(ctx sdk.Context, account sdk.AccAddress, pageReq *query.PageRequest) ([]types.Trade, *query.PageResponse, error) {
+	return k.getTradesByIndex(ctx, types.GetTradeKeyByAccount(account.String()), pageReq)
+}
+
+func (k Keeper) getTradesByIndex(ctx sdk.Context, indexPrefix []byte, pageReq *query.PageRequest) ([]types.Trade, *query.PageResponse, error) {
+	idxStore := ctx.KVStore(k.keyIndices)
+	store := prefix.NewStore(idxStore, indexPrefix)
+
+	trades := make([]types.Trade, 0)
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, _ []byte) error {
+		var trade types.Trade
+		if err := k.cdc.UnmarshalBinaryBare(idxStore.Get(append(types.GetTradePrefix(), key...)), &trade); err != nil {
+			return err
+		}
+
+		trades = append(trades, trade)
+		return nil
+	})
+
+	return trades, pageRes, err
+}
+
+// GetAllTrades returns the trade log, oldest first.
+func (k Keeper) GetAllTrades(ctx sdk.Context) (res []types.Trade) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.keyIndices), types.GetTradePrefix())
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var trade types.Trade
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &trade)
+		res = append(res, trade)
+	}
+
+	return
+}
+
+func (k Keeper) setTrade(ctx sdk.Context, trade *types.Trade) {
+	idxStore := ctx.KVStore(k.keyIndices)
+
+	idxStore.Set(types.GetTradeKey(trade.ID), k.cdc.MustMarshalBinaryBare(trade))
+	idxStore.Set(types.GetTradeInstrumentKey(trade.Source.Denom, trade.Destination.Denom, trade.ID), []byte{})
+	idxStore.Set(types.GetTradeAccountKey(trade.Maker, trade.ID), []byte{})
+	idxStore.Set(types.GetTradeAccountKey(trade.Taker, trade.ID), []byte{})
+}
+
+func (k Keeper) deleteTrade(ctx sdk.Context, trade *types.Trade) {
+	idxStore := ctx.KVStore(k.keyIndices)
+
+	idxStore.Delete(types.GetTradeKey(trade.ID))
+	idxStore.Delete(types.GetTradeInstrumentKey(trade.Source.Denom, trade.Destination.Denom, trade.ID))
+	idxStore.Delete(types.GetTradeAccountKey(trade.Maker, trade.ID))
+	idxStore.Delete(types.GetTradeAccountKey(trade.Taker, trade.ID))
+}
+
+// Delete the trades with an id below the given one.
+func (k Keeper) pruneTrades(ctx sdk.Context, before uint64) {
+	idxStore := ctx.KVStore(k.keyIndices)
+
+	var trades []*types.Trade
+
+	it := idxStore.Iterator(types.GetTradePrefix(), types.GetTradeKey(before))
+	for ; it.Valid(); it.Next() {
+		trade := new(types.Trade)
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), trade)
+		trades = append(trades, trade)
+	}
+	it.Close()
+
+	for _, trade := range trades {
+		k.deleteTrade(ctx, trade)
+	}
+}
+
+func (k Keeper) getNextTradeNumber(ctx sdk.Context) uint64 {
+	tradeID := k.peekNextTradeNumber(ctx)
+
+	bz := sdk.Uint64ToBigEndian(tradeID + 1)
+	ctx.KVStore(k.key).Set(types.GetTradeIDGeneratorKey(), bz)
+	return tradeID
+}
+
+func (k Keeper) peekNextTradeNumber(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.key).Get(types.GetTradeIDGeneratorKey())
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
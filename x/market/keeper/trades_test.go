@@ -0,0 +1,150 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTradeLogged(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	ctx = ctx.WithBlockHeight(7)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	maker := order(ctx.BlockTime(), acc1, "100eur", "120usd")
+	require.NoError(t, k.NewOrderSingle(ctx, maker))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "60usd", "50eur")))
+
+	trades := k.GetAllTrades(ctx)
+	require.Len(t, trades, 1)
+
+	trade := trades[0]
+	require.Equal(t, uint64(0), trade.ID)
+	require.Equal(t, uint64(0), trade.MakerOrderID)
+	require.Equal(t, uint64(1), trade.TakerOrderID)
+	require.Equal(t, acc1.GetAddress().String(), trade.Maker)
+	require.Equal(t, acc2.GetAddress().String(), trade.Taker)
+	require.Equal(t, "50eur", trade.Source.String())
+	require.Equal(t, "60usd", trade.Destination.String())
+	require.Equal(t, "1.200000000000000000", trade.Price.String())
+	require.Equal(t, ctx.BlockTime(), trade.Timestamp)
+	require.Equal(t, int64(7), trade.Height)
+}
+
+func TestSyntheticTradeLogsEveryLeg(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000chf")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	// usd -> eur through chf
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "100chf")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100chf", "100usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "100usd", "100eur")))
+
+	trades := k.GetAllTrades(ctx)
+	require.Len(t, trades, 2)
+
+	for _, trade := range trades {
+		require.Equal(t, uint64(2), trade.TakerOrderID)
+		require.Equal(t, acc3.GetAddress().String(), trade.Taker)
+	}
+
+	res, _, err := k.GetTradesByAccount(ctx, acc3.GetAddress(), nil)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+
+	res, _, err = k.GetTradesByAccount(ctx, acc1.GetAddress(), nil)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, "100eur", res[0].Source.String())
+
+	res, _, err = k.GetTradesByInstrument(ctx, "chf", "usd", nil)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, acc2.GetAddress().String(), res[0].Maker)
+
+	res, _, err = k.GetTradesByInstrument(ctx, "usd", "chf", nil)
+	require.NoError(t, err)
+	require.Empty(t, res)
+}
+
+func TestTradeRetention(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	k.SetParams(ctx, types.NewParams(types.DefaultCandleRetention, 2, 0, 0, nil, types.DefaultMaxHops, types.DefaultMaxOpenOrders, types.DefaultMaxInstrumentOpenOrders, nil, types.DefaultPriceHistoryRetention))
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "1000eur", "1000usd")))
+	for i := 0; i < 3; i++ {
+		require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "10usd", "10eur")))
+	}
+
+	trades := k.GetAllTrades(ctx)
+	require.Len(t, trades, 2)
+	require.Equal(t, uint64(1), trades[0].ID)
+	require.Equal(t, uint64(2), trades[1].ID)
+	require.Nil(t, k.GetTrade(ctx, 0))
+
+	// The indices of the dropped trade are removed
+	for _, acc := range []sdk.AccAddress{acc1.GetAddress(), acc2.GetAddress()} {
+		res, _, err := k.GetTradesByAccount(ctx, acc, nil)
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+	}
+
+	res, _, err := k.GetTradesByInstrument(ctx, "eur", "usd", nil)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+}
+
+func TestQueryTrades(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "1000eur", "1000usd")))
+	for i := 0; i < 3; i++ {
+		require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "10usd", "10eur")))
+	}
+
+	res, err := k.TradesByAccount(sdk.WrapSDKContext(ctx), &types.QueryTradesByAccountRequest{
+		Address: acc2.GetAddress().String(), Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Trades, 2)
+	require.Equal(t, uint64(0), res.Trades[0].ID)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = k.TradesByAccount(sdk.WrapSDKContext(ctx), &types.QueryTradesByAccountRequest{
+		Address: acc2.GetAddress().String(), Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Trades, 1)
+	require.Equal(t, uint64(2), res.Trades[0].ID)
+
+	res, err = k.TradesByInstrument(sdk.WrapSDKContext(ctx), &types.QueryTradesByInstrumentRequest{
+		Source: "eur", Destination: "usd", Pagination: &query.PageRequest{Offset: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Trades, 2)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	_, err = k.TradesByAccount(sdk.WrapSDKContext(ctx), &types.QueryTradesByAccountRequest{Address: "invalid"})
+	require.Error(t, err)
+
+	_, err = k.TradesByInstrument(sdk.WrapSDKContext(ctx), &types.QueryTradesByInstrumentRequest{Source: "#!@@", Destination: "usd"})
+	require.Error(t, err)
+}
@@ -0,0 +1,93 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// TWAP returns the time-weighted average of the last traded price of src in dst over the window ending at the current
+// block time. The window cannot exceed the price history retention.
+func (k Keeper) TWAP(ctx sdk.Context, src, dst string, window time.Duration) (sdk.Dec, error) {
+	if retention := k.GetParams(ctx).PriceHistoryRetention; window <= 0 || window > retention {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidTwapWindow, "window must be positive and at most %v: %v", retention, window)
+	}
+
+	md := k.GetInstrument(ctx, src, dst)
+	if md == nil || md.LastPrice == nil {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrNoMarketDataAvailable, "%v/%v", src, dst)
+	}
+
+	now := ctx.BlockTime()
+	start := k.getPriceHistoryAt(ctx, src, dst, now.Add(-window))
+	if start == nil {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInsufficientPriceHistory, "%v/%v has no trades before %v", src, dst, now.Add(-window))
+	}
+
+	return types.TimeWeightedAveragePrice(*start, *md, window, now), nil
+}
+
+// Returns the latest market data of the instrument recorded at or before t.
+func (k Keeper) getPriceHistoryAt(ctx sdk.Context, src, dst string, t time.Time) *types.MarketData {
+	idxStore := ctx.KVStore(k.keyIndices)
+
+	it := idxStore.ReverseIterator(types.GetPriceHistoryKeyByInstrument(src, dst), sdk.PrefixEndBytes(types.GetPriceHistoryKey(src, dst, t)))
+	defer it.Close()
+
+	if !it.Valid() {
+		return nil
+	}
+
+	md := new(types.MarketData)
+	k.cdc.MustUnmarshalBinaryBare(it.Value(), md)
+	return md
+}
+
+// Record the market data after a trade, dropping the entries that no longer fall within the retention. The latest entry
+// before the retention is kept, as it holds the price at the start of the longest window.
+func (k Keeper) recordPriceHistory(ctx sdk.Context, md types.MarketData) {
+	k.setPriceHistory(ctx, md)
+
+	idxStore := ctx.KVStore(k.keyIndices)
+	cutoff := md.Timestamp.Add(-k.GetParams(ctx).PriceHistoryRetention)
+
+	it := idxStore.ReverseIterator(types.GetPriceHistoryKeyByInstrument(md.Source, md.Destination), sdk.PrefixEndBytes(types.GetPriceHistoryKey(md.Source, md.Destination, cutoff)))
+	defer it.Close()
+
+	if !it.Valid() {
+		return
+	}
+
+	var expired [][]byte
+	for it.Next(); it.Valid(); it.Next() {
+		expired = append(expired, it.Key())
+	}
+
+	for _, key := range expired {
+		idxStore.Delete(key)
+	}
+}
+
+func (k Keeper) setPriceHistory(ctx sdk.Context, md types.MarketData) {
+	ctx.KVStore(k.keyIndices).Set(types.GetPriceHistoryKey(md.Source, md.Destination, *md.Timestamp), k.cdc.MustMarshalBinaryBare(&md))
+}
+
+// GetAllPriceHistory returns the recorded market data of every instrument, oldest first within an instrument.
+func (k Keeper) GetAllPriceHistory(ctx sdk.Context) (res []types.MarketData) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.keyIndices), types.GetPriceHistoryPrefix())
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var md types.MarketData
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &md)
+		res = append(res, md)
+	}
+
+	return
+}
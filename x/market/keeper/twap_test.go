@@ -0,0 +1,93 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTWAP(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	trade := func(ctx sdk.Context, usd string) {
+		require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, usd, "100eur")))
+		require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", usd)))
+	}
+
+	start := ctx.BlockTime()
+	trade(ctx, "120usd")
+	trade(ctx.WithBlockTime(start.Add(30*time.Minute)), "140usd")
+	ctx = ctx.WithBlockTime(start.Add(time.Hour))
+
+	// 1.2 usd per eur for the first half hour and 1.4 for the second
+	twap, err := k.TWAP(ctx, "eur", "usd", time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(13, 1), twap)
+
+	twap, err = k.TWAP(ctx, "eur", "usd", 30*time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(14, 1), twap)
+
+	// The price of the opposite direction is accumulated separately
+	twap, err = k.TWAP(ctx, "usd", "eur", 30*time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, sdk.OneDec().Quo(sdk.NewDecWithPrec(14, 1)), twap)
+
+	_, err = k.TWAP(ctx, "eur", "usd", 90*time.Minute)
+	require.ErrorIs(t, err, types.ErrInsufficientPriceHistory)
+
+	_, err = k.TWAP(ctx, "eur", "usd", 0)
+	require.ErrorIs(t, err, types.ErrInvalidTwapWindow)
+
+	_, err = k.TWAP(ctx, "eur", "usd", types.DefaultPriceHistoryRetention+time.Second)
+	require.ErrorIs(t, err, types.ErrInvalidTwapWindow)
+
+	_, err = k.TWAP(ctx, "chf", "usd", time.Hour)
+	require.ErrorIs(t, err, types.ErrNoMarketDataAvailable)
+
+	res, err := k.TimeWeightedAveragePrice(sdk.WrapSDKContext(ctx), &types.QueryTimeWeightedAveragePriceRequest{Source: "eur", Destination: "usd", Window: "1h"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(13, 1), res.Price)
+
+	_, err = k.TimeWeightedAveragePrice(sdk.WrapSDKContext(ctx), &types.QueryTimeWeightedAveragePriceRequest{Source: "eur", Destination: "usd", Window: "an hour"})
+	require.Error(t, err)
+}
+
+func TestPriceHistoryRetention(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	params := k.GetParams(ctx)
+	params.PriceHistoryRetention = time.Hour
+	k.SetParams(ctx, params)
+
+	start := ctx.BlockTime()
+	for _, offset := range []time.Duration{0, 30 * time.Minute, 90 * time.Minute, 150 * time.Minute} {
+		ctx := ctx.WithBlockTime(start.Add(offset))
+		require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "120usd", "100eur")))
+		require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	}
+
+	// The latest entry before the retention is kept for windows of the full retention
+	history := k.GetAllPriceHistory(ctx)
+	require.Len(t, history, 4)
+	for _, md := range history {
+		require.True(t, md.Timestamp.Equal(start.Add(90*time.Minute)) || md.Timestamp.Equal(start.Add(150*time.Minute)))
+	}
+
+	ctx = ctx.WithBlockTime(start.Add(150 * time.Minute))
+	// A price that held for the whole window is its own average
+	twap, err := k.TWAP(ctx, "eur", "usd", time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, *k.GetInstrument(ctx, "eur", "usd").LastPrice, twap)
+}
@@ -0,0 +1,35 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewBatchAuction(src, dst string) BatchAuction {
+	return BatchAuction{Source: src, Destination: dst}
+}
+
+func (a BatchAuction) Validate() error {
+	if err := sdk.ValidateDenom(a.Source); err != nil {
+		return fmt.Errorf("invalid source denomination: %w", err)
+	}
+
+	if err := sdk.ValidateDenom(a.Destination); err != nil {
+		return fmt.Errorf("invalid destination denomination: %w", err)
+	}
+
+	if a.Source == a.Destination {
+		return fmt.Errorf("'%v/%v' is not a valid instrument", a.Source, a.Destination)
+	}
+
+	return nil
+}
+
+func (a BatchAuction) String() string {
+	return fmt.Sprintf("%v/%v", a.Source, a.Destination)
+}
@@ -0,0 +1,113 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CandleIntervals lists the intervals that are aggregated for every traded instrument.
+var CandleIntervals = []CandleInterval{CandleInterval_Minute, CandleInterval_Hour, CandleInterval_Day}
+
+// Duration returns the length of the interval, or zero if it is unspecified.
+func (i CandleInterval) Duration() time.Duration {
+	switch i {
+	case CandleInterval_Minute:
+		return time.Minute
+	case CandleInterval_Hour:
+		return time.Hour
+	case CandleInterval_Day:
+		return 24 * time.Hour
+	}
+
+	return 0
+}
+
+// BucketStart returns the start of the interval's bucket that contains t.
+func (i CandleInterval) BucketStart(t time.Time) time.Time {
+	return t.UTC().Truncate(i.Duration())
+}
+
+// Convert from the candle interval string representation to the internal enum type. Case insensitive.
+func CandleIntervalFromString(p string) (CandleInterval, error) {
+	p = strings.ToLower(p)
+
+	switch p {
+	case "1m":
+		return CandleInterval_Minute, nil
+	case "1h":
+		return CandleInterval_Hour, nil
+	case "1d":
+		return CandleInterval_Day, nil
+	}
+
+	return 0, fmt.Errorf("unknown candle interval: %v", p)
+}
+
+// NewCandle opens a candle for a trade at price.
+func NewCandle(src, dst string, interval CandleInterval, start time.Time, price sdk.Dec, volume sdk.Int) Candle {
+	return Candle{
+		Source:      src,
+		Destination: dst,
+		Interval:    interval,
+		Start:       start,
+		Open:        price,
+		High:        price,
+		Low:         price,
+		Close:       price,
+		Volume:      volume,
+	}
+}
+
+// AddTrade updates the candle with a trade at price, which occurred after every trade already in the candle.
+func (c *Candle) AddTrade(price sdk.Dec, volume sdk.Int) {
+	if price.GT(c.High) {
+		c.High = price
+	}
+
+	if price.LT(c.Low) {
+		c.Low = price
+	}
+
+	c.Close = price
+	c.Volume = c.Volume.Add(volume)
+}
+
+func (c Candle) Validate() error {
+	if sdk.ValidateDenom(c.Source) != nil || sdk.ValidateDenom(c.Destination) != nil || c.Source == c.Destination {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid instrument: %v/%v", c.Source, c.Destination)
+	}
+
+	if c.Interval.Duration() == 0 {
+		return fmt.Errorf("invalid candle interval: %v", c.Interval)
+	}
+
+	if !c.Start.Equal(c.Interval.BucketStart(c.Start)) {
+		return fmt.Errorf("candle start %v is not aligned to its interval", c.Start)
+	}
+
+	if c.Open.IsNil() || c.High.IsNil() || c.Low.IsNil() || c.Close.IsNil() || c.Volume.IsNil() {
+		return fmt.Errorf("incomplete candle")
+	}
+
+	if !c.Low.IsPositive() || c.Low.GT(c.Open) || c.Low.GT(c.Close) || c.High.LT(c.Open) || c.High.LT(c.Close) {
+		return fmt.Errorf("inconsistent candle prices: %v", c)
+	}
+
+	if c.Volume.IsNegative() {
+		return fmt.Errorf("negative candle volume: %v", c.Volume)
+	}
+
+	return nil
+}
+
+func (c Candle) String() string {
+	return fmt.Sprintf("%v/%v %v %v O:%v H:%v L:%v C:%v V:%v", c.Source, c.Destination, c.Interval, c.Start.Format(time.RFC3339), c.Open, c.High, c.Low, c.Close, c.Volume)
+}
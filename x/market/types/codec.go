@@ -28,6 +28,15 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddMarketOrder{}, "e-money/MsgAddMarketOrder", nil)
 	cdc.RegisterConcrete(&MsgCancelReplaceLimitOrder{}, "e-money/MsgCancelReplaceLimitOrder", nil)
 	cdc.RegisterConcrete(&MsgCancelOrder{}, "e-money/MsgCancelOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelAllOrders{}, "e-money/MsgCancelAllOrders", nil)
+	cdc.RegisterConcrete(&MsgAddStopOrder{}, "e-money/MsgAddStopOrder", nil)
+	cdc.RegisterConcrete(&MsgSetFees{}, "e-money/MsgSetFees", nil)
+	cdc.RegisterConcrete(&MsgSetInstrumentRules{}, "e-money/MsgSetInstrumentRules", nil)
+	cdc.RegisterConcrete(&MsgHaltTrading{}, "e-money/MsgHaltTrading", nil)
+	cdc.RegisterConcrete(&MsgResumeTrading{}, "e-money/MsgResumeTrading", nil)
+	cdc.RegisterConcrete(&MsgSetPriceBand{}, "e-money/MsgSetPriceBand", nil)
+	cdc.RegisterConcrete(&MsgSetOrderLimits{}, "e-money/MsgSetOrderLimits", nil)
+	cdc.RegisterConcrete(&MsgSetBatchAuction{}, "e-money/MsgSetBatchAuction", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -36,6 +45,15 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgAddMarketOrder{},
 		&MsgCancelReplaceLimitOrder{},
 		&MsgCancelOrder{},
+		&MsgCancelAllOrders{},
+		&MsgAddStopOrder{},
+		&MsgSetFees{},
+		&MsgSetInstrumentRules{},
+		&MsgHaltTrading{},
+		&MsgResumeTrading{},
+		&MsgSetPriceBand{},
+		&MsgSetOrderLimits{},
+		&MsgSetBatchAuction{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
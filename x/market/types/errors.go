@@ -19,7 +19,29 @@ var (
 	ErrInvalidPrice                            = sdkerrors.Register(ModuleName, 8, "insufficient source instrument quantity to pay for 1 unit of destination instrument")
 	ErrNoSourceRemaining                       = sdkerrors.Register(ModuleName, 9, "the original order has spent the entire source instrument quantity")
 	ErrUnknownAsset                            = sdkerrors.Register(ModuleName, 10, "unknown destination instrument denomination")
-	ErrUnknownTimeInForce                      = sdkerrors.Register(ModuleName, 12, "unknown time in force value. Valid values are TimeInForce_GoodTillCancel, TimeInForce_FillOrKill, TimeInForce_ImmediateOrCancel")
+	ErrUnknownTimeInForce                      = sdkerrors.Register(ModuleName, 12, "unknown time in force value. Valid values are TimeInForce_GoodTillCancel, TimeInForce_FillOrKill, TimeInForce_ImmediateOrCancel, TimeInForce_GoodTillTime, TimeInForce_GoodTillBlock")
 	ErrNoMarketDataAvailable                   = sdkerrors.Register(ModuleName, 13, "no market data available for instrument")
 	ErrInvalidSlippage                         = sdkerrors.Register(ModuleName, 14, "invalid slippage")
+	ErrInvalidExpiry                           = sdkerrors.Register(ModuleName, 15, "invalid order expiry")
+	ErrPostOnlyWouldCross                      = sdkerrors.Register(ModuleName, 16, "post-only order would match a resting order")
+	ErrInvalidPostOnlyMode                     = sdkerrors.Register(ModuleName, 17, "invalid post-only mode. Post-only orders must be allowed to rest on the book")
+	ErrInvalidStopOrder                        = sdkerrors.Register(ModuleName, 18, "invalid stop order")
+	ErrStopPriceReached                        = sdkerrors.Register(ModuleName, 19, "the last traded price has already reached the stop price")
+	ErrInvalidFees                             = sdkerrors.Register(ModuleName, 20, "invalid trading fees")
+	ErrInvalidInstrumentRules                  = sdkerrors.Register(ModuleName, 21, "invalid instrument rules")
+	ErrOrderBelowMinimumSize                   = sdkerrors.Register(ModuleName, 22, "order is below the minimum order size of the instrument")
+	ErrInvalidLotSize                          = sdkerrors.Register(ModuleName, 23, "order amount is not a multiple of the lot size of the instrument")
+	ErrInvalidTickSize                         = sdkerrors.Register(ModuleName, 24, "order price is not a multiple of the tick size of the instrument")
+	ErrInvalidSelfTradePrevention              = sdkerrors.Register(ModuleName, 25, "invalid self-trade prevention mode")
+	ErrInvalidDisplayQuantity                  = sdkerrors.Register(ModuleName, 26, "invalid display quantity")
+	ErrInvalidTradingHalt                      = sdkerrors.Register(ModuleName, 27, "invalid trading halt")
+	ErrTradingHalted                           = sdkerrors.Register(ModuleName, 28, "trading is halted on the instrument")
+	ErrTradingHaltNotFound                     = sdkerrors.Register(ModuleName, 29, "the trading halt cannot be found")
+	ErrInvalidPriceBand                        = sdkerrors.Register(ModuleName, 30, "invalid price band")
+	ErrInvalidOrderLimits                      = sdkerrors.Register(ModuleName, 31, "invalid open order limits")
+	ErrTooManyOpenOrders                       = sdkerrors.Register(ModuleName, 32, "the account has reached its limit of open orders")
+	ErrInvalidBatchAuction                     = sdkerrors.Register(ModuleName, 33, "invalid batch auction")
+	ErrNotSupportedInBatchAuction              = sdkerrors.Register(ModuleName, 34, "order type is not supported by instruments in batch auction mode")
+	ErrInvalidTwapWindow                       = sdkerrors.Register(ModuleName, 35, "invalid time-weighted average price window")
+	ErrInsufficientPriceHistory                = sdkerrors.Register(ModuleName, 36, "the price history of the instrument does not cover the window")
 )
@@ -10,6 +10,8 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 // market module event types
@@ -28,6 +30,18 @@ const (
 	AttributeKeyDestinationFilled = "destination_filled"
 	AttributeKeyAggressive        = "aggressive"
 	AttributeKeyCreated           = "created"
+	AttributeKeyStopOrderID       = "stop_order_id"
+	AttributeKeyOrderType         = "order_type"
+	AttributeKeyStopPrice         = "stop_price"
+	AttributeKeyFee               = "fee"
+	AttributeKeyExpireTime        = "expire_time"
+
+	AttributeKeyRestingOrderID      = "resting_order_id"
+	AttributeKeySelfTradePrevention = "self_trade_prevention"
+
+	AttributeKeySellOrderID = "sell_order_id"
+	AttributeKeyBuyOrderID  = "buy_order_id"
+	AttributeKeyError       = "error"
 )
 
 func EmitAcceptEvent(ctx sdk.Context, order Order) {
@@ -42,6 +56,15 @@ func EmitAcceptEvent(ctx sdk.Context, order Order) {
 			sdk.NewAttribute(AttributeKeyCreated, order.Created.Format(time.RFC3339)),
 		),
 	)
+
+	emitTypedEvent(ctx, &EventOrderAccepted{
+		OrderId:       order.ID,
+		Owner:         order.Owner,
+		ClientOrderId: order.ClientOrderID,
+		Source:        order.Source,
+		Destination:   order.Destination,
+		Created:       order.Created,
+	})
 }
 
 func EmitExpireEvent(ctx sdk.Context, order Order) {
@@ -58,9 +81,21 @@ func EmitExpireEvent(ctx sdk.Context, order Order) {
 			sdk.NewAttribute(AttributeKeyDestinationFilled, fmt.Sprintf("%v%v", order.DestinationFilled.String(), order.Destination.Denom)),
 		),
 	)
+
+	emitTypedEvent(ctx, &EventOrderExpired{
+		OrderId:           order.ID,
+		Owner:             order.Owner,
+		ClientOrderId:     order.ClientOrderID,
+		Source:            order.Source,
+		SourceFilled:      sdk.NewCoin(order.Source.Denom, order.SourceFilled),
+		SourceRemaining:   sdk.NewCoin(order.Source.Denom, order.SourceRemaining),
+		Destination:       order.Destination,
+		DestinationFilled: sdk.NewCoin(order.Destination.Denom, order.DestinationFilled),
+	})
 }
 
-func EmitFillEvent(ctx sdk.Context, order Order, aggressive bool, sourceFilled sdk.Int, destinationFilled sdk.Int) {
+// EmitFillEvent reports a fill of order. The fee is charged in the destination denomination.
+func EmitFillEvent(ctx sdk.Context, order Order, aggressive bool, sourceFilled sdk.Int, destinationFilled sdk.Int, fee sdk.Int) {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(EventTypeMarket,
 			sdk.NewAttribute(AttributeKeyAction, "fill"),
@@ -70,8 +105,19 @@ func EmitFillEvent(ctx sdk.Context, order Order, aggressive bool, sourceFilled s
 			sdk.NewAttribute(AttributeKeyAggressive, strconv.FormatBool(aggressive)),
 			sdk.NewAttribute(AttributeKeySourceFilled, fmt.Sprintf("%v%v", sourceFilled.String(), order.Source.Denom)),
 			sdk.NewAttribute(AttributeKeyDestinationFilled, fmt.Sprintf("%v%v", destinationFilled.String(), order.Destination.Denom)),
+			sdk.NewAttribute(AttributeKeyFee, fmt.Sprintf("%v%v", fee.String(), order.Destination.Denom)),
 		),
 	)
+
+	emitTypedEvent(ctx, &EventOrderFilled{
+		OrderId:           order.ID,
+		Owner:             order.Owner,
+		ClientOrderId:     order.ClientOrderID,
+		Aggressive:        aggressive,
+		SourceFilled:      sdk.NewCoin(order.Source.Denom, sourceFilled),
+		DestinationFilled: sdk.NewCoin(order.Destination.Denom, destinationFilled),
+		Fee:               sdk.NewCoin(order.Destination.Denom, fee),
+	})
 }
 
 func EmitUpdateEvent(ctx sdk.Context, order Order) {
@@ -84,4 +130,142 @@ func EmitUpdateEvent(ctx sdk.Context, order Order) {
 			sdk.NewAttribute(AttributeKeySourceRemaining, fmt.Sprintf("%v%v", order.SourceRemaining.String(), order.Source.Denom)),
 		),
 	)
+
+	emitTypedEvent(ctx, &EventOrderUpdated{
+		OrderId:         order.ID,
+		Owner:           order.Owner,
+		ClientOrderId:   order.ClientOrderID,
+		SourceRemaining: sdk.NewCoin(order.Source.Denom, order.SourceRemaining),
+	})
+}
+
+// EmitSelfTradeEvent reports that the aggressive order would have matched a resting order of the same owner.
+func EmitSelfTradeEvent(ctx sdk.Context, aggressive Order, resting Order) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(EventTypeMarket,
+			sdk.NewAttribute(AttributeKeyAction, "self_trade"),
+			sdk.NewAttribute(AttributeKeyOrderID, fmt.Sprintf("%d", aggressive.ID)),
+			sdk.NewAttribute(AttributeKeyOwner, aggressive.Owner),
+			sdk.NewAttribute(AttributeKeyClientOrderID, aggressive.ClientOrderID),
+			sdk.NewAttribute(AttributeKeyRestingOrderID, fmt.Sprintf("%d", resting.ID)),
+			sdk.NewAttribute(AttributeKeySelfTradePrevention, aggressive.SelfTradePrevention.String()),
+		),
+	)
+}
+
+func EmitStopAcceptEvent(ctx sdk.Context, stopOrder StopOrder) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(EventTypeMarket,
+			sdk.NewAttribute(AttributeKeyAction, "accept_stop"),
+			sdk.NewAttribute(AttributeKeyStopOrderID, fmt.Sprintf("%d", stopOrder.ID)),
+			sdk.NewAttribute(AttributeKeyOwner, stopOrder.Owner),
+			sdk.NewAttribute(AttributeKeyClientOrderID, stopOrder.ClientOrderID),
+			sdk.NewAttribute(AttributeKeyOrderType, stopOrder.OrderType.String()),
+			sdk.NewAttribute(AttributeKeySource, stopOrder.Source.String()),
+			sdk.NewAttribute(AttributeKeyDestination, stopOrder.Destination.String()),
+			sdk.NewAttribute(AttributeKeyStopPrice, stopOrder.StopPrice.String()),
+			sdk.NewAttribute(AttributeKeyCreated, stopOrder.Created.Format(time.RFC3339)),
+		),
+	)
+}
+
+func EmitTriggerEvent(ctx sdk.Context, stopOrder StopOrder, lastPrice sdk.Dec) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(EventTypeMarket,
+			sdk.NewAttribute(AttributeKeyAction, "trigger"),
+			sdk.NewAttribute(AttributeKeyStopOrderID, fmt.Sprintf("%d", stopOrder.ID)),
+			sdk.NewAttribute(AttributeKeyOwner, stopOrder.Owner),
+			sdk.NewAttribute(AttributeKeyClientOrderID, stopOrder.ClientOrderID),
+			sdk.NewAttribute(AttributeKeyStopPrice, stopOrder.StopPrice.String()),
+			sdk.NewAttribute(AttributeKeyPrice, lastPrice.String()),
+		),
+	)
+}
+
+func EmitStopExpireEvent(ctx sdk.Context, stopOrder StopOrder) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(EventTypeMarket,
+			sdk.NewAttribute(AttributeKeyAction, "expire_stop"),
+			sdk.NewAttribute(AttributeKeyStopOrderID, fmt.Sprintf("%d", stopOrder.ID)),
+			sdk.NewAttribute(AttributeKeyOwner, stopOrder.Owner),
+			sdk.NewAttribute(AttributeKeyClientOrderID, stopOrder.ClientOrderID),
+		),
+	)
+}
+
+// EmitPriceBandHaltEvent reports that repeated breaches of a price band have halted an instrument until the halt expires.
+func EmitPriceBandHaltEvent(ctx sdk.Context, halt TradingHalt) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(EventTypeMarket,
+			sdk.NewAttribute(AttributeKeyAction, "price_band_halt"),
+			sdk.NewAttribute(AttributeKeySource, halt.Source),
+			sdk.NewAttribute(AttributeKeyDestination, halt.Destination),
+			sdk.NewAttribute(AttributeKeyExpireTime, halt.ExpireTime.Format(time.RFC3339)),
+		),
+	)
+}
+
+// EmitAuctionSettlementFailedEvent reports that a match of a batch auction could not be settled and was skipped.
+func EmitAuctionSettlementFailedEvent(ctx sdk.Context, sell Order, buy Order, err error) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(EventTypeMarket,
+			sdk.NewAttribute(AttributeKeyAction, "auction_settlement_failed"),
+			sdk.NewAttribute(AttributeKeySource, sell.Source.Denom),
+			sdk.NewAttribute(AttributeKeyDestination, sell.Destination.Denom),
+			sdk.NewAttribute(AttributeKeySellOrderID, fmt.Sprintf("%d", sell.ID)),
+			sdk.NewAttribute(AttributeKeyBuyOrderID, fmt.Sprintf("%d", buy.ID)),
+			sdk.NewAttribute(AttributeKeyError, err.Error()),
+		),
+	)
+}
+
+// Order events are emitted both as legacy events of type EventTypeMarket and as typed events, which carry the
+// protobuf message name as their type. The legacy events will be removed once indexers have moved to the typed ones.
+func emitTypedEvent(ctx sdk.Context, tev proto.Message) {
+	// The event messages only hold valid coins and timestamps, so encoding them cannot fail.
+	if err := ctx.EventManager().EmitTypedEvent(tev); err != nil {
+		panic(err)
+	}
+}
+
+// IsTypedEvent reports whether event is one of the typed events of the market module.
+func IsTypedEvent(event abci.Event) bool {
+	switch event.Type {
+	case proto.MessageName(&EventOrderAccepted{}),
+		proto.MessageName(&EventOrderFilled{}),
+		proto.MessageName(&EventOrderExpired{}),
+		proto.MessageName(&EventOrderUpdated{}):
+		return true
+	}
+
+	return false
+}
+
+// ParseTypedEvent decodes a typed event of the market module into one of *EventOrderAccepted, *EventOrderFilled,
+// *EventOrderExpired or *EventOrderUpdated.
+func ParseTypedEvent(event abci.Event) (proto.Message, error) {
+	if !IsTypedEvent(event) {
+		return nil, fmt.Errorf("not a typed market event: %q", event.Type)
+	}
+
+	return sdk.ParseTypedEvent(event)
+}
+
+// ParseTypedEvents decodes the typed events of the market module among events, such as those of a transaction result,
+// in the order they were emitted. Legacy market events and the events of other modules are skipped.
+func ParseTypedEvents(events []abci.Event) ([]proto.Message, error) {
+	res := make([]proto.Message, 0)
+	for _, ev := range events {
+		if !IsTypedEvent(ev) {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(ev)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, msg)
+	}
+
+	return res, nil
 }
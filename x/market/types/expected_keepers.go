@@ -21,6 +21,14 @@ type (
 		InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error
 		SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 		GetSupply(ctx sdk.Context) exported.SupplyI
-		AddBalanceListener(l func(sdk.Context, []sdk.AccAddress))
+		AddBalanceListener(l func(sdk.Context, []sdk.AccAddress, sdk.Coins))
+	}
+
+	StakingKeeper interface {
+		BondDenom(ctx sdk.Context) string
+	}
+
+	AuthorityKeeper interface {
+		ValidateAuthority(ctx sdk.Context, address sdk.AccAddress) error
 	}
 )
@@ -0,0 +1,295 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewGenesisState(
+	orders []Order, marketData []MarketData, nextOrderID uint64, stopOrders []StopOrder, params Params, candles []Candle,
+	trades []Trade, nextTradeID uint64, instrumentRules []InstrumentRules, tradingHalts []TradingHalt,
+	priceBands []PriceBand, batchAuctions []BatchAuction, priceHistory []MarketData,
+) GenesisState {
+	return GenesisState{
+		Orders:          orders,
+		MarketData:      marketData,
+		NextOrderID:     nextOrderID,
+		StopOrders:      stopOrders,
+		Params:          params,
+		Candles:         candles,
+		Trades:          trades,
+		NextTradeID:     nextTradeID,
+		InstrumentRules: instrumentRules,
+		TradingHalts:    tradingHalts,
+		PriceBands:      priceBands,
+		BatchAuctions:   batchAuctions,
+		PriceHistory:    priceHistory,
+	}
+}
+
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Orders:          []Order{},
+		MarketData:      []MarketData{},
+		StopOrders:      []StopOrder{},
+		Params:          DefaultParams(),
+		Candles:         []Candle{},
+		Trades:          []Trade{},
+		InstrumentRules: []InstrumentRules{},
+		TradingHalts:    []TradingHalt{},
+		PriceBands:      []PriceBand{},
+		BatchAuctions:   []BatchAuction{},
+		PriceHistory:    []MarketData{},
+	}
+}
+
+// Validate performs a stateless check of the parameters, instrument rules,
+// trading halts, price bands, batch auctions, resting orders, market data,
+// price history, candles and trade log before they are loaded into the order
+// book.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+
+	orderIDs := make(map[uint64]bool)
+	clientOrderIDs := make(map[string]bool)
+
+	for _, order := range gs.Orders {
+		if err := validateGenesisOrder(order); err != nil {
+			return fmt.Errorf("invalid order %v: %w", order.ID, err)
+		}
+
+		if orderIDs[order.ID] {
+			return fmt.Errorf("duplicate order id: %v", order.ID)
+		}
+		orderIDs[order.ID] = true
+
+		ownerKey := string(GetOwnerKey(order.Owner, order.ClientOrderID))
+		if clientOrderIDs[ownerKey] {
+			return fmt.Errorf("duplicate client order id for %v: %v", order.Owner, order.ClientOrderID)
+		}
+		clientOrderIDs[ownerKey] = true
+
+		if order.ID >= gs.NextOrderID {
+			return fmt.Errorf("order id %v is not below the next order id %v", order.ID, gs.NextOrderID)
+		}
+	}
+
+	for _, stopOrder := range gs.StopOrders {
+		if err := validateGenesisStopOrder(stopOrder); err != nil {
+			return fmt.Errorf("invalid stop order %v: %w", stopOrder.ID, err)
+		}
+
+		// Stop orders share the id sequence with orders and become orders with the same client order id once triggered.
+		if orderIDs[stopOrder.ID] {
+			return fmt.Errorf("duplicate order id: %v", stopOrder.ID)
+		}
+		orderIDs[stopOrder.ID] = true
+
+		ownerKey := string(GetOwnerKey(stopOrder.Owner, stopOrder.ClientOrderID))
+		if clientOrderIDs[ownerKey] {
+			return fmt.Errorf("duplicate client order id for %v: %v", stopOrder.Owner, stopOrder.ClientOrderID)
+		}
+		clientOrderIDs[ownerKey] = true
+
+		if stopOrder.ID >= gs.NextOrderID {
+			return fmt.Errorf("stop order id %v is not below the next order id %v", stopOrder.ID, gs.NextOrderID)
+		}
+	}
+
+	instruments := make(map[string]bool)
+	for _, md := range gs.MarketData {
+		if err := validateGenesisMarketData(md); err != nil {
+			return err
+		}
+
+		instr := fmt.Sprintf("%v/%v", md.Source, md.Destination)
+		if instruments[instr] {
+			return fmt.Errorf("duplicate market data for instrument %v", instr)
+		}
+		instruments[instr] = true
+	}
+
+	history := make(map[string]bool)
+	for _, md := range gs.PriceHistory {
+		if err := validateGenesisMarketData(md); err != nil {
+			return fmt.Errorf("invalid price history: %w", err)
+		}
+
+		// Entries without a price do not contribute to a time-weighted average price.
+		if md.LastPrice == nil || md.Timestamp == nil {
+			return fmt.Errorf("price history for %v/%v is missing a price or timestamp", md.Source, md.Destination)
+		}
+
+		key := string(GetPriceHistoryKey(md.Source, md.Destination, *md.Timestamp))
+		if history[key] {
+			return fmt.Errorf("duplicate price history for instrument %v/%v at %v", md.Source, md.Destination, md.Timestamp)
+		}
+		history[key] = true
+	}
+
+	candles := make(map[string]bool)
+	for _, candle := range gs.Candles {
+		if err := candle.Validate(); err != nil {
+			return fmt.Errorf("invalid candle %v: %w", candle, err)
+		}
+
+		key := string(GetCandleKey(candle.Source, candle.Destination, candle.Interval, candle.Start))
+		if candles[key] {
+			return fmt.Errorf("duplicate candle: %v", candle)
+		}
+		candles[key] = true
+	}
+
+	tradeIDs := make(map[uint64]bool)
+	for _, trade := range gs.Trades {
+		if err := trade.Validate(); err != nil {
+			return fmt.Errorf("invalid trade %v: %w", trade.ID, err)
+		}
+
+		if tradeIDs[trade.ID] {
+			return fmt.Errorf("duplicate trade id: %v", trade.ID)
+		}
+		tradeIDs[trade.ID] = true
+
+		if trade.ID >= gs.NextTradeID {
+			return fmt.Errorf("trade id %v is not below the next trade id %v", trade.ID, gs.NextTradeID)
+		}
+	}
+
+	rulesInstruments := make(map[string]bool)
+	for _, rules := range gs.InstrumentRules {
+		if err := rules.Validate(); err != nil {
+			return fmt.Errorf("invalid instrument rules: %w", err)
+		}
+
+		instr := fmt.Sprintf("%v/%v", rules.Source, rules.Destination)
+		if rulesInstruments[instr] {
+			return fmt.Errorf("duplicate rules for instrument %v", instr)
+		}
+		rulesInstruments[instr] = true
+	}
+
+	halts := make(map[string]bool)
+	for _, halt := range gs.TradingHalts {
+		if err := halt.Validate(); err != nil {
+			return fmt.Errorf("invalid trading halt: %w", err)
+		}
+
+		key := string(halt.Key())
+		if halts[key] {
+			return fmt.Errorf("duplicate trading halt %v", halt)
+		}
+		halts[key] = true
+	}
+
+	bands := make(map[string]bool)
+	for _, band := range gs.PriceBands {
+		if err := band.Validate(); err != nil {
+			return fmt.Errorf("invalid price band: %w", err)
+		}
+
+		key := string(GetPriceBandKey(band.Source, band.Destination))
+		if bands[key] {
+			return fmt.Errorf("duplicate price band for instrument %v/%v", band.Source, band.Destination)
+		}
+		bands[key] = true
+	}
+
+	auctions := make(map[string]bool)
+	for _, auction := range gs.BatchAuctions {
+		if err := auction.Validate(); err != nil {
+			return fmt.Errorf("invalid batch auction: %w", err)
+		}
+
+		key := string(GetBatchAuctionKey(auction.Source, auction.Destination))
+		if auctions[key] {
+			return fmt.Errorf("duplicate batch auction for instrument %v/%v", auction.Source, auction.Destination)
+		}
+		auctions[key] = true
+	}
+
+	return nil
+}
+
+func validateGenesisMarketData(md MarketData) error {
+	if err := sdk.ValidateDenom(md.Source); err != nil {
+		return fmt.Errorf("invalid market data source denomination: %w", err)
+	}
+
+	if err := sdk.ValidateDenom(md.Destination); err != nil {
+		return fmt.Errorf("invalid market data destination denomination: %w", err)
+	}
+
+	if md.Source == md.Destination {
+		return fmt.Errorf("'%v/%v' is not a valid instrument", md.Source, md.Destination)
+	}
+
+	if md.LastPrice != nil && !md.LastPrice.IsPositive() {
+		return fmt.Errorf("market data for %v/%v has a non-positive last price: %v", md.Source, md.Destination, md.LastPrice)
+	}
+
+	if !md.CumulativePrice.IsNil() && md.CumulativePrice.IsNegative() {
+		return fmt.Errorf("market data for %v/%v has a negative cumulative price: %v", md.Source, md.Destination, md.CumulativePrice)
+	}
+
+	return nil
+}
+
+func validateGenesisOrder(order Order) error {
+	if _, err := sdk.AccAddressFromBech32(order.Owner); err != nil {
+		return fmt.Errorf("invalid owner address: %w", err)
+	}
+
+	if err := validateClientOrderID(order.ClientOrderID); err != nil {
+		return err
+	}
+
+	if !order.Source.IsValid() || !order.Destination.IsValid() {
+		return fmt.Errorf("invalid source or destination: %v -> %v", order.Source, order.Destination)
+	}
+
+	if err := order.IsValid(); err != nil {
+		return err
+	}
+
+	if order.SourceRemaining.IsNil() || order.SourceFilled.IsNil() || order.DestinationFilled.IsNil() {
+		return fmt.Errorf("missing filled or remaining amounts")
+	}
+
+	if order.SourceFilled.IsNegative() || order.DestinationFilled.IsNegative() || order.SourceRemaining.IsNegative() {
+		return fmt.Errorf("negative filled or remaining amounts")
+	}
+
+	if order.SourceFilled.Add(order.SourceRemaining).GT(order.Source.Amount) {
+		return fmt.Errorf("source filled %v and remaining %v exceed source %v", order.SourceFilled, order.SourceRemaining, order.Source)
+	}
+
+	if order.IsFilled() {
+		return fmt.Errorf("order is filled and cannot rest on the book")
+	}
+
+	return nil
+}
+
+func validateGenesisStopOrder(stopOrder StopOrder) error {
+	if _, err := sdk.AccAddressFromBech32(stopOrder.Owner); err != nil {
+		return fmt.Errorf("invalid owner address: %w", err)
+	}
+
+	if err := validateClientOrderID(stopOrder.ClientOrderID); err != nil {
+		return err
+	}
+
+	if !stopOrder.Source.IsValid() || !stopOrder.Destination.IsValid() {
+		return fmt.Errorf("invalid source or destination: %v -> %v", stopOrder.Source, stopOrder.Destination)
+	}
+
+	return stopOrder.IsValid()
+}
@@ -0,0 +1,1037 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: em/market/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type GenesisState struct {
+	Orders          []Order           `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders" yaml:"orders"`
+	MarketData      []MarketData      `protobuf:"bytes,2,rep,name=market_data,json=marketData,proto3" json:"market_data" yaml:"market_data"`
+	NextOrderID     uint64            `protobuf:"varint,3,opt,name=next_order_id,json=nextOrderId,proto3" json:"next_order_id,omitempty" yaml:"next_order_id"`
+	StopOrders      []StopOrder       `protobuf:"bytes,4,rep,name=stop_orders,json=stopOrders,proto3" json:"stop_orders" yaml:"stop_orders"`
+	Params          Params            `protobuf:"bytes,5,opt,name=params,proto3" json:"params" yaml:"params"`
+	Candles         []Candle          `protobuf:"bytes,6,rep,name=candles,proto3" json:"candles" yaml:"candles"`
+	Trades          []Trade           `protobuf:"bytes,7,rep,name=trades,proto3" json:"trades" yaml:"trades"`
+	NextTradeID     uint64            `protobuf:"varint,8,opt,name=next_trade_id,json=nextTradeId,proto3" json:"next_trade_id,omitempty" yaml:"next_trade_id"`
+	InstrumentRules []InstrumentRules `protobuf:"bytes,9,rep,name=instrument_rules,json=instrumentRules,proto3" json:"instrument_rules" yaml:"instrument_rules"`
+	TradingHalts    []TradingHalt     `protobuf:"bytes,10,rep,name=trading_halts,json=tradingHalts,proto3" json:"trading_halts" yaml:"trading_halts"`
+	PriceBands      []PriceBand       `protobuf:"bytes,11,rep,name=price_bands,json=priceBands,proto3" json:"price_bands" yaml:"price_bands"`
+	BatchAuctions   []BatchAuction    `protobuf:"bytes,12,rep,name=batch_auctions,json=batchAuctions,proto3" json:"batch_auctions" yaml:"batch_auctions"`
+	// Market data recorded at every trade within the price history retention,
+	// from which time-weighted average prices are derived.
+	PriceHistory []MarketData `protobuf:"bytes,13,rep,name=price_history,json=priceHistory,proto3" json:"price_history" yaml:"price_history"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebff68995ee636f7, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetOrders() []Order {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMarketData() []MarketData {
+	if m != nil {
+		return m.MarketData
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextOrderID() uint64 {
+	if m != nil {
+		return m.NextOrderID
+	}
+	return 0
+}
+
+func (m *GenesisState) GetStopOrders() []StopOrder {
+	if m != nil {
+		return m.StopOrders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetCandles() []Candle {
+	if m != nil {
+		return m.Candles
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTrades() []Trade {
+	if m != nil {
+		return m.Trades
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextTradeID() uint64 {
+	if m != nil {
+		return m.NextTradeID
+	}
+	return 0
+}
+
+func (m *GenesisState) GetInstrumentRules() []InstrumentRules {
+	if m != nil {
+		return m.InstrumentRules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTradingHalts() []TradingHalt {
+	if m != nil {
+		return m.TradingHalts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPriceBands() []PriceBand {
+	if m != nil {
+		return m.PriceBands
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBatchAuctions() []BatchAuction {
+	if m != nil {
+		return m.BatchAuctions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPriceHistory() []MarketData {
+	if m != nil {
+		return m.PriceHistory
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "em.market.v1.GenesisState")
+}
+
+func init() { proto.RegisterFile("em/market/v1/genesis.proto", fileDescriptor_ebff68995ee636f7) }
+
+var fileDescriptor_ebff68995ee636f7 = []byte{
+	// 607 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x41, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc6, 0x9b, 0xff, 0xf6, 0xef, 0x86, 0xd3, 0x0c, 0x64, 0x3a, 0x96, 0x55, 0x2c, 0xad, 0x7c,
+	0x61, 0x12, 0x5a, 0xa2, 0x8d, 0x1b, 0x37, 0xb2, 0x01, 0x9b, 0x10, 0x30, 0x79, 0xe3, 0x02, 0x48,
+	0xc1, 0x6d, 0xac, 0x34, 0xa2, 0x71, 0xa2, 0xd8, 0x9d, 0xda, 0x6f, 0xc1, 0xc7, 0xea, 0x71, 0x47,
+	0x4e, 0x15, 0x6a, 0xbf, 0xc1, 0x3e, 0x01, 0x8a, 0xed, 0x76, 0x4d, 0x3a, 0x89, 0x5b, 0xac, 0xf7,
+	0xf9, 0x3d, 0xef, 0xeb, 0xc7, 0x8e, 0x41, 0x8b, 0x26, 0x5e, 0x42, 0xf2, 0x9f, 0x54, 0x78, 0x37,
+	0xc7, 0x5e, 0x44, 0x19, 0xe5, 0x31, 0x77, 0xb3, 0x3c, 0x15, 0x29, 0x6c, 0xd0, 0xc4, 0x55, 0x35,
+	0xf7, 0xe6, 0xb8, 0xd5, 0x8c, 0xd2, 0x28, 0x95, 0x05, 0xaf, 0xf8, 0x52, 0x9a, 0xd6, 0x7e, 0x89,
+	0xd7, 0x6a, 0x59, 0x42, 0x93, 0x6d, 0xd0, 0x78, 0xaf, 0x0c, 0xaf, 0x04, 0x11, 0x14, 0xfa, 0xa0,
+	0x9e, 0xe6, 0x21, 0xcd, 0xb9, 0x6d, 0x74, 0x36, 0x0e, 0xcd, 0x93, 0xa7, 0xee, 0x6a, 0x03, 0xf7,
+	0x73, 0x51, 0xf3, 0x77, 0x27, 0xd3, 0x76, 0xed, 0x6e, 0xda, 0xb6, 0xc6, 0x24, 0x19, 0xbc, 0x46,
+	0x0a, 0x40, 0x58, 0x93, 0xf0, 0x0b, 0x30, 0x15, 0x11, 0x84, 0x44, 0x10, 0xfb, 0x3f, 0x69, 0x64,
+	0x97, 0x8d, 0x3e, 0xca, 0xaf, 0x33, 0x22, 0x88, 0xdf, 0xd2, 0x6e, 0x50, 0xb9, 0xad, 0xa0, 0x08,
+	0x83, 0x64, 0xa9, 0x83, 0x1f, 0x80, 0xc5, 0xe8, 0x48, 0x04, 0xb2, 0x4b, 0x10, 0x87, 0xf6, 0x46,
+	0xc7, 0x38, 0xdc, 0xf4, 0x5f, 0xcc, 0xa6, 0x6d, 0xf3, 0x13, 0x1d, 0x09, 0x39, 0xdb, 0xc5, 0xd9,
+	0xdd, 0xb4, 0xdd, 0x54, 0x4e, 0x25, 0x35, 0xc2, 0x26, 0x5b, 0x8a, 0x42, 0x78, 0x0d, 0x4c, 0x2e,
+	0xd2, 0x2c, 0xd0, 0x9b, 0xdd, 0x94, 0x33, 0xee, 0x95, 0x67, 0xbc, 0x12, 0x69, 0xa6, 0x36, 0x5c,
+	0x19, 0x71, 0x85, 0x44, 0x18, 0xf0, 0x85, 0x8c, 0xc3, 0x53, 0x50, 0xcf, 0x48, 0x4e, 0x12, 0x6e,
+	0xff, 0xdf, 0x31, 0x0e, 0xcd, 0x93, 0x66, 0xd9, 0xf0, 0x52, 0xd6, 0xaa, 0xf1, 0x29, 0x02, 0x61,
+	0x8d, 0xc2, 0x77, 0x60, 0xab, 0x47, 0x58, 0x38, 0xa0, 0xdc, 0xae, 0x77, 0x36, 0xd6, 0x5d, 0x4e,
+	0x65, 0xd1, 0x7f, 0xa6, 0x5d, 0x76, 0x94, 0x8b, 0x46, 0x10, 0x5e, 0xc0, 0xc5, 0x51, 0x8a, 0x9c,
+	0x84, 0x94, 0xdb, 0x5b, 0x0f, 0x1d, 0xe5, 0x75, 0x51, 0xab, 0xce, 0xa2, 0x00, 0x84, 0x35, 0xb9,
+	0xcc, 0x5c, 0x2e, 0x8b, 0xcc, 0xb7, 0xcb, 0x99, 0x4b, 0x93, 0xb5, 0xcc, 0x17, 0x6a, 0x9d, 0xb9,
+	0x12, 0x85, 0x30, 0x06, 0x4f, 0x62, 0xc6, 0x45, 0x3e, 0x4c, 0x28, 0x13, 0x41, 0x3e, 0x2c, 0x76,
+	0xf8, 0x48, 0x8e, 0x76, 0x50, 0x1e, 0xed, 0x62, 0xa9, 0xc2, 0x85, 0xc8, 0x6f, 0xeb, 0x21, 0xf7,
+	0x54, 0x8f, 0xaa, 0x09, 0xc2, 0x8f, 0xe3, 0x32, 0x01, 0xbf, 0x03, 0xab, 0x18, 0x22, 0x66, 0x51,
+	0xd0, 0x27, 0x03, 0xc1, 0x6d, 0x20, 0xfb, 0xec, 0xaf, 0x47, 0x10, 0xb3, 0xe8, 0x9c, 0x0c, 0x84,
+	0xff, 0x5c, 0xf7, 0x68, 0xde, 0x07, 0xb1, 0xa4, 0x11, 0x6e, 0x88, 0x7b, 0x29, 0x2f, 0x2e, 0x4f,
+	0x96, 0xc7, 0x3d, 0x1a, 0x74, 0x09, 0x0b, 0xb9, 0x6d, 0x3e, 0x74, 0x79, 0x2e, 0x0b, 0x81, 0x4f,
+	0x58, 0x58, 0xbd, 0x3c, 0x2b, 0x24, 0xc2, 0x20, 0x5b, 0xc8, 0x38, 0xfc, 0x01, 0x76, 0xba, 0x44,
+	0xf4, 0xfa, 0x01, 0x19, 0xf6, 0x44, 0x9c, 0x32, 0x6e, 0x37, 0xa4, 0x71, 0xab, 0x6c, 0xec, 0x17,
+	0x9a, 0x37, 0x4a, 0xe2, 0x1f, 0x68, 0xef, 0x5d, 0xe5, 0x5d, 0xe6, 0x11, 0xb6, 0xba, 0x2b, 0x62,
+	0x0e, 0xbf, 0x01, 0x4b, 0x75, 0xef, 0xc7, 0x5c, 0xa4, 0xf9, 0xd8, 0xb6, 0xfe, 0xf1, 0x6b, 0x56,
+	0x42, 0x29, 0xc1, 0x08, 0x37, 0xe4, 0xfa, 0x5c, 0x2d, 0xfd, 0xb7, 0x93, 0x99, 0x63, 0xdc, 0xce,
+	0x1c, 0xe3, 0xcf, 0xcc, 0x31, 0x7e, 0xcd, 0x9d, 0xda, 0xed, 0xdc, 0xa9, 0xfd, 0x9e, 0x3b, 0xb5,
+	0xaf, 0x2f, 0xa3, 0x58, 0xf4, 0x87, 0x5d, 0xb7, 0x97, 0x26, 0x1e, 0x3d, 0x4a, 0x52, 0x46, 0xc7,
+	0x1e, 0x4d, 0x8e, 0x06, 0x34, 0x8c, 0x68, 0xee, 0x8d, 0x16, 0x6f, 0x93, 0x18, 0x67, 0x94, 0x77,
+	0xeb, 0xf2, 0x61, 0x7a, 0xf5, 0x77, 0x00, 0x46, 0x37, 0xa6, 0xaa, 0xf5, 0x04, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceHistory) > 0 {
+		for iNdEx := len(m.PriceHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.BatchAuctions) > 0 {
+		for iNdEx := len(m.BatchAuctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchAuctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.PriceBands) > 0 {
+		for iNdEx := len(m.PriceBands) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceBands[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.TradingHalts) > 0 {
+		for iNdEx := len(m.TradingHalts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TradingHalts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.InstrumentRules) > 0 {
+		for iNdEx := len(m.InstrumentRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InstrumentRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.NextTradeID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextTradeID))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Candles) > 0 {
+		for iNdEx := len(m.Candles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.StopOrders) > 0 {
+		for iNdEx := len(m.StopOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StopOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.NextOrderID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextOrderID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MarketData) > 0 {
+		for iNdEx := len(m.MarketData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MarketData) > 0 {
+		for _, e := range m.MarketData {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextOrderID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextOrderID))
+	}
+	if len(m.StopOrders) > 0 {
+		for _, e := range m.StopOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Candles) > 0 {
+		for _, e := range m.Candles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextTradeID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextTradeID))
+	}
+	if len(m.InstrumentRules) > 0 {
+		for _, e := range m.InstrumentRules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TradingHalts) > 0 {
+		for _, e := range m.TradingHalts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceBands) > 0 {
+		for _, e := range m.PriceBands {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BatchAuctions) > 0 {
+		for _, e := range m.BatchAuctions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceHistory) > 0 {
+		for _, e := range m.PriceHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketData = append(m.MarketData, MarketData{})
+			if err := m.MarketData[len(m.MarketData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextOrderID", wireType)
+			}
+			m.NextOrderID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextOrderID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StopOrders = append(m.StopOrders, StopOrder{})
+			if err := m.StopOrders[len(m.StopOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candles = append(m.Candles, Candle{})
+			if err := m.Candles[len(m.Candles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trades = append(m.Trades, Trade{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTradeID", wireType)
+			}
+			m.NextTradeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextTradeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstrumentRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstrumentRules = append(m.InstrumentRules, InstrumentRules{})
+			if err := m.InstrumentRules[len(m.InstrumentRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingHalts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradingHalts = append(m.TradingHalts, TradingHalt{})
+			if err := m.TradingHalts[len(m.TradingHalts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceBands", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceBands = append(m.PriceBands, PriceBand{})
+			if err := m.PriceBands[len(m.PriceBands)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchAuctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchAuctions = append(m.BatchAuctions, BatchAuction{})
+			if err := m.BatchAuctions[len(m.BatchAuctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceHistory = append(m.PriceHistory, MarketData{})
+			if err := m.PriceHistory[len(m.PriceHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
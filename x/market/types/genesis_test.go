@@ -0,0 +1,463 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package types
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+func TestGenesisValidation(t *testing.T) {
+	owner := sdk.AccAddress(tmrand.Bytes(sdk.AddrLen))
+	validOrder := func() Order {
+		o, err := NewOrder(time.Now(), TimeInForce_GoodTillCancel, coin("100eur"), coin("120usd"), owner, "A")
+		require.NoError(t, err)
+		o.ID = 3
+		return o
+	}
+	validStopOrder := func() StopOrder {
+		so, err := NewStopOrder(time.Now(), StopOrderType_Limit, TimeInForce_GoodTillCancel, coin("100eur"), coin("100usd"), sdk.NewDecWithPrec(11, 1), sdk.ZeroDec(), owner, "S")
+		require.NoError(t, err)
+		so.ID = 2
+		return so
+	}
+	price := sdk.NewDecWithPrec(12, 1)
+	hour := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	validCandle := func() Candle {
+		c := NewCandle("eur", "usd", CandleInterval_Hour, hour, price, sdk.NewInt(100))
+		c.AddTrade(sdk.NewDecWithPrec(11, 1), sdk.NewInt(50))
+		return c
+	}
+	validTrade := func(id uint64) Trade {
+		maker, taker := validOrder(), validOrder()
+		taker.ID = 4
+		tr := NewTrade(maker, taker, coin("50eur"), coin("60usd"), hour, 10)
+		tr.ID = id
+		return tr
+	}
+
+	specs := map[string]struct {
+		mutate func(gs *GenesisState)
+		expErr bool
+	}{
+		"default": {
+			mutate: func(gs *GenesisState) {},
+		},
+		"valid order": {
+			mutate: func(gs *GenesisState) {
+				gs.Orders = []Order{validOrder()}
+				gs.NextOrderID = 4
+			},
+		},
+		"order id not below next order id": {
+			mutate: func(gs *GenesisState) {
+				gs.Orders = []Order{validOrder()}
+				gs.NextOrderID = 3
+			},
+			expErr: true,
+		},
+		"duplicate order id": {
+			mutate: func(gs *GenesisState) {
+				o1, o2 := validOrder(), validOrder()
+				o2.ClientOrderID = "B"
+				gs.Orders = []Order{o1, o2}
+				gs.NextOrderID = 4
+			},
+			expErr: true,
+		},
+		"duplicate client order id": {
+			mutate: func(gs *GenesisState) {
+				o1, o2 := validOrder(), validOrder()
+				o2.ID = 2
+				gs.Orders = []Order{o1, o2}
+				gs.NextOrderID = 4
+			},
+			expErr: true,
+		},
+		"invalid owner": {
+			mutate: func(gs *GenesisState) {
+				o := validOrder()
+				o.Owner = "invalid"
+				gs.Orders = []Order{o}
+				gs.NextOrderID = 4
+			},
+			expErr: true,
+		},
+		"overfilled order": {
+			mutate: func(gs *GenesisState) {
+				o := validOrder()
+				o.SourceFilled = sdk.NewInt(50)
+				gs.Orders = []Order{o}
+				gs.NextOrderID = 4
+			},
+			expErr: true,
+		},
+		"filled order": {
+			mutate: func(gs *GenesisState) {
+				o := validOrder()
+				o.SourceFilled = o.Source.Amount
+				o.SourceRemaining = sdk.ZeroInt()
+				gs.Orders = []Order{o}
+				gs.NextOrderID = 4
+			},
+			expErr: true,
+		},
+		"valid stop order": {
+			mutate: func(gs *GenesisState) {
+				gs.Orders = []Order{validOrder()}
+				gs.StopOrders = []StopOrder{validStopOrder()}
+				gs.NextOrderID = 4
+			},
+		},
+		"stop order id shared with order": {
+			mutate: func(gs *GenesisState) {
+				so := validStopOrder()
+				so.ID = 3
+				gs.Orders = []Order{validOrder()}
+				gs.StopOrders = []StopOrder{so}
+				gs.NextOrderID = 4
+			},
+			expErr: true,
+		},
+		"stop order client order id shared with order": {
+			mutate: func(gs *GenesisState) {
+				so := validStopOrder()
+				so.ClientOrderID = "A"
+				gs.Orders = []Order{validOrder()}
+				gs.StopOrders = []StopOrder{so}
+				gs.NextOrderID = 4
+			},
+			expErr: true,
+		},
+		"invalid stop price": {
+			mutate: func(gs *GenesisState) {
+				so := validStopOrder()
+				so.StopPrice = sdk.ZeroDec()
+				gs.StopOrders = []StopOrder{so}
+				gs.NextOrderID = 4
+			},
+			expErr: true,
+		},
+		"valid market data": {
+			mutate: func(gs *GenesisState) {
+				gs.MarketData = []MarketData{{Source: "eur", Destination: "usd", LastPrice: &price}, {Source: "usd", Destination: "eur"}}
+			},
+		},
+		"duplicate market data": {
+			mutate: func(gs *GenesisState) {
+				gs.MarketData = []MarketData{{Source: "eur", Destination: "usd"}, {Source: "eur", Destination: "usd"}}
+			},
+			expErr: true,
+		},
+		"invalid market data instrument": {
+			mutate: func(gs *GenesisState) {
+				gs.MarketData = []MarketData{{Source: "eur", Destination: "eur"}}
+			},
+			expErr: true,
+		},
+		"negative last price": {
+			mutate: func(gs *GenesisState) {
+				negative := price.Neg()
+				gs.MarketData = []MarketData{{Source: "eur", Destination: "usd", LastPrice: &negative}}
+			},
+			expErr: true,
+		},
+		"negative cumulative price": {
+			mutate: func(gs *GenesisState) {
+				gs.MarketData = []MarketData{{Source: "eur", Destination: "usd", LastPrice: &price, CumulativePrice: price.Neg()}}
+			},
+			expErr: true,
+		},
+		"valid price history": {
+			mutate: func(gs *GenesisState) {
+				later := hour.Add(time.Minute)
+				gs.PriceHistory = []MarketData{
+					{Source: "eur", Destination: "usd", LastPrice: &price, Timestamp: &hour, CumulativePrice: sdk.ZeroDec()},
+					{Source: "eur", Destination: "usd", LastPrice: &price, Timestamp: &later, CumulativePrice: price.MulInt64(60)},
+				}
+			},
+		},
+		"duplicate price history": {
+			mutate: func(gs *GenesisState) {
+				md := MarketData{Source: "eur", Destination: "usd", LastPrice: &price, Timestamp: &hour, CumulativePrice: sdk.ZeroDec()}
+				gs.PriceHistory = []MarketData{md, md}
+			},
+			expErr: true,
+		},
+		"price history without price": {
+			mutate: func(gs *GenesisState) {
+				gs.PriceHistory = []MarketData{{Source: "eur", Destination: "usd", Timestamp: &hour, CumulativePrice: sdk.ZeroDec()}}
+			},
+			expErr: true,
+		},
+		"zero price history retention": {
+			mutate: func(gs *GenesisState) {
+				gs.Params.PriceHistoryRetention = 0
+			},
+			expErr: true,
+		},
+		"zero candle retention": {
+			mutate: func(gs *GenesisState) {
+				gs.Params.CandleRetention = 0
+			},
+			expErr: true,
+		},
+		"candle retention above maximum": {
+			mutate: func(gs *GenesisState) {
+				gs.Params.CandleRetention = MaxCandleRetention + 1
+			},
+			expErr: true,
+		},
+		"maximum candle retention": {
+			mutate: func(gs *GenesisState) {
+				gs.Params.CandleRetention = MaxCandleRetention
+			},
+		},
+		"valid fees": {
+			mutate: func(gs *GenesisState) {
+				gs.Params.MakerFee, gs.Params.TakerFee = 10, 20
+				gs.Params.InstrumentFees = []InstrumentFees{{Source: "eur", Destination: "usd", MakerFee: 0, TakerFee: 5}}
+			},
+		},
+		"fee rate above maximum": {
+			mutate: func(gs *GenesisState) {
+				gs.Params.TakerFee = MaxFeeRate + 1
+			},
+			expErr: true,
+		},
+		"max hops above maximum": {
+			mutate: func(gs *GenesisState) {
+				gs.Params.MaxHops = MaxMaxHops + 1
+			},
+			expErr: true,
+		},
+		"no hops": {
+			mutate: func(gs *GenesisState) {
+				gs.Params.MaxHops = 0
+			},
+			expErr: true,
+		},
+		"valid order limits": {
+			mutate: func(gs *GenesisState) {
+				gs.Params.MaxOpenOrders, gs.Params.MaxInstrumentOpenOrders = 0, 10
+				gs.Params.AccountOrderLimits = []AccountOrderLimits{{Account: sdk.AccAddress(tmrand.Bytes(sdk.AddrLen)).String(), MaxOpenOrders: 1000}}
+			},
+		},
+		"invalid order limits account": {
+			mutate: func(gs *GenesisState) {
+				gs.Params.AccountOrderLimits = []AccountOrderLimits{{Account: "invalid"}}
+			},
+			expErr: true,
+		},
+		"duplicate order limits": {
+			mutate: func(gs *GenesisState) {
+				acc := sdk.AccAddress(tmrand.Bytes(sdk.AddrLen)).String()
+				gs.Params.AccountOrderLimits = []AccountOrderLimits{{Account: acc, MaxOpenOrders: 10}, {Account: acc}}
+			},
+			expErr: true,
+		},
+		"duplicate instrument fees": {
+			mutate: func(gs *GenesisState) {
+				gs.Params.InstrumentFees = []InstrumentFees{
+					{Source: "eur", Destination: "usd"},
+					{Source: "usd", Destination: "eur"},
+				}
+			},
+			expErr: true,
+		},
+		"valid instrument rules": {
+			mutate: func(gs *GenesisState) {
+				gs.InstrumentRules = []InstrumentRules{
+					NewInstrumentRules("eur", "usd", sdk.NewDecWithPrec(1, 2), sdk.NewInt(100), sdk.NewInt(10)),
+					NewInstrumentRules("usd", "eur", sdk.ZeroDec(), sdk.NewInt(100), sdk.ZeroInt()),
+				}
+			},
+		},
+		"negative lot size": {
+			mutate: func(gs *GenesisState) {
+				gs.InstrumentRules = []InstrumentRules{
+					NewInstrumentRules("eur", "usd", sdk.ZeroDec(), sdk.ZeroInt(), sdk.NewInt(-1)),
+				}
+			},
+			expErr: true,
+		},
+		"duplicate instrument rules": {
+			mutate: func(gs *GenesisState) {
+				r := NewInstrumentRules("eur", "usd", sdk.NewDecWithPrec(1, 2), sdk.ZeroInt(), sdk.ZeroInt())
+				gs.InstrumentRules = []InstrumentRules{r, r}
+			},
+			expErr: true,
+		},
+		"valid trading halts": {
+			mutate: func(gs *GenesisState) {
+				gs.TradingHalts = []TradingHalt{NewDenomHalt("eur"), NewInstrumentHalt("usd", "chf")}
+			},
+		},
+		"invalid trading halt": {
+			mutate: func(gs *GenesisState) {
+				gs.TradingHalts = []TradingHalt{{Denom: "eur", Source: "eur", Destination: "usd"}}
+			},
+			expErr: true,
+		},
+		"duplicate trading halt": {
+			mutate: func(gs *GenesisState) {
+				gs.TradingHalts = []TradingHalt{NewInstrumentHalt("usd", "chf"), NewInstrumentHalt("chf", "usd")}
+			},
+			expErr: true,
+		},
+		"valid price bands": {
+			mutate: func(gs *GenesisState) {
+				gs.PriceBands = []PriceBand{
+					NewPriceBand("eur", "usd", sdk.NewDecWithPrec(1, 1), sdk.ZeroDec(), 0, 0, 0),
+					NewPriceBand("usd", "chf", sdk.NewDecWithPrec(5, 2), sdk.OneDec(), 3, time.Minute, time.Hour),
+				}
+			},
+		},
+		"invalid price band": {
+			mutate: func(gs *GenesisState) {
+				gs.PriceBands = []PriceBand{NewPriceBand("eur", "usd", sdk.NewDec(-1), sdk.ZeroDec(), 0, 0, 0)}
+			},
+			expErr: true,
+		},
+		"duplicate price band": {
+			mutate: func(gs *GenesisState) {
+				gs.PriceBands = []PriceBand{
+					NewPriceBand("eur", "usd", sdk.NewDecWithPrec(1, 1), sdk.ZeroDec(), 0, 0, 0),
+					NewPriceBand("usd", "eur", sdk.NewDecWithPrec(1, 1), sdk.ZeroDec(), 0, 0, 0),
+				}
+			},
+			expErr: true,
+		},
+		"valid batch auctions": {
+			mutate: func(gs *GenesisState) {
+				gs.BatchAuctions = []BatchAuction{NewBatchAuction("eur", "usd"), NewBatchAuction("usd", "chf")}
+			},
+		},
+		"invalid batch auction": {
+			mutate: func(gs *GenesisState) {
+				gs.BatchAuctions = []BatchAuction{NewBatchAuction("eur", "eur")}
+			},
+			expErr: true,
+		},
+		"duplicate batch auction": {
+			mutate: func(gs *GenesisState) {
+				gs.BatchAuctions = []BatchAuction{NewBatchAuction("eur", "usd"), NewBatchAuction("usd", "eur")}
+			},
+			expErr: true,
+		},
+		"valid candles": {
+			mutate: func(gs *GenesisState) {
+				c1, c2 := validCandle(), validCandle()
+				c2.Interval = CandleInterval_Day
+				c2.Start = CandleInterval_Day.BucketStart(hour)
+				gs.Candles = []Candle{c1, c2}
+			},
+		},
+		"duplicate candle": {
+			mutate: func(gs *GenesisState) {
+				gs.Candles = []Candle{validCandle(), validCandle()}
+			},
+			expErr: true,
+		},
+		"unaligned candle": {
+			mutate: func(gs *GenesisState) {
+				c := validCandle()
+				c.Start = hour.Add(time.Minute)
+				gs.Candles = []Candle{c}
+			},
+			expErr: true,
+		},
+		"inconsistent candle prices": {
+			mutate: func(gs *GenesisState) {
+				c := validCandle()
+				c.High = c.Low.Sub(sdk.OneDec())
+				gs.Candles = []Candle{c}
+			},
+			expErr: true,
+		},
+		"unspecified candle interval": {
+			mutate: func(gs *GenesisState) {
+				c := validCandle()
+				c.Interval = CandleInterval_Unspecified
+				gs.Candles = []Candle{c}
+			},
+			expErr: true,
+		},
+		"valid trades": {
+			mutate: func(gs *GenesisState) {
+				gs.Trades = []Trade{validTrade(0), validTrade(1)}
+				gs.NextTradeID = 2
+			},
+		},
+		"trade id not below next trade id": {
+			mutate: func(gs *GenesisState) {
+				gs.Trades = []Trade{validTrade(2)}
+				gs.NextTradeID = 2
+			},
+			expErr: true,
+		},
+		"duplicate trade id": {
+			mutate: func(gs *GenesisState) {
+				gs.Trades = []Trade{validTrade(1), validTrade(1)}
+				gs.NextTradeID = 2
+			},
+			expErr: true,
+		},
+		"invalid trade": {
+			mutate: func(gs *GenesisState) {
+				tr := validTrade(0)
+				tr.Price = sdk.ZeroDec()
+				gs.Trades = []Trade{tr}
+				gs.NextTradeID = 1
+			},
+			expErr: true,
+		},
+	}
+
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gs := DefaultGenesisState()
+			spec.mutate(gs)
+
+			err := gs.Validate()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestOrderJSONRoundTrip(t *testing.T) {
+	order1, err := NewOrder(time.Now(), TimeInForce_ImmediateOrCancel, coin("100eur"), coin("120usd"), []byte("acc1"), "A")
+	require.NoError(t, err)
+	order1.ID = 42
+	order1.SourceRemaining = sdk.NewInt(40)
+	order1.SourceFilled = sdk.NewInt(60)
+	order1.DestinationFilled = sdk.NewInt(72)
+
+	bz, err := json.Marshal(order1)
+	require.NoError(t, err)
+
+	var order2 Order
+	require.NoError(t, json.Unmarshal(bz, &order2))
+
+	require.Equal(t, order1.ID, order2.ID)
+	require.Equal(t, order1.TimeInForce, order2.TimeInForce)
+	require.Equal(t, order1.Owner, order2.Owner)
+	require.Equal(t, order1.ClientOrderID, order2.ClientOrderID)
+	require.Equal(t, order1.Source, order2.Source)
+	require.Equal(t, order1.Destination, order2.Destination)
+	require.True(t, order1.SourceRemaining.Equal(order2.SourceRemaining))
+	require.True(t, order1.SourceFilled.Equal(order2.SourceFilled))
+	require.True(t, order1.DestinationFilled.Equal(order2.DestinationFilled))
+	require.True(t, order1.Created.Equal(order2.Created))
+}
@@ -0,0 +1,85 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDenomHalt halts every instrument involving denom.
+func NewDenomHalt(denom string) TradingHalt {
+	return TradingHalt{Denom: denom}
+}
+
+// NewInstrumentHalt halts the instrument between src and dst in both directions.
+func NewInstrumentHalt(src, dst string) TradingHalt {
+	return TradingHalt{Source: src, Destination: dst}
+}
+
+func (h TradingHalt) Validate() error {
+	if h.IsDenomHalt() {
+		if h.Source != "" || h.Destination != "" {
+			return fmt.Errorf("a halt applies to either a denomination or an instrument")
+		}
+
+		if err := sdk.ValidateDenom(h.Denom); err != nil {
+			return fmt.Errorf("invalid denomination: %w", err)
+		}
+
+		return nil
+	}
+
+	if err := sdk.ValidateDenom(h.Source); err != nil {
+		return fmt.Errorf("invalid source denomination: %w", err)
+	}
+
+	if err := sdk.ValidateDenom(h.Destination); err != nil {
+		return fmt.Errorf("invalid destination denomination: %w", err)
+	}
+
+	if h.Source == h.Destination {
+		return fmt.Errorf("'%v/%v' is not a valid instrument", h.Source, h.Destination)
+	}
+
+	return nil
+}
+
+func (h TradingHalt) IsDenomHalt() bool {
+	return h.Denom != ""
+}
+
+// IsActive reports whether the halt applies at blockTime. Halts without an expiry time last until trading is resumed.
+func (h TradingHalt) IsActive(blockTime time.Time) bool {
+	return h.ExpireTime == nil || blockTime.Before(*h.ExpireTime)
+}
+
+// Matches reports whether the halt applies to the instrument from src to dst.
+func (h TradingHalt) Matches(src, dst string) bool {
+	if h.IsDenomHalt() {
+		return h.Denom == src || h.Denom == dst
+	}
+
+	return (h.Source == src && h.Destination == dst) || (h.Source == dst && h.Destination == src)
+}
+
+// Key returns the store key of the halt. Both directions of an instrument share a key.
+func (h TradingHalt) Key() []byte {
+	if h.IsDenomHalt() {
+		return GetDenomHaltKey(h.Denom)
+	}
+
+	return GetInstrumentHaltKey(h.Source, h.Destination)
+}
+
+func (h TradingHalt) String() string {
+	if h.IsDenomHalt() {
+		return h.Denom
+	}
+
+	return fmt.Sprintf("%v/%v", h.Source, h.Destination)
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"strings"
+	"time"
 
 	"github.com/e-money/em-ledger/util"
 )
@@ -30,6 +31,8 @@ const (
 var (
 	// Parameter key for global order IDs
 	globalOrderIDKey = []byte("globalOrderID")
+	// Parameter key for global trade IDs
+	globalTradeIDKey = []byte("globalTradeID")
 
 	// IAVL Store prefixes
 	keysPrefix = []byte{0x01}
@@ -37,12 +40,56 @@ var (
 	marketDataPrefix = []byte{0x02}
 	priorityPrefix   = []byte{0x03}
 	ownerPrefix      = []byte{0x04}
+
+	expireTimePrefix   = []byte{0x05}
+	expireHeightPrefix = []byte{0x06}
+
+	stopOwnerPrefix     = []byte{0x07}
+	stopTriggerPrefix   = []byte{0x08}
+	stopTriggeredPrefix = []byte{0x09}
+
+	candlePrefix = []byte{0x0A}
+
+	tradePrefix           = []byte{0x0B}
+	tradeInstrumentPrefix = []byte{0x0C}
+	tradeAccountPrefix    = []byte{0x0D}
+
+	instrumentRulesPrefix = []byte{0x0E}
+
+	orderIDPrefix = []byte{0x0F}
+
+	denomHaltPrefix      = []byte{0x10}
+	instrumentHaltPrefix = []byte{0x11}
+
+	priceBandPrefix         = []byte{0x12}
+	priceBandBreachesPrefix = []byte{0x13}
+
+	batchAuctionPrefix = []byte{0x14}
+
+	priceHistoryPrefix = []byte{0x15}
+
+	ownerDenomPrefix = []byte{0x16}
 )
 
 /*
  - Priority-prefix: Orders sorted by SRC/DST/Price/orderID
  - Owner-prefix : Order sorted by owner-account/ClientOrderId
  - marketData-Prefix : Last traded price sorted by SRC/DST
+ - expireTime-prefix : Owner key of GTT orders sorted by expiry time/orderID
+ - expireHeight-prefix : Owner key of GTB orders sorted by expiry height/orderID
+ - stopOwner-prefix : Stop orders sorted by owner-account/ClientOrderId
+ - stopTrigger-prefix : Stop owner key of stop orders sorted by SRC/DST/StopPrice/stopOrderID
+ - stopTriggered-prefix : Stop owner key of triggered stop orders awaiting execution sorted by stopOrderID
+ - candle-prefix : Candles sorted by SRC/DST/Interval/Start
+ - trade-prefix : Trade log sorted by tradeID
+ - tradeInstrument-prefix : Trade ids sorted by the maker's SRC/DST/tradeID
+ - tradeAccount-prefix : Trade ids sorted by maker and taker account/tradeID
+ - instrumentRules-prefix : Tick size, minimum order size and lot size sorted by SRC/DST
+ - orderID-prefix : Owner key of resting orders sorted by orderID
+ - denomHalt-prefix : Trading halts of every instrument involving a denomination sorted by denomination
+ - instrumentHalt-prefix : Trading halts of single instruments sorted by the alphabetically ordered pair of denominations
+ - priceBand-prefix : Price bands sorted by the alphabetically ordered pair of denominations
+ - priceBandBreaches-prefix : Recent price band breaches sorted by the alphabetically ordered pair of denominations
 */
 
 func GetMarketDataPrefix() []byte {
@@ -58,6 +105,10 @@ func GetOrderIDGeneratorKey() []byte {
 	return append(keysPrefix, globalOrderIDKey...)
 }
 
+func GetTradeIDGeneratorKey() []byte {
+	return append(keysPrefix, globalTradeIDKey...)
+}
+
 func GetPriorityKeyBySrcAndDst(src, dst string) []byte {
 	instr := fmt.Sprintf("%v/%v", src, dst)
 	return append(priorityPrefix, []byte(instr)...)
@@ -115,3 +166,198 @@ func GetOwnerKey(acc, clientOrderId string) []byte {
 	res = append(res, []byte(clientOrderId)...)
 	return res
 }
+
+func GetExpireTimePrefix() []byte {
+	return expireTimePrefix
+}
+
+// GetExpireTimeKeyByTime returns the prefix of all GTT orders expiring at the given time.
+func GetExpireTimeKeyByTime(expireTime time.Time) []byte {
+	return append(GetExpireTimePrefix(), sdk.FormatTimeBytes(expireTime)...)
+}
+
+func GetExpireTimeKey(expireTime time.Time, orderId uint64) []byte {
+	return append(GetExpireTimeKeyByTime(expireTime), util.Uint64ToBytes(orderId)...)
+}
+
+func GetExpireHeightPrefix() []byte {
+	return expireHeightPrefix
+}
+
+// GetExpireHeightKeyByHeight returns the prefix of all GTB orders expiring at the given height.
+func GetExpireHeightKeyByHeight(height int64) []byte {
+	return append(GetExpireHeightPrefix(), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func GetExpireHeightKey(height int64, orderId uint64) []byte {
+	return append(GetExpireHeightKeyByHeight(height), util.Uint64ToBytes(orderId)...)
+}
+
+func GetStopOwnersPrefix() []byte {
+	return stopOwnerPrefix
+}
+
+func GetStopOwnerKey(acc, clientOrderId string) []byte {
+	res := append(GetStopOwnersPrefix(), []byte(acc)...)
+	res = append(res, []byte(clientOrderId)...)
+	return res
+}
+
+// GetStopTriggerKeyByInstrument returns the prefix of all stop orders of an instrument, sorted by stop price.
+func GetStopTriggerKeyByInstrument(src, dst string) []byte {
+	instr := fmt.Sprintf("%v/%v/", src, dst)
+	return append(stopTriggerPrefix, []byte(instr)...)
+}
+
+// GetStopTriggerKeyByPrice returns the first key of the stop orders in an instrument with a stop price of at least price.
+func GetStopTriggerKeyByPrice(src, dst string, price sdk.Dec) []byte {
+	return append(GetStopTriggerKeyByInstrument(src, dst), sdk.SortableDecBytes(price)...)
+}
+
+func GetStopTriggerKey(src, dst string, stopPrice sdk.Dec, stopOrderId uint64) []byte {
+	return append(GetStopTriggerKeyByPrice(src, dst, stopPrice), util.Uint64ToBytes(stopOrderId)...)
+}
+
+func GetStopTriggeredPrefix() []byte {
+	return stopTriggeredPrefix
+}
+
+func GetStopTriggeredKey(stopOrderId uint64) []byte {
+	return append(GetStopTriggeredPrefix(), util.Uint64ToBytes(stopOrderId)...)
+}
+
+func GetCandlePrefix() []byte {
+	return candlePrefix
+}
+
+// GetCandleKeyByInstrument returns the prefix of all candles of an instrument with the given interval, sorted by start.
+func GetCandleKeyByInstrument(src, dst string, interval CandleInterval) []byte {
+	instr := fmt.Sprintf("%v/%v/", src, dst)
+	res := append(GetCandlePrefix(), []byte(instr)...)
+	return append(res, byte(interval))
+}
+
+func GetCandleKey(src, dst string, interval CandleInterval, start time.Time) []byte {
+	return append(GetCandleKeyByInstrument(src, dst, interval), sdk.FormatTimeBytes(start)...)
+}
+
+func GetTradePrefix() []byte {
+	return tradePrefix
+}
+
+func GetTradeKey(tradeId uint64) []byte {
+	return append(GetTradePrefix(), util.Uint64ToBytes(tradeId)...)
+}
+
+// GetTradeKeyByInstrument returns the prefix of the trades in which the maker sold src for dst.
+func GetTradeKeyByInstrument(src, dst string) []byte {
+	instr := fmt.Sprintf("%v/%v/", src, dst)
+	return append(tradeInstrumentPrefix, []byte(instr)...)
+}
+
+func GetTradeInstrumentKey(src, dst string, tradeId uint64) []byte {
+	return append(GetTradeKeyByInstrument(src, dst), util.Uint64ToBytes(tradeId)...)
+}
+
+// GetTradeKeyByAccount returns the prefix of the trades in which the account was either maker or taker.
+func GetTradeKeyByAccount(acc string) []byte {
+	res := append(tradeAccountPrefix, []byte(acc)...)
+	return append(res, '/')
+}
+
+func GetTradeAccountKey(acc string, tradeId uint64) []byte {
+	return append(GetTradeKeyByAccount(acc), util.Uint64ToBytes(tradeId)...)
+}
+
+func GetInstrumentRulesPrefix() []byte {
+	return instrumentRulesPrefix
+}
+
+func GetInstrumentRulesKey(src, dst string) []byte {
+	instr := fmt.Sprintf("%v/%v", src, dst)
+	return append(GetInstrumentRulesPrefix(), []byte(instr)...)
+}
+
+func GetOrderIDPrefix() []byte {
+	return orderIDPrefix
+}
+
+func GetOrderIDKey(orderId uint64) []byte {
+	return append(GetOrderIDPrefix(), util.Uint64ToBytes(orderId)...)
+}
+
+func GetDenomHaltPrefix() []byte {
+	return denomHaltPrefix
+}
+
+func GetDenomHaltKey(denom string) []byte {
+	return append(GetDenomHaltPrefix(), []byte(denom)...)
+}
+
+func GetInstrumentHaltPrefix() []byte {
+	return instrumentHaltPrefix
+}
+
+// GetInstrumentHaltKey returns the same key for both directions of an instrument.
+func GetInstrumentHaltKey(src, dst string) []byte {
+	return append(GetInstrumentHaltPrefix(), []byte(orderedInstrument(src, dst))...)
+}
+
+func GetPriceBandPrefix() []byte {
+	return priceBandPrefix
+}
+
+// GetPriceBandKey returns the same key for both directions of an instrument.
+func GetPriceBandKey(src, dst string) []byte {
+	return append(GetPriceBandPrefix(), []byte(orderedInstrument(src, dst))...)
+}
+
+// GetPriceBandBreachesKey returns the same key for both directions of an instrument.
+func GetPriceBandBreachesKey(src, dst string) []byte {
+	return append(priceBandBreachesPrefix, []byte(orderedInstrument(src, dst))...)
+}
+
+func GetBatchAuctionPrefix() []byte {
+	return batchAuctionPrefix
+}
+
+// GetBatchAuctionKey returns the same key for both directions of an instrument.
+func GetBatchAuctionKey(src, dst string) []byte {
+	return append(GetBatchAuctionPrefix(), []byte(orderedInstrument(src, dst))...)
+}
+
+func GetPriceHistoryPrefix() []byte {
+	return priceHistoryPrefix
+}
+
+func GetPriceHistoryKeyByInstrument(src, dst string) []byte {
+	instr := fmt.Sprintf("%v/%v/", src, dst)
+	return append(GetPriceHistoryPrefix(), []byte(instr)...)
+}
+
+func GetPriceHistoryKey(src, dst string, timestamp time.Time) []byte {
+	return append(GetPriceHistoryKeyByInstrument(src, dst), sdk.FormatTimeBytes(timestamp)...)
+}
+
+func orderedInstrument(src, dst string) string {
+	if dst < src {
+		src, dst = dst, src
+	}
+
+	return fmt.Sprintf("%v/%v", src, dst)
+}
+
+func GetOwnerDenomPrefix() []byte {
+	return ownerDenomPrefix
+}
+
+// GetOwnerDenomKeyByDenom returns the prefix of the resting orders of the account that sell denom, sorted by order id.
+// Denominations may contain slashes, so the prefix also covers denominations that start with denom followed by a slash.
+func GetOwnerDenomKeyByDenom(acc, denom string) []byte {
+	key := fmt.Sprintf("%v/%v/", acc, denom)
+	return append(GetOwnerDenomPrefix(), []byte(key)...)
+}
+
+func GetOwnerDenomKey(acc, denom string, orderId uint64) []byte {
+	return append(GetOwnerDenomKeyByDenom(acc, denom), util.Uint64ToBytes(orderId)...)
+}
@@ -10,6 +10,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
 	_ "github.com/golang/protobuf/ptypes/timestamp"
 	io "io"
 	math "math"
@@ -36,6 +37,8 @@ const (
 	TimeInForce_GoodTillCancel    TimeInForce = 1
 	TimeInForce_ImmediateOrCancel TimeInForce = 2
 	TimeInForce_FillOrKill        TimeInForce = 3
+	TimeInForce_GoodTillTime      TimeInForce = 4
+	TimeInForce_GoodTillBlock     TimeInForce = 5
 )
 
 var TimeInForce_name = map[int32]string{
@@ -43,6 +46,8 @@ var TimeInForce_name = map[int32]string{
 	1: "TIME_IN_FORCE_GOOD_TILL_CANCEL",
 	2: "TIME_IN_FORCE_IMMEDIATE_OR_CANCEL",
 	3: "TIME_IN_FORCE_FILL_OR_KILL",
+	4: "TIME_IN_FORCE_GOOD_TILL_TIME",
+	5: "TIME_IN_FORCE_GOOD_TILL_BLOCK",
 }
 
 var TimeInForce_value = map[string]int32{
@@ -50,6 +55,8 @@ var TimeInForce_value = map[string]int32{
 	"TIME_IN_FORCE_GOOD_TILL_CANCEL":    1,
 	"TIME_IN_FORCE_IMMEDIATE_OR_CANCEL": 2,
 	"TIME_IN_FORCE_FILL_OR_KILL":        3,
+	"TIME_IN_FORCE_GOOD_TILL_TIME":      4,
+	"TIME_IN_FORCE_GOOD_TILL_BLOCK":     5,
 }
 
 func (x TimeInForce) String() string {
@@ -60,6 +67,144 @@ func (TimeInForce) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_888ec7fc0f7580e2, []int{0}
 }
 
+// PostOnlyMode determines how an order that must rest on the book is treated
+// when it would cross the spread on arrival.
+type PostOnlyMode int32
+
+const (
+	PostOnlyMode_None PostOnlyMode = 0
+	// Reject the order if it would match a resting order.
+	PostOnlyMode_Reject PostOnlyMode = 1
+	// Reprice the order one unit of destination away from the best crossing
+	// price so that it rests on the book.
+	PostOnlyMode_Reprice PostOnlyMode = 2
+)
+
+var PostOnlyMode_name = map[int32]string{
+	0: "POST_ONLY_MODE_NONE",
+	1: "POST_ONLY_MODE_REJECT",
+	2: "POST_ONLY_MODE_REPRICE",
+}
+
+var PostOnlyMode_value = map[string]int32{
+	"POST_ONLY_MODE_NONE":    0,
+	"POST_ONLY_MODE_REJECT":  1,
+	"POST_ONLY_MODE_REPRICE": 2,
+}
+
+func (x PostOnlyMode) String() string {
+	return proto.EnumName(PostOnlyMode_name, int32(x))
+}
+
+func (PostOnlyMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{1}
+}
+
+// SelfTradePrevention determines what happens when an order would match a
+// resting order of the same owner.
+type SelfTradePrevention int32
+
+const (
+	SelfTradePrevention_None SelfTradePrevention = 0
+	// Cancel the remainder of the incoming order.
+	SelfTradePrevention_CancelNewest SelfTradePrevention = 1
+	// Cancel the resting order and continue matching.
+	SelfTradePrevention_CancelOldest SelfTradePrevention = 2
+	// Cancel both the resting order and the remainder of the incoming order.
+	SelfTradePrevention_CancelBoth SelfTradePrevention = 3
+	// Reduce both orders by the amounts that would have traded without
+	// trading them, canceling the order that is used up.
+	SelfTradePrevention_DecrementAndCancel SelfTradePrevention = 4
+)
+
+var SelfTradePrevention_name = map[int32]string{
+	0: "SELF_TRADE_PREVENTION_NONE",
+	1: "SELF_TRADE_PREVENTION_CANCEL_NEWEST",
+	2: "SELF_TRADE_PREVENTION_CANCEL_OLDEST",
+	3: "SELF_TRADE_PREVENTION_CANCEL_BOTH",
+	4: "SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL",
+}
+
+var SelfTradePrevention_value = map[string]int32{
+	"SELF_TRADE_PREVENTION_NONE":                 0,
+	"SELF_TRADE_PREVENTION_CANCEL_NEWEST":        1,
+	"SELF_TRADE_PREVENTION_CANCEL_OLDEST":        2,
+	"SELF_TRADE_PREVENTION_CANCEL_BOTH":          3,
+	"SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL": 4,
+}
+
+func (x SelfTradePrevention) String() string {
+	return proto.EnumName(SelfTradePrevention_name, int32(x))
+}
+
+func (SelfTradePrevention) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{2}
+}
+
+// StopOrderType determines the kind of order that is sent to the market when a
+// stop order is triggered.
+type StopOrderType int32
+
+const (
+	StopOrderType_Unspecified StopOrderType = 0
+	// Send a limit order with the stop order's source and destination.
+	StopOrderType_Limit StopOrderType = 1
+	// Send a market order using the last traded price and maximum slippage.
+	StopOrderType_Market StopOrderType = 2
+)
+
+var StopOrderType_name = map[int32]string{
+	0: "STOP_ORDER_TYPE_UNSPECIFIED",
+	1: "STOP_ORDER_TYPE_LIMIT",
+	2: "STOP_ORDER_TYPE_MARKET",
+}
+
+var StopOrderType_value = map[string]int32{
+	"STOP_ORDER_TYPE_UNSPECIFIED": 0,
+	"STOP_ORDER_TYPE_LIMIT":       1,
+	"STOP_ORDER_TYPE_MARKET":      2,
+}
+
+func (x StopOrderType) String() string {
+	return proto.EnumName(StopOrderType_name, int32(x))
+}
+
+func (StopOrderType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{3}
+}
+
+// CandleInterval is the length of the time bucket aggregated by a candle.
+type CandleInterval int32
+
+const (
+	CandleInterval_Unspecified CandleInterval = 0
+	CandleInterval_Minute      CandleInterval = 1
+	CandleInterval_Hour        CandleInterval = 2
+	CandleInterval_Day         CandleInterval = 3
+)
+
+var CandleInterval_name = map[int32]string{
+	0: "CANDLE_INTERVAL_UNSPECIFIED",
+	1: "CANDLE_INTERVAL_MINUTE",
+	2: "CANDLE_INTERVAL_HOUR",
+	3: "CANDLE_INTERVAL_DAY",
+}
+
+var CandleInterval_value = map[string]int32{
+	"CANDLE_INTERVAL_UNSPECIFIED": 0,
+	"CANDLE_INTERVAL_MINUTE":      1,
+	"CANDLE_INTERVAL_HOUR":        2,
+	"CANDLE_INTERVAL_DAY":         3,
+}
+
+func (x CandleInterval) String() string {
+	return proto.EnumName(CandleInterval_name, int32(x))
+}
+
+func (CandleInterval) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{4}
+}
+
 type Instrument struct {
 	Source      string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
 	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
@@ -123,6 +268,21 @@ type Order struct {
 	Destination       types.Coin                             `protobuf:"bytes,8,opt,name=destination,proto3" json:"destination" yaml:"destination"`
 	DestinationFilled github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=destination_filled,json=destinationFilled,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"destination_filled" yaml:"destination_filled"`
 	Created           time.Time                              `protobuf:"bytes,10,opt,name=created,proto3,stdtime" json:"created" yaml:"created"`
+	// Block time at which a GoodTillTime order expires.
+	ExpireTime *time.Time `protobuf:"bytes,11,opt,name=expire_time,json=expireTime,proto3,stdtime" json:"expire_time,omitempty" yaml:"expire_time"`
+	// Block height at which a GoodTillBlock order expires.
+	ExpireHeight        int64               `protobuf:"varint,12,opt,name=expire_height,json=expireHeight,proto3" json:"expire_height,omitempty" yaml:"expire_height"`
+	PostOnly            PostOnlyMode        `protobuf:"varint,13,opt,name=post_only,json=postOnly,proto3,enum=em.market.v1.PostOnlyMode" json:"post_only,omitempty" yaml:"post_only"`
+	SelfTradePrevention SelfTradePrevention `protobuf:"varint,14,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=em.market.v1.SelfTradePrevention" json:"self_trade_prevention,omitempty" yaml:"self_trade_prevention"`
+	// Amount of the source denomination shown on the book at a time. Zero
+	// shows the entire remainder of the order.
+	DisplayQuantity github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,15,opt,name=display_quantity,json=displayQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"display_quantity" yaml:"display_quantity"`
+	// Unfilled part of the slice currently shown on the book.
+	DisplayRemaining github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,16,opt,name=display_remaining,json=displayRemaining,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"display_remaining" yaml:"display_remaining"`
+	// Time priority among resting orders at the same price. Zero until the
+	// displayed slice of the order is refilled, in which case the order id is
+	// used.
+	Priority uint64 `protobuf:"varint,17,opt,name=priority,proto3" json:"priority,omitempty" yaml:"priority"`
 }
 
 func (m *Order) Reset()      { *m = Order{} }
@@ -206,16 +366,157 @@ func (m *Order) GetCreated() time.Time {
 	return time.Time{}
 }
 
+func (m *Order) GetExpireTime() *time.Time {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+func (m *Order) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *Order) GetPostOnly() PostOnlyMode {
+	if m != nil {
+		return m.PostOnly
+	}
+	return PostOnlyMode_None
+}
+
+func (m *Order) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return SelfTradePrevention_None
+}
+
+func (m *Order) GetPriority() uint64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+// StopOrder is parked in the trigger index until the last traded price of its
+// instrument falls to or below the stop price.
+type StopOrder struct {
+	ID            uint64        `protobuf:"varint,1,opt,name=stop_order_id,json=stopOrderId,proto3" json:"stop_order_id,omitempty" yaml:"stop_order_id"`
+	OrderType     StopOrderType `protobuf:"varint,2,opt,name=order_type,json=orderType,proto3,enum=em.market.v1.StopOrderType" json:"order_type,omitempty" yaml:"order_type"`
+	TimeInForce   TimeInForce   `protobuf:"varint,3,opt,name=time_in_force,json=timeInForce,proto3,enum=em.market.v1.TimeInForce" json:"time_in_force,omitempty" yaml:"time_in_force"`
+	Owner         string        `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ClientOrderID string        `protobuf:"bytes,5,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty" yaml:"client_order_id"`
+	// Only the denomination is used by market stop orders.
+	Source      types.Coin                             `protobuf:"bytes,6,opt,name=source,proto3" json:"source" yaml:"source"`
+	Destination types.Coin                             `protobuf:"bytes,7,opt,name=destination,proto3" json:"destination" yaml:"destination"`
+	StopPrice   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=stop_price,json=stopPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"stop_price" yaml:"stop_price"`
+	MaxSlippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=maximum_slippage,json=maximumSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maximum_slippage" yaml:"maximum_slippage"`
+	Created     time.Time                              `protobuf:"bytes,10,opt,name=created,proto3,stdtime" json:"created" yaml:"created"`
+}
+
+func (m *StopOrder) Reset()         { *m = StopOrder{} }
+func (m *StopOrder) String() string { return proto.CompactTextString(m) }
+func (*StopOrder) ProtoMessage()    {}
+func (*StopOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{2}
+}
+func (m *StopOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StopOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StopOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopOrder.Merge(m, src)
+}
+func (m *StopOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopOrder proto.InternalMessageInfo
+
+func (m *StopOrder) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *StopOrder) GetOrderType() StopOrderType {
+	if m != nil {
+		return m.OrderType
+	}
+	return StopOrderType_Unspecified
+}
+
+func (m *StopOrder) GetTimeInForce() TimeInForce {
+	if m != nil {
+		return m.TimeInForce
+	}
+	return TimeInForce_Unspecified
+}
+
+func (m *StopOrder) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *StopOrder) GetClientOrderID() string {
+	if m != nil {
+		return m.ClientOrderID
+	}
+	return ""
+}
+
+func (m *StopOrder) GetSource() types.Coin {
+	if m != nil {
+		return m.Source
+	}
+	return types.Coin{}
+}
+
+func (m *StopOrder) GetDestination() types.Coin {
+	if m != nil {
+		return m.Destination
+	}
+	return types.Coin{}
+}
+
+func (m *StopOrder) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return time.Time{}
+}
+
 type ExecutionPlan struct {
-	Price       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
-	FirstOrder  *Order                                 `protobuf:"bytes,2,opt,name=first_order,json=firstOrder,proto3" json:"first_order,omitempty"`
-	SecondOrder *Order                                 `protobuf:"bytes,3,opt,name=second_order,json=secondOrder,proto3" json:"second_order,omitempty"`
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// Passive orders to match in sequence. The first sells the denomination
+	// bought by the aggressive order, the last buys the one it sells.
+	Orders []*Order `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders,omitempty"`
 }
 
 func (m *ExecutionPlan) Reset()      { *m = ExecutionPlan{} }
 func (*ExecutionPlan) ProtoMessage() {}
 func (*ExecutionPlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_888ec7fc0f7580e2, []int{2}
+	return fileDescriptor_888ec7fc0f7580e2, []int{3}
 }
 func (m *ExecutionPlan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -244,16 +545,9 @@ func (m *ExecutionPlan) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExecutionPlan proto.InternalMessageInfo
 
-func (m *ExecutionPlan) GetFirstOrder() *Order {
+func (m *ExecutionPlan) GetOrders() []*Order {
 	if m != nil {
-		return m.FirstOrder
-	}
-	return nil
-}
-
-func (m *ExecutionPlan) GetSecondOrder() *Order {
-	if m != nil {
-		return m.SecondOrder
+		return m.Orders
 	}
 	return nil
 }
@@ -263,13 +557,15 @@ type MarketData struct {
 	Destination string                                  `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
 	LastPrice   *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=last_price,json=lastPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_price,omitempty"`
 	Timestamp   *time.Time                              `protobuf:"bytes,4,opt,name=timestamp,proto3,stdtime" json:"timestamp,omitempty"`
+	// Sum of the last price weighted by the seconds it held, up to timestamp.
+	CumulativePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=cumulative_price,json=cumulativePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_price"`
 }
 
 func (m *MarketData) Reset()         { *m = MarketData{} }
 func (m *MarketData) String() string { return proto.CompactTextString(m) }
 func (*MarketData) ProtoMessage()    {}
 func (*MarketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_888ec7fc0f7580e2, []int{3}
+	return fileDescriptor_888ec7fc0f7580e2, []int{4}
 }
 func (m *MarketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,448 +615,5020 @@ func (m *MarketData) GetTimestamp() *time.Time {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("em.market.v1.TimeInForce", TimeInForce_name, TimeInForce_value)
-	proto.RegisterType((*Instrument)(nil), "em.market.v1.Instrument")
-	proto.RegisterType((*Order)(nil), "em.market.v1.Order")
-	proto.RegisterType((*ExecutionPlan)(nil), "em.market.v1.ExecutionPlan")
-	proto.RegisterType((*MarketData)(nil), "em.market.v1.MarketData")
+// Candle aggregates the trades of an instrument within a time bucket. Prices
+// are expressed as destination / source, like the last price in MarketData.
+type Candle struct {
+	Source      string         `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
+	Destination string         `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty" yaml:"destination"`
+	Interval    CandleInterval `protobuf:"varint,3,opt,name=interval,proto3,enum=em.market.v1.CandleInterval" json:"interval,omitempty" yaml:"interval"`
+	// Block time at which the bucket begins.
+	Start time.Time                              `protobuf:"bytes,4,opt,name=start,proto3,stdtime" json:"start" yaml:"start"`
+	Open  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=open,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"open" yaml:"open"`
+	High  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=high,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"high" yaml:"high"`
+	Low   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=low,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"low" yaml:"low"`
+	Close github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=close,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"close" yaml:"close"`
+	// Amount of the source denomination traded within the bucket.
+	Volume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"volume" yaml:"volume"`
 }
 
-func init() { proto.RegisterFile("em/market/v1/market.proto", fileDescriptor_888ec7fc0f7580e2) }
-
-var fileDescriptor_888ec7fc0f7580e2 = []byte{
-	// 896 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x3f, 0x6f, 0xdb, 0x46,
-	0x14, 0x17, 0x6d, 0xd9, 0x8e, 0x4f, 0x96, 0xad, 0x5c, 0x9d, 0x54, 0xe2, 0x40, 0xaa, 0x1c, 0x82,
-	0x20, 0x81, 0x49, 0xd8, 0x0d, 0x32, 0x04, 0x45, 0x81, 0x48, 0xa2, 0x52, 0xc2, 0xb2, 0x65, 0x30,
-	0x0a, 0x02, 0x74, 0x21, 0x28, 0xf2, 0xa4, 0x1e, 0x4c, 0xde, 0x09, 0xe4, 0xc9, 0x8d, 0xfb, 0x11,
-	0x3c, 0x65, 0xec, 0x62, 0xa0, 0x43, 0x87, 0x7e, 0x94, 0x74, 0x73, 0xb7, 0xa2, 0x03, 0x5b, 0xc8,
-	0x53, 0x87, 0x2e, 0xfa, 0x04, 0x05, 0xef, 0x28, 0x99, 0x6c, 0xd1, 0x16, 0xea, 0xa4, 0x7b, 0x7f,
-	0x7e, 0xef, 0xbd, 0xdf, 0xfb, 0x43, 0x81, 0x06, 0x0a, 0x8d, 0xd0, 0x8d, 0xce, 0x11, 0x33, 0x2e,
-	0x0e, 0xb3, 0x97, 0x3e, 0x89, 0x28, 0xa3, 0x70, 0x07, 0x85, 0x7a, 0xa6, 0xb8, 0x38, 0x94, 0xf7,
-	0xc7, 0x74, 0x4c, 0xb9, 0xc1, 0x48, 0x5f, 0xc2, 0x47, 0x56, 0xc7, 0x94, 0x8e, 0x03, 0x64, 0x70,
-	0x69, 0x38, 0x1d, 0x19, 0x0c, 0x87, 0x28, 0x66, 0x6e, 0x38, 0xc9, 0x1c, 0x14, 0x8f, 0xc6, 0x21,
-	0x8d, 0x8d, 0xa1, 0x1b, 0x23, 0xe3, 0xe2, 0x70, 0x88, 0x98, 0x7b, 0x68, 0x78, 0x14, 0x13, 0x61,
-	0xd7, 0xba, 0x00, 0x58, 0x24, 0x66, 0xd1, 0x34, 0x44, 0x84, 0xc1, 0x87, 0x60, 0x33, 0xa6, 0xd3,
-	0xc8, 0x43, 0x75, 0xa9, 0x29, 0x3d, 0xde, 0xb6, 0x33, 0x09, 0x36, 0x41, 0xc5, 0x47, 0x31, 0xc3,
-	0xc4, 0x65, 0x98, 0x92, 0xfa, 0x1a, 0x37, 0xe6, 0x55, 0xda, 0x1f, 0x9b, 0x60, 0xa3, 0x1f, 0xf9,
-	0x28, 0x82, 0xcf, 0xc0, 0x3d, 0x9a, 0x3e, 0x1c, 0xec, 0xf3, 0x28, 0xe5, 0x56, 0x63, 0x96, 0xa8,
-	0x6b, 0x56, 0x67, 0x9e, 0xa8, 0x7b, 0x97, 0x6e, 0x18, 0xbc, 0xd0, 0x16, 0x76, 0xcd, 0xde, 0xe2,
-	0x4f, 0xcb, 0x87, 0x6f, 0x41, 0x35, 0x2d, 0xdd, 0xc1, 0xc4, 0x19, 0xd1, 0xb4, 0x80, 0x34, 0xc7,
-	0xee, 0x51, 0x43, 0xcf, 0x37, 0x41, 0x1f, 0xe0, 0x10, 0x59, 0xa4, 0x9b, 0x3a, 0xb4, 0xea, 0xf3,
-	0x44, 0xdd, 0x17, 0xf1, 0x0a, 0x48, 0xcd, 0xae, 0xb0, 0x3b, 0x37, 0xf8, 0x08, 0x6c, 0xd0, 0xaf,
-	0x09, 0x8a, 0xea, 0xeb, 0x69, 0xd1, 0xad, 0xda, 0x3c, 0x51, 0x77, 0xb2, 0x2a, 0x52, 0xb5, 0x66,
-	0x0b, 0x33, 0x7c, 0x0d, 0xf6, 0xbc, 0x00, 0x23, 0xc2, 0x9c, 0x65, 0xf5, 0x65, 0x8e, 0x78, 0x3a,
-	0x4b, 0xd4, 0x6a, 0x9b, 0x9b, 0x38, 0x41, 0x4e, 0xe4, 0xa1, 0x08, 0xf1, 0x17, 0x84, 0x66, 0x57,
-	0xbd, 0x9c, 0xa3, 0x0f, 0xbf, 0x58, 0xf6, 0x73, 0xa3, 0x29, 0x3d, 0xae, 0x1c, 0x35, 0x74, 0x31,
-	0x0e, 0x3d, 0x1d, 0x87, 0x9e, 0x8d, 0x43, 0x6f, 0x53, 0x4c, 0x5a, 0x0f, 0x3e, 0x24, 0x6a, 0x69,
-	0x9e, 0xa8, 0x55, 0x11, 0x59, 0xc0, 0xb4, 0xe5, 0x04, 0x18, 0xa8, 0x89, 0x97, 0x13, 0xa1, 0xd0,
-	0xc5, 0x04, 0x93, 0x71, 0x7d, 0x93, 0xd7, 0x67, 0xa5, 0xc0, 0x5f, 0x12, 0xf5, 0xd1, 0x18, 0xb3,
-	0xaf, 0xa6, 0x43, 0xdd, 0xa3, 0xa1, 0x91, 0x0d, 0x5d, 0xfc, 0x1c, 0xc4, 0xfe, 0xb9, 0xc1, 0x2e,
-	0x27, 0x28, 0xd6, 0x2d, 0xc2, 0xe6, 0x89, 0xfa, 0x71, 0x3e, 0xc5, 0x5d, 0x3c, 0xcd, 0xde, 0x13,
-	0x2a, 0x7b, 0xa1, 0x81, 0xe7, 0xa0, 0x9a, 0x79, 0x8d, 0x70, 0x10, 0x20, 0xbf, 0xbe, 0xc5, 0x53,
-	0x76, 0x57, 0x4e, 0xb9, 0x5f, 0x48, 0x29, 0x82, 0x69, 0xf6, 0x8e, 0x90, 0xbb, 0x5c, 0x84, 0x6f,
-	0x8b, 0x4b, 0x76, 0xef, 0xbf, 0x3a, 0x26, 0x67, 0x1d, 0x83, 0x22, 0x76, 0x7e, 0x1b, 0x0b, 0xbb,
-	0x09, 0xbf, 0x01, 0x30, 0x27, 0x2e, 0xa8, 0x6c, 0x73, 0x2a, 0xc7, 0x2b, 0x53, 0x69, 0xfc, 0x2d,
-	0xdd, 0x92, 0xcf, 0xfd, 0x9c, 0x32, 0x23, 0x75, 0x06, 0xb6, 0xbc, 0x08, 0xb9, 0x0c, 0xf9, 0x75,
-	0xc0, 0x09, 0xc9, 0xba, 0x38, 0x59, 0x7d, 0x71, 0xb2, 0x7c, 0xa9, 0xf9, 0xc9, 0x2e, 0x19, 0xed,
-	0x66, 0xdb, 0x25, 0x80, 0xda, 0xfb, 0x5f, 0x55, 0xc9, 0x5e, 0x84, 0x79, 0x51, 0xfe, 0xf6, 0x3b,
-	0xb5, 0xa4, 0xfd, 0x28, 0x81, 0xaa, 0xf9, 0x0e, 0x79, 0xd3, 0x34, 0xd7, 0x59, 0xe0, 0x12, 0xd8,
-	0x01, 0x1b, 0x93, 0x08, 0x2f, 0x4e, 0xb7, 0xa5, 0xaf, 0x40, 0xac, 0x83, 0x3c, 0x5b, 0x80, 0xe1,
-	0x33, 0x50, 0x19, 0xe1, 0x28, 0xce, 0x76, 0x9a, 0x5f, 0x61, 0xe5, 0xe8, 0xa3, 0xe2, 0x15, 0xf2,
-	0xed, 0xb6, 0x01, 0xf7, 0x13, 0x37, 0xff, 0x1c, 0xec, 0xc4, 0xc8, 0xa3, 0xc4, 0xcf, 0x60, 0xeb,
-	0xff, 0x0c, 0xab, 0x08, 0x47, 0x2e, 0x64, 0x5c, 0x7e, 0x92, 0x00, 0x38, 0xe1, 0x6e, 0x1d, 0x97,
-	0xb9, 0xff, 0xff, 0x23, 0x04, 0x2d, 0x00, 0x02, 0x37, 0x66, 0x8e, 0xe8, 0x83, 0x38, 0xf8, 0x27,
-	0x2b, 0xf4, 0x60, 0x3b, 0x45, 0x9f, 0xf1, 0x3e, 0x7c, 0x0e, 0xb6, 0x97, 0x9f, 0x52, 0xfe, 0x21,
-	0xf8, 0xf7, 0xc9, 0x95, 0xf9, 0x8c, 0xee, 0x20, 0x4f, 0x7e, 0x97, 0x40, 0x25, 0xf7, 0xb5, 0x82,
-	0x3a, 0x68, 0x0c, 0xac, 0x13, 0xd3, 0xb1, 0x4e, 0x9d, 0x6e, 0xdf, 0x6e, 0x9b, 0xce, 0x9b, 0xd3,
-	0xd7, 0x67, 0x66, 0xdb, 0xea, 0x5a, 0x66, 0xa7, 0x56, 0x92, 0xf7, 0xae, 0xae, 0x9b, 0x95, 0x37,
-	0x24, 0x9e, 0x20, 0x0f, 0x8f, 0x30, 0xf2, 0xe1, 0x73, 0xa0, 0x14, 0xfd, 0x5f, 0xf5, 0xfb, 0x1d,
-	0x67, 0x60, 0xf5, 0x7a, 0x4e, 0xfb, 0xe5, 0x69, 0xdb, 0xec, 0xd5, 0x24, 0x19, 0x5e, 0x5d, 0x37,
-	0x77, 0x5f, 0x51, 0xea, 0x0f, 0x70, 0x10, 0xb4, 0x5d, 0xe2, 0xa1, 0x00, 0x7e, 0x06, 0x3e, 0x29,
-	0xe2, 0xac, 0x93, 0x13, 0xb3, 0x63, 0xbd, 0x1c, 0x98, 0x4e, 0xdf, 0x5e, 0x40, 0xd7, 0xe4, 0x07,
-	0x57, 0xd7, 0xcd, 0xfb, 0x56, 0x18, 0x22, 0x1f, 0xbb, 0x0c, 0xf5, 0xa3, 0x0c, 0xad, 0x03, 0xb9,
-	0x88, 0xee, 0xa6, 0x09, 0xfb, 0xb6, 0x73, 0x6c, 0xf5, 0x7a, 0xb5, 0x75, 0x79, 0xf7, 0xea, 0xba,
-	0x09, 0xd2, 0xcd, 0xee, 0x47, 0xc7, 0x38, 0x08, 0xe4, 0xf2, 0x0f, 0xdf, 0x2b, 0x52, 0xcb, 0xfc,
-	0x30, 0x53, 0xa4, 0x9b, 0x99, 0x22, 0xfd, 0x36, 0x53, 0xa4, 0xf7, 0xb7, 0x4a, 0xe9, 0xe6, 0x56,
-	0x29, 0xfd, 0x7c, 0xab, 0x94, 0xbe, 0x7c, 0x9a, 0x6b, 0x3c, 0x3a, 0x08, 0x29, 0x41, 0x97, 0x06,
-	0x0a, 0x0f, 0x02, 0xe4, 0x8f, 0x51, 0x64, 0xbc, 0x5b, 0xfc, 0xf3, 0xf1, 0x09, 0x0c, 0x37, 0x79,
-	0x5f, 0x3f, 0xfd, 0x33, 0x00, 0x00, 0xff, 0xff, 0xb5, 0x22, 0xa6, 0x88, 0x13, 0x07, 0x00, 0x00,
+func (m *Candle) Reset()      { *m = Candle{} }
+func (*Candle) ProtoMessage() {}
+func (*Candle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{5}
 }
-
-func (m *Instrument) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Candle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *Candle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candle.Merge(m, src)
+}
+func (m *Candle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Candle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candle.DiscardUnknown(m)
 }
 
-func (m *Instrument) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_Candle proto.InternalMessageInfo
+
+func (m *Candle) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
 }
 
-func (m *Instrument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Destination) > 0 {
-		i -= len(m.Destination)
-		copy(dAtA[i:], m.Destination)
-		i = encodeVarintMarket(dAtA, i, uint64(len(m.Destination)))
-		i--
-		dAtA[i] = 0x12
+func (m *Candle) GetDestination() string {
+	if m != nil {
+		return m.Destination
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintMarket(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *Candle) GetInterval() CandleInterval {
+	if m != nil {
+		return m.Interval
 	}
-	return len(dAtA) - i, nil
+	return CandleInterval_Unspecified
 }
 
-func (m *Order) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Candle) GetStart() time.Time {
+	if m != nil {
+		return m.Start
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *Order) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Trade records a fill of a resting (maker) order by an incoming (taker)
+// order. Amounts are seen from the maker's side.
+type Trade struct {
+	ID           uint64 `protobuf:"varint,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty" yaml:"trade_id"`
+	MakerOrderID uint64 `protobuf:"varint,2,opt,name=maker_order_id,json=makerOrderId,proto3" json:"maker_order_id,omitempty" yaml:"maker_order_id"`
+	TakerOrderID uint64 `protobuf:"varint,3,opt,name=taker_order_id,json=takerOrderId,proto3" json:"taker_order_id,omitempty" yaml:"taker_order_id"`
+	Maker        string `protobuf:"bytes,4,opt,name=maker,proto3" json:"maker,omitempty" yaml:"maker"`
+	Taker        string `protobuf:"bytes,5,opt,name=taker,proto3" json:"taker,omitempty" yaml:"taker"`
+	// Amount sold by the maker.
+	Source types.Coin `protobuf:"bytes,6,opt,name=source,proto3" json:"source" yaml:"source"`
+	// Amount received by the maker.
+	Destination types.Coin `protobuf:"bytes,7,opt,name=destination,proto3" json:"destination" yaml:"destination"`
+	// Limit price of the maker order, expressed as destination / source.
+	Price     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
+	Timestamp time.Time                              `protobuf:"bytes,9,opt,name=timestamp,proto3,stdtime" json:"timestamp" yaml:"timestamp"`
+	Height    int64                                  `protobuf:"varint,10,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
 }
 
-func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintMarket(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.DestinationFilled.Size()
-		i -= size
-		if _, err := m.DestinationFilled.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMarket(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+func (m *Trade) Reset()      { *m = Trade{} }
+func (*Trade) ProtoMessage() {}
+func (*Trade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{6}
+}
+func (m *Trade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Trade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Trade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMarket(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.SourceFilled.Size()
-		i -= size
-		if _, err := m.SourceFilled.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMarket(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.SourceRemaining.Size()
-		i -= size
-		if _, err := m.SourceRemaining.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMarket(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMarket(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.ClientOrderID) > 0 {
-		i -= len(m.ClientOrderID)
-		copy(dAtA[i:], m.ClientOrderID)
-		i = encodeVarintMarket(dAtA, i, uint64(len(m.ClientOrderID)))
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintMarket(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *Trade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Trade.Merge(m, src)
+}
+func (m *Trade) XXX_Size() int {
+	return m.Size()
+}
+func (m *Trade) XXX_DiscardUnknown() {
+	xxx_messageInfo_Trade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Trade proto.InternalMessageInfo
+
+func (m *Trade) GetID() uint64 {
+	if m != nil {
+		return m.ID
 	}
-	if m.TimeInForce != 0 {
-		i = encodeVarintMarket(dAtA, i, uint64(m.TimeInForce))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *Trade) GetMakerOrderID() uint64 {
+	if m != nil {
+		return m.MakerOrderID
 	}
-	if m.ID != 0 {
-		i = encodeVarintMarket(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *Trade) GetTakerOrderID() uint64 {
+	if m != nil {
+		return m.TakerOrderID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ExecutionPlan) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Trade) GetMaker() string {
+	if m != nil {
+		return m.Maker
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ExecutionPlan) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Trade) GetTaker() string {
+	if m != nil {
+		return m.Taker
+	}
+	return ""
 }
 
-func (m *ExecutionPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SecondOrder != nil {
-		{
-			size, err := m.SecondOrder.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMarket(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func (m *Trade) GetSource() types.Coin {
+	if m != nil {
+		return m.Source
 	}
-	if m.FirstOrder != nil {
-		{
-			size, err := m.FirstOrder.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMarket(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return types.Coin{}
+}
+
+func (m *Trade) GetDestination() types.Coin {
+	if m != nil {
+		return m.Destination
 	}
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMarket(dAtA, i, uint64(size))
+	return types.Coin{}
+}
+
+func (m *Trade) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *MarketData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Trade) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MarketData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Params struct {
+	// Number of most recent candles kept per instrument and interval.
+	CandleRetention uint32 `protobuf:"varint,1,opt,name=candle_retention,json=candleRetention,proto3" json:"candle_retention,omitempty" yaml:"candle_retention"`
+	// Number of most recent trades kept in the trade log.
+	TradeRetention uint64 `protobuf:"varint,2,opt,name=trade_retention,json=tradeRetention,proto3" json:"trade_retention,omitempty" yaml:"trade_retention"`
+	// Fee charged to the resting order of a trade, in basis points of the
+	// amount it receives.
+	MakerFee uint32 `protobuf:"varint,3,opt,name=maker_fee,json=makerFee,proto3" json:"maker_fee,omitempty" yaml:"maker_fee"`
+	// Fee charged to the incoming order of a trade, in basis points of the
+	// amount it receives.
+	TakerFee uint32 `protobuf:"varint,4,opt,name=taker_fee,json=takerFee,proto3" json:"taker_fee,omitempty" yaml:"taker_fee"`
+	// Fee rates overriding maker_fee and taker_fee for specific instruments.
+	InstrumentFees []InstrumentFees `protobuf:"bytes,5,rep,name=instrument_fees,json=instrumentFees,proto3" json:"instrument_fees" yaml:"instrument_fees"`
+	// Maximum number of passive orders an order can be matched through in a
+	// single route.
+	MaxHops uint32 `protobuf:"varint,6,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty" yaml:"max_hops"`
+	// Maximum number of resting orders of an account. Zero imposes no limit.
+	MaxOpenOrders uint32 `protobuf:"varint,7,opt,name=max_open_orders,json=maxOpenOrders,proto3" json:"max_open_orders,omitempty" yaml:"max_open_orders"`
+	// Maximum number of resting orders of an account in a single instrument.
+	// Zero imposes no limit.
+	MaxInstrumentOpenOrders uint32 `protobuf:"varint,8,opt,name=max_instrument_open_orders,json=maxInstrumentOpenOrders,proto3" json:"max_instrument_open_orders,omitempty" yaml:"max_instrument_open_orders"`
+	// Limits overriding max_open_orders and max_instrument_open_orders for
+	// specific accounts, such as market makers.
+	AccountOrderLimits []AccountOrderLimits `protobuf:"bytes,9,rep,name=account_order_limits,json=accountOrderLimits,proto3" json:"account_order_limits" yaml:"account_order_limits"`
+	// Period for which the market data of every trade is kept, which is the
+	// longest window a time-weighted average price can be derived for.
+	PriceHistoryRetention time.Duration `protobuf:"bytes,10,opt,name=price_history_retention,json=priceHistoryRetention,proto3,stdduration" json:"price_history_retention" yaml:"price_history_retention"`
 }
 
-func (m *MarketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Timestamp != nil {
-		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timestamp):])
-		if err6 != nil {
-			return 0, err6
-		}
-		i -= n6
-		i = encodeVarintMarket(dAtA, i, uint64(n6))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.LastPrice != nil {
-		{
-			size := m.LastPrice.Size()
-			i -= size
-			if _, err := m.LastPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintMarket(dAtA, i, uint64(size))
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{7}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.Destination) > 0 {
-		i -= len(m.Destination)
-		copy(dAtA[i:], m.Destination)
-		i = encodeVarintMarket(dAtA, i, uint64(len(m.Destination)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetCandleRetention() uint32 {
+	if m != nil {
+		return m.CandleRetention
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintMarket(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *Params) GetTradeRetention() uint64 {
+	if m != nil {
+		return m.TradeRetention
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintMarket(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMarket(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Params) GetMakerFee() uint32 {
+	if m != nil {
+		return m.MakerFee
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Instrument) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Params) GetTakerFee() uint32 {
+	if m != nil {
+		return m.TakerFee
 	}
-	var l int
-	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovMarket(uint64(l))
+	return 0
+}
+
+func (m *Params) GetInstrumentFees() []InstrumentFees {
+	if m != nil {
+		return m.InstrumentFees
 	}
-	l = len(m.Destination)
-	if l > 0 {
-		n += 1 + l + sovMarket(uint64(l))
+	return nil
+}
+
+func (m *Params) GetMaxHops() uint32 {
+	if m != nil {
+		return m.MaxHops
 	}
-	return n
+	return 0
 }
 
-func (m *Order) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Params) GetMaxOpenOrders() uint32 {
+	if m != nil {
+		return m.MaxOpenOrders
 	}
-	var l int
-	_ = l
-	if m.ID != 0 {
-		n += 1 + sovMarket(uint64(m.ID))
+	return 0
+}
+
+func (m *Params) GetMaxInstrumentOpenOrders() uint32 {
+	if m != nil {
+		return m.MaxInstrumentOpenOrders
 	}
-	if m.TimeInForce != 0 {
-		n += 1 + sovMarket(uint64(m.TimeInForce))
+	return 0
+}
+
+func (m *Params) GetAccountOrderLimits() []AccountOrderLimits {
+	if m != nil {
+		return m.AccountOrderLimits
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovMarket(uint64(l))
+	return nil
+}
+
+func (m *Params) GetPriceHistoryRetention() time.Duration {
+	if m != nil {
+		return m.PriceHistoryRetention
 	}
-	l = len(m.ClientOrderID)
-	if l > 0 {
-		n += 1 + l + sovMarket(uint64(l))
+	return 0
+}
+
+// AccountOrderLimits holds the open order limits of an account. Zero values
+// impose no limit.
+type AccountOrderLimits struct {
+	Account                 string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty" yaml:"account"`
+	MaxOpenOrders           uint32 `protobuf:"varint,2,opt,name=max_open_orders,json=maxOpenOrders,proto3" json:"max_open_orders,omitempty" yaml:"max_open_orders"`
+	MaxInstrumentOpenOrders uint32 `protobuf:"varint,3,opt,name=max_instrument_open_orders,json=maxInstrumentOpenOrders,proto3" json:"max_instrument_open_orders,omitempty" yaml:"max_instrument_open_orders"`
+}
+
+func (m *AccountOrderLimits) Reset()         { *m = AccountOrderLimits{} }
+func (m *AccountOrderLimits) String() string { return proto.CompactTextString(m) }
+func (*AccountOrderLimits) ProtoMessage()    {}
+func (*AccountOrderLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{8}
+}
+func (m *AccountOrderLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountOrderLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountOrderLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Source.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	l = m.SourceRemaining.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	l = m.SourceFilled.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	l = m.Destination.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	l = m.DestinationFilled.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovMarket(uint64(l))
-	return n
+}
+func (m *AccountOrderLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountOrderLimits.Merge(m, src)
+}
+func (m *AccountOrderLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountOrderLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountOrderLimits.DiscardUnknown(m)
 }
 
-func (m *ExecutionPlan) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_AccountOrderLimits proto.InternalMessageInfo
+
+func (m *AccountOrderLimits) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	var l int
-	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	if m.FirstOrder != nil {
-		l = m.FirstOrder.Size()
-		n += 1 + l + sovMarket(uint64(l))
+	return ""
+}
+
+func (m *AccountOrderLimits) GetMaxOpenOrders() uint32 {
+	if m != nil {
+		return m.MaxOpenOrders
 	}
-	if m.SecondOrder != nil {
-		l = m.SecondOrder.Size()
-		n += 1 + l + sovMarket(uint64(l))
+	return 0
+}
+
+func (m *AccountOrderLimits) GetMaxInstrumentOpenOrders() uint32 {
+	if m != nil {
+		return m.MaxInstrumentOpenOrders
+	}
+	return 0
+}
+
+// InstrumentFees holds the fee rates of both books of a pair of
+// denominations, in basis points.
+type InstrumentFees struct {
+	Source      string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
+	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty" yaml:"destination"`
+	MakerFee    uint32 `protobuf:"varint,3,opt,name=maker_fee,json=makerFee,proto3" json:"maker_fee,omitempty" yaml:"maker_fee"`
+	TakerFee    uint32 `protobuf:"varint,4,opt,name=taker_fee,json=takerFee,proto3" json:"taker_fee,omitempty" yaml:"taker_fee"`
+}
+
+func (m *InstrumentFees) Reset()         { *m = InstrumentFees{} }
+func (m *InstrumentFees) String() string { return proto.CompactTextString(m) }
+func (*InstrumentFees) ProtoMessage()    {}
+func (*InstrumentFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{9}
+}
+func (m *InstrumentFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InstrumentFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InstrumentFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InstrumentFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstrumentFees.Merge(m, src)
+}
+func (m *InstrumentFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *InstrumentFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstrumentFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InstrumentFees proto.InternalMessageInfo
+
+func (m *InstrumentFees) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *InstrumentFees) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *InstrumentFees) GetMakerFee() uint32 {
+	if m != nil {
+		return m.MakerFee
+	}
+	return 0
+}
+
+func (m *InstrumentFees) GetTakerFee() uint32 {
+	if m != nil {
+		return m.TakerFee
+	}
+	return 0
+}
+
+// InstrumentRules constrains the orders of an instrument. Zero values impose
+// no constraint.
+type InstrumentRules struct {
+	Source      string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
+	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty" yaml:"destination"`
+	// Price increment, expressed as destination per source, of orders that can
+	// rest on the book.
+	TickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=tick_size,json=tickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tick_size" yaml:"tick_size"`
+	// Minimum source amount of an order.
+	MinOrderSize github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_order_size,json=minOrderSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_order_size" yaml:"min_order_size"`
+	// The source amount of an order must be a multiple of the lot size.
+	LotSize github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=lot_size,json=lotSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"lot_size" yaml:"lot_size"`
+}
+
+func (m *InstrumentRules) Reset()         { *m = InstrumentRules{} }
+func (m *InstrumentRules) String() string { return proto.CompactTextString(m) }
+func (*InstrumentRules) ProtoMessage()    {}
+func (*InstrumentRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{10}
+}
+func (m *InstrumentRules) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InstrumentRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InstrumentRules.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InstrumentRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstrumentRules.Merge(m, src)
+}
+func (m *InstrumentRules) XXX_Size() int {
+	return m.Size()
+}
+func (m *InstrumentRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstrumentRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InstrumentRules proto.InternalMessageInfo
+
+func (m *InstrumentRules) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *InstrumentRules) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+// TradingHalt stops trading on an instrument in both directions, or on every
+// instrument involving a denomination.
+type TradingHalt struct {
+	// Denomination whose instruments are halted. Empty when a single instrument
+	// is halted.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// Denominations of the halted instrument. Empty when a denomination is
+	// halted.
+	Source      string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
+	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty" yaml:"destination"`
+	// Block time at which the halt lifts. Halts without expiry last until
+	// trading is resumed.
+	ExpireTime *time.Time `protobuf:"bytes,4,opt,name=expire_time,json=expireTime,proto3,stdtime" json:"expire_time,omitempty" yaml:"expire_time"`
+}
+
+func (m *TradingHalt) Reset()      { *m = TradingHalt{} }
+func (*TradingHalt) ProtoMessage() {}
+func (*TradingHalt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{11}
+}
+func (m *TradingHalt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradingHalt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradingHalt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TradingHalt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradingHalt.Merge(m, src)
+}
+func (m *TradingHalt) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradingHalt) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradingHalt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradingHalt proto.InternalMessageInfo
+
+func (m *TradingHalt) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TradingHalt) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *TradingHalt) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *TradingHalt) GetExpireTime() *time.Time {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+// PriceBand limits how far the trades of an instrument may move from a
+// reference price. It applies to both directions of the instrument.
+type PriceBand struct {
+	Source      string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
+	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty" yaml:"destination"`
+	// Largest relative deviation of a trade price from the reference price, such
+	// as 0.1 for 10%.
+	MaxDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_deviation,json=maxDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_deviation" yaml:"max_deviation"`
+	// Fixed reference price, expressed as destination per source. When zero, the
+	// last traded price of the instrument before the order arrived is used.
+	ReferencePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=reference_price,json=referencePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reference_price" yaml:"reference_price"`
+	// Number of breaches within the breach window that halt the instrument. Zero
+	// never halts it.
+	MaxBreaches  uint32        `protobuf:"varint,5,opt,name=max_breaches,json=maxBreaches,proto3" json:"max_breaches,omitempty" yaml:"max_breaches"`
+	BreachWindow time.Duration `protobuf:"bytes,6,opt,name=breach_window,json=breachWindow,proto3,stdduration" json:"breach_window" yaml:"breach_window"`
+	// Time after which a halt triggered by the breaches lifts.
+	HaltDuration time.Duration `protobuf:"bytes,7,opt,name=halt_duration,json=haltDuration,proto3,stdduration" json:"halt_duration" yaml:"halt_duration"`
+}
+
+func (m *PriceBand) Reset()         { *m = PriceBand{} }
+func (m *PriceBand) String() string { return proto.CompactTextString(m) }
+func (*PriceBand) ProtoMessage()    {}
+func (*PriceBand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{12}
+}
+func (m *PriceBand) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceBand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceBand.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceBand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceBand.Merge(m, src)
+}
+func (m *PriceBand) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceBand) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceBand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceBand proto.InternalMessageInfo
+
+func (m *PriceBand) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *PriceBand) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *PriceBand) GetMaxBreaches() uint32 {
+	if m != nil {
+		return m.MaxBreaches
+	}
+	return 0
+}
+
+func (m *PriceBand) GetBreachWindow() time.Duration {
+	if m != nil {
+		return m.BreachWindow
+	}
+	return 0
+}
+
+func (m *PriceBand) GetHaltDuration() time.Duration {
+	if m != nil {
+		return m.HaltDuration
+	}
+	return 0
+}
+
+// PriceBandBreaches counts the breaches of a price band within the current
+// breach window.
+type PriceBandBreaches struct {
+	Count       uint32    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty" yaml:"count"`
+	WindowStart time.Time `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start" yaml:"window_start"`
+}
+
+func (m *PriceBandBreaches) Reset()         { *m = PriceBandBreaches{} }
+func (m *PriceBandBreaches) String() string { return proto.CompactTextString(m) }
+func (*PriceBandBreaches) ProtoMessage()    {}
+func (*PriceBandBreaches) Descriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{13}
+}
+func (m *PriceBandBreaches) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceBandBreaches) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceBandBreaches.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceBandBreaches) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceBandBreaches.Merge(m, src)
+}
+func (m *PriceBandBreaches) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceBandBreaches) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceBandBreaches.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceBandBreaches proto.InternalMessageInfo
+
+func (m *PriceBandBreaches) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *PriceBandBreaches) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
+// BatchAuction places an instrument in batch auction mode. Its orders are not
+// matched on arrival, but collected during the block and cleared together at
+// a uniform price at the end of it. It applies to both directions of the
+// instrument. The clearing price is quoted in destination per source and the
+// executed volume is measured in the source denomination.
+type BatchAuction struct {
+	Source      string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
+	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty" yaml:"destination"`
+}
+
+func (m *BatchAuction) Reset()      { *m = BatchAuction{} }
+func (*BatchAuction) ProtoMessage() {}
+func (*BatchAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{14}
+}
+func (m *BatchAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchAuction.Merge(m, src)
+}
+func (m *BatchAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchAuction proto.InternalMessageInfo
+
+func (m *BatchAuction) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *BatchAuction) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("em.market.v1.TimeInForce", TimeInForce_name, TimeInForce_value)
+	proto.RegisterEnum("em.market.v1.PostOnlyMode", PostOnlyMode_name, PostOnlyMode_value)
+	proto.RegisterEnum("em.market.v1.SelfTradePrevention", SelfTradePrevention_name, SelfTradePrevention_value)
+	proto.RegisterEnum("em.market.v1.StopOrderType", StopOrderType_name, StopOrderType_value)
+	proto.RegisterEnum("em.market.v1.CandleInterval", CandleInterval_name, CandleInterval_value)
+	proto.RegisterType((*Instrument)(nil), "em.market.v1.Instrument")
+	proto.RegisterType((*Order)(nil), "em.market.v1.Order")
+	proto.RegisterType((*StopOrder)(nil), "em.market.v1.StopOrder")
+	proto.RegisterType((*ExecutionPlan)(nil), "em.market.v1.ExecutionPlan")
+	proto.RegisterType((*MarketData)(nil), "em.market.v1.MarketData")
+	proto.RegisterType((*Candle)(nil), "em.market.v1.Candle")
+	proto.RegisterType((*Trade)(nil), "em.market.v1.Trade")
+	proto.RegisterType((*Params)(nil), "em.market.v1.Params")
+	proto.RegisterType((*AccountOrderLimits)(nil), "em.market.v1.AccountOrderLimits")
+	proto.RegisterType((*InstrumentFees)(nil), "em.market.v1.InstrumentFees")
+	proto.RegisterType((*InstrumentRules)(nil), "em.market.v1.InstrumentRules")
+	proto.RegisterType((*TradingHalt)(nil), "em.market.v1.TradingHalt")
+	proto.RegisterType((*PriceBand)(nil), "em.market.v1.PriceBand")
+	proto.RegisterType((*PriceBandBreaches)(nil), "em.market.v1.PriceBandBreaches")
+	proto.RegisterType((*BatchAuction)(nil), "em.market.v1.BatchAuction")
+}
+
+func init() { proto.RegisterFile("em/market/v1/market.proto", fileDescriptor_888ec7fc0f7580e2) }
+
+var fileDescriptor_888ec7fc0f7580e2 = []byte{
+	// 2706 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcb, 0x6f, 0x1b, 0xd7,
+	0xb9, 0x17, 0x29, 0x92, 0x12, 0x0f, 0x49, 0x91, 0x3a, 0x7a, 0x98, 0xa6, 0x7d, 0x45, 0x7a, 0x72,
+	0xe3, 0xeb, 0x28, 0x37, 0xe4, 0xb5, 0x6f, 0x5a, 0xb4, 0x41, 0x92, 0x42, 0x43, 0x0e, 0x23, 0xc6,
+	0x7c, 0x28, 0x47, 0x74, 0x5c, 0xb7, 0x45, 0xa7, 0xa3, 0xe1, 0x91, 0x34, 0xd5, 0x3c, 0x98, 0x99,
+	0x43, 0x3d, 0x02, 0x74, 0x51, 0x74, 0x53, 0x68, 0xd3, 0x2c, 0x03, 0x14, 0x02, 0xba, 0xc8, 0xa2,
+	0x8b, 0x2e, 0x5a, 0xb4, 0x8b, 0xfe, 0x09, 0x59, 0x06, 0xe8, 0xa2, 0x45, 0x0b, 0xb0, 0x85, 0xb2,
+	0x2b, 0xd0, 0x8d, 0xf6, 0x05, 0x8a, 0xf3, 0x18, 0xce, 0x90, 0x92, 0x2b, 0x33, 0x36, 0x0c, 0x74,
+	0xa5, 0x99, 0xf3, 0x7d, 0xdf, 0xef, 0x3b, 0xe7, 0x7b, 0x9f, 0xa1, 0xc0, 0x4d, 0x6c, 0x55, 0x2c,
+	0xcd, 0x3d, 0xc0, 0xa4, 0x72, 0x78, 0x5f, 0x3c, 0x95, 0xfb, 0xae, 0x43, 0x1c, 0x98, 0xc6, 0x56,
+	0x59, 0x2c, 0x1c, 0xde, 0x2f, 0x2c, 0xef, 0x39, 0x7b, 0x0e, 0x23, 0x54, 0xe8, 0x13, 0xe7, 0x29,
+	0xac, 0xed, 0x39, 0xce, 0x9e, 0x89, 0x2b, 0xec, 0x6d, 0x67, 0xb0, 0x5b, 0xe9, 0x0d, 0x5c, 0x8d,
+	0x18, 0x8e, 0x2d, 0xe8, 0xc5, 0x49, 0x3a, 0x31, 0x2c, 0xec, 0x11, 0xcd, 0xea, 0xfb, 0x00, 0xba,
+	0xe3, 0x59, 0x8e, 0x57, 0xd9, 0xd1, 0x3c, 0x5c, 0x39, 0xbc, 0xbf, 0x83, 0x89, 0x76, 0xbf, 0xa2,
+	0x3b, 0x86, 0x00, 0x90, 0xea, 0x00, 0x34, 0x6c, 0x8f, 0xb8, 0x03, 0x0b, 0xdb, 0x04, 0xae, 0x82,
+	0x84, 0xe7, 0x0c, 0x5c, 0x1d, 0xe7, 0x23, 0xa5, 0xc8, 0xbd, 0x24, 0x12, 0x6f, 0xb0, 0x04, 0x52,
+	0x3d, 0xec, 0x11, 0xc3, 0x66, 0xba, 0xf3, 0x51, 0x46, 0x0c, 0x2f, 0x49, 0xff, 0x48, 0x81, 0x78,
+	0xc7, 0xed, 0x61, 0x17, 0xbe, 0x09, 0xe6, 0x1d, 0xfa, 0xa0, 0x1a, 0x3d, 0x86, 0x12, 0x93, 0x6f,
+	0x9e, 0x0f, 0x8b, 0xd1, 0x46, 0xed, 0x62, 0x58, 0xcc, 0x9e, 0x68, 0x96, 0xf9, 0x96, 0xe4, 0xd3,
+	0x25, 0x34, 0xc7, 0x1e, 0x1b, 0x3d, 0xf8, 0x18, 0x64, 0xe8, 0xd6, 0x55, 0xc3, 0x56, 0x77, 0x1d,
+	0xba, 0x01, 0xaa, 0x63, 0xe1, 0xc1, 0xcd, 0x72, 0xd8, 0x48, 0xe5, 0xae, 0x61, 0xe1, 0x86, 0x5d,
+	0xa7, 0x0c, 0x72, 0xfe, 0x62, 0x58, 0x5c, 0xe6, 0x78, 0x63, 0x92, 0x12, 0x4a, 0x91, 0x80, 0x0d,
+	0xde, 0x05, 0x71, 0xe7, 0xc8, 0xc6, 0x6e, 0x7e, 0x96, 0x6e, 0x5a, 0xce, 0x5d, 0x0c, 0x8b, 0x69,
+	0xb1, 0x0b, 0xba, 0x2c, 0x21, 0x4e, 0x86, 0xdb, 0x20, 0xab, 0x9b, 0x06, 0xb6, 0x89, 0x3a, 0xda,
+	0x7d, 0x8c, 0x49, 0xbc, 0x7e, 0x3e, 0x2c, 0x66, 0xaa, 0x8c, 0xc4, 0x0e, 0xc8, 0x0e, 0xb2, 0xca,
+	0x21, 0x26, 0x24, 0x24, 0x94, 0xd1, 0x43, 0x8c, 0x3d, 0xb8, 0x39, 0xb2, 0x67, 0xbc, 0x14, 0xb9,
+	0x97, 0x7a, 0x70, 0xb3, 0xcc, 0xdd, 0x51, 0xa6, 0xee, 0x28, 0x0b, 0x77, 0x94, 0xab, 0x8e, 0x61,
+	0xcb, 0x2b, 0x9f, 0x0f, 0x8b, 0x33, 0x17, 0xc3, 0x62, 0x86, 0x23, 0x73, 0x31, 0x69, 0xe4, 0x01,
+	0x02, 0x72, 0xfc, 0x49, 0x75, 0xb1, 0xa5, 0x19, 0xb6, 0x61, 0xef, 0xe5, 0x13, 0x6c, 0x7f, 0x0d,
+	0x2a, 0xf8, 0xe7, 0x61, 0xf1, 0xee, 0x9e, 0x41, 0xf6, 0x07, 0x3b, 0x65, 0xdd, 0xb1, 0x2a, 0xc2,
+	0xe9, 0xfc, 0xcf, 0x1b, 0x5e, 0xef, 0xa0, 0x42, 0x4e, 0xfa, 0xd8, 0x2b, 0x37, 0x6c, 0x72, 0x31,
+	0x2c, 0xde, 0x08, 0xab, 0x08, 0xf0, 0x24, 0x94, 0xe5, 0x4b, 0xc8, 0x5f, 0x81, 0x07, 0x20, 0x23,
+	0xb8, 0x76, 0x0d, 0xd3, 0xc4, 0xbd, 0xfc, 0x1c, 0x53, 0x59, 0x9f, 0x5a, 0xe5, 0xf2, 0x98, 0x4a,
+	0x0e, 0x26, 0xa1, 0x34, 0x7f, 0xaf, 0xb3, 0x57, 0xf8, 0x78, 0x3c, 0xc8, 0xe6, 0xaf, 0xb3, 0x58,
+	0x41, 0x58, 0x0c, 0x72, 0xec, 0x70, 0x34, 0x8e, 0xc5, 0x26, 0xfc, 0x18, 0xc0, 0xd0, 0xab, 0x7f,
+	0x94, 0x24, 0x3b, 0xca, 0xc3, 0xa9, 0x8f, 0x72, 0xf3, 0x92, 0xba, 0xd1, 0x79, 0x16, 0x43, 0x8b,
+	0xe2, 0x50, 0x5b, 0x60, 0x4e, 0x77, 0xb1, 0x46, 0x70, 0x2f, 0x0f, 0xd8, 0x81, 0x0a, 0x65, 0x9e,
+	0xb2, 0x65, 0x3f, 0x65, 0xcb, 0x5d, 0x3f, 0x65, 0x47, 0x27, 0x5a, 0x10, 0xd1, 0xc5, 0x05, 0xa5,
+	0x4f, 0xfe, 0x5a, 0x8c, 0x20, 0x1f, 0x86, 0x9a, 0x09, 0x1f, 0xf7, 0x0d, 0x17, 0xab, 0x34, 0xcc,
+	0xf3, 0xa9, 0xeb, 0x51, 0x03, 0x1b, 0x85, 0x04, 0x39, 0x2a, 0xe0, 0x2b, 0x94, 0x19, 0xbe, 0x03,
+	0x32, 0x82, 0xbe, 0x8f, 0x8d, 0xbd, 0x7d, 0x92, 0x4f, 0x97, 0x22, 0xf7, 0x66, 0xc3, 0x79, 0x36,
+	0x46, 0x96, 0x50, 0x9a, 0xbf, 0x6f, 0xb2, 0x57, 0xd8, 0x02, 0xc9, 0xbe, 0xe3, 0x11, 0xd5, 0xb1,
+	0xcd, 0x93, 0x7c, 0x86, 0x65, 0x6f, 0x61, 0x3c, 0x7b, 0xb7, 0x1c, 0x8f, 0x74, 0x6c, 0xf3, 0xa4,
+	0xe5, 0xf4, 0xb0, 0xbc, 0x7c, 0x31, 0x2c, 0xe6, 0x38, 0xec, 0x48, 0x4c, 0x42, 0xf3, 0x7d, 0xc1,
+	0x03, 0x8f, 0xc0, 0x8a, 0x87, 0xcd, 0x5d, 0x95, 0xb8, 0x5a, 0x0f, 0xab, 0x7d, 0x17, 0x1f, 0x62,
+	0x9b, 0xc5, 0xc5, 0x02, 0x83, 0xbe, 0x33, 0x0e, 0xbd, 0x8d, 0xcd, 0xdd, 0x2e, 0xe5, 0xdc, 0x1a,
+	0x31, 0xca, 0xa5, 0x8b, 0x61, 0xf1, 0xb6, 0x88, 0xbb, 0xab, 0x90, 0x24, 0xb4, 0xe4, 0x5d, 0x16,
+	0xa3, 0x99, 0xd6, 0x33, 0xbc, 0xbe, 0xa9, 0x9d, 0xa8, 0x1f, 0x0d, 0x34, 0x9b, 0x18, 0xe4, 0x24,
+	0x9f, 0x7d, 0xbe, 0x4c, 0x9b, 0xc4, 0x93, 0x50, 0x56, 0x2c, 0x7d, 0x20, 0x56, 0xe0, 0x11, 0x58,
+	0xf4, 0xb9, 0x82, 0x04, 0xcf, 0x31, 0xb5, 0xef, 0x4f, 0xad, 0x36, 0x3f, 0xae, 0x36, 0x94, 0xe1,
+	0xfe, 0xd1, 0x82, 0x14, 0xaf, 0x80, 0xf9, 0xbe, 0x6b, 0x38, 0x2e, 0x3d, 0xe6, 0x22, 0x2b, 0xd7,
+	0x4b, 0x41, 0xa1, 0xf6, 0x29, 0xd4, 0x31, 0xe2, 0xf1, 0xad, 0xd8, 0xa7, 0xbf, 0x28, 0xce, 0x48,
+	0x7f, 0x4c, 0x80, 0xe4, 0x36, 0x71, 0xfa, 0xbc, 0xe6, 0xcb, 0x20, 0xe3, 0x11, 0xa7, 0xaf, 0x4e,
+	0x14, 0xfe, 0xb5, 0x51, 0xe1, 0xf7, 0xf3, 0x3f, 0xcc, 0x24, 0xa1, 0x94, 0xe7, 0x23, 0x34, 0x7a,
+	0xf0, 0x03, 0x00, 0x38, 0x85, 0x9e, 0x44, 0x94, 0xff, 0x5b, 0x13, 0x5e, 0xf6, 0xd9, 0xbb, 0x27,
+	0x7d, 0x2c, 0xaf, 0x5c, 0x0c, 0x8b, 0x8b, 0xe1, 0x86, 0x42, 0x05, 0x25, 0x94, 0x74, 0x7c, 0x8e,
+	0xcb, 0x4d, 0x65, 0xf6, 0x45, 0x37, 0x95, 0xd8, 0xd4, 0x4d, 0x25, 0xfe, 0x02, 0x9b, 0x4a, 0xe2,
+	0x39, 0x9b, 0xca, 0x44, 0xc5, 0x9d, 0x7b, 0x61, 0x15, 0x77, 0x07, 0x00, 0xe6, 0xea, 0xbe, 0x6b,
+	0xe8, 0x98, 0x55, 0xf2, 0xa4, 0x5c, 0x9d, 0x22, 0x8c, 0x6b, 0x58, 0x0f, 0x9c, 0x1b, 0x20, 0x49,
+	0x28, 0x49, 0x5f, 0xb6, 0xe8, 0x33, 0xfc, 0x49, 0x04, 0xe4, 0x2c, 0xed, 0xd8, 0xb0, 0x06, 0x96,
+	0xea, 0x99, 0x46, 0xbf, 0xaf, 0xed, 0x61, 0x51, 0xd4, 0xbf, 0x3d, 0x9d, 0xaa, 0xf3, 0x61, 0x31,
+	0xd5, 0xd2, 0x8e, 0xb7, 0x05, 0x48, 0x90, 0xb7, 0x93, 0xf0, 0x12, 0xca, 0x8a, 0x25, 0x9f, 0xf7,
+	0xc5, 0xd7, 0x77, 0xe9, 0xa7, 0x11, 0x90, 0x51, 0x8e, 0xb1, 0x3e, 0xa0, 0x96, 0xdc, 0x32, 0x35,
+	0x1b, 0xd6, 0x40, 0x9c, 0x1b, 0x92, 0x0d, 0x65, 0x72, 0x79, 0xba, 0xd3, 0x21, 0x2e, 0x0c, 0x5f,
+	0x07, 0x09, 0x16, 0x52, 0x5e, 0x3e, 0x5a, 0x9a, 0xbd, 0x97, 0x7a, 0xb0, 0x34, 0x9e, 0x05, 0x2c,
+	0xba, 0x90, 0x60, 0x11, 0x49, 0xfe, 0xab, 0x28, 0x00, 0x2d, 0xc6, 0x51, 0xd3, 0x88, 0xf6, 0xd5,
+	0xa7, 0x43, 0xd8, 0x00, 0xc0, 0xd4, 0x3c, 0x22, 0xe2, 0x81, 0x4f, 0x62, 0xeb, 0x53, 0x1c, 0x21,
+	0x49, 0xa5, 0xb9, 0xdb, 0xdf, 0x05, 0xc9, 0xd1, 0x8c, 0x9b, 0x8f, 0x5d, 0x6b, 0xf2, 0x18, 0x33,
+	0x6e, 0x20, 0x02, 0x9f, 0x80, 0x9c, 0x3e, 0xb0, 0x06, 0xa6, 0x46, 0x8c, 0x43, 0x2c, 0x36, 0x14,
+	0xff, 0x4a, 0x76, 0xcd, 0x06, 0x38, 0x6c, 0x6b, 0xd2, 0x6f, 0xe3, 0x20, 0x51, 0xd5, 0xec, 0x9e,
+	0x89, 0xe1, 0x6b, 0xe3, 0xa6, 0x92, 0x17, 0x9f, 0x9e, 0x84, 0xdf, 0xb8, 0xc2, 0x7a, 0xf2, 0xea,
+	0xb3, 0x64, 0x59, 0x0b, 0xcc, 0x1b, 0x36, 0xc1, 0xee, 0xa1, 0x66, 0x8a, 0xca, 0x76, 0x7b, 0xdc,
+	0xa7, 0x7c, 0x33, 0x0d, 0xc1, 0x13, 0x2e, 0xec, 0xbe, 0x9c, 0x84, 0x46, 0x10, 0xf0, 0x7d, 0x10,
+	0xf7, 0x88, 0xe6, 0x92, 0x67, 0xb0, 0x6a, 0x5e, 0x04, 0x72, 0xda, 0xcf, 0x50, 0xcd, 0x25, 0x3c,
+	0x8c, 0x39, 0x04, 0xfc, 0x00, 0xc4, 0x9c, 0x3e, 0xb6, 0x85, 0x65, 0xdf, 0x99, 0x3a, 0xf5, 0x53,
+	0x1c, 0x98, 0x62, 0x48, 0x88, 0x41, 0x51, 0xc8, 0x7d, 0x63, 0x6f, 0x3f, 0x9f, 0x78, 0x3e, 0x48,
+	0x8a, 0x21, 0x21, 0x06, 0x05, 0xdb, 0x60, 0xd6, 0x74, 0x8e, 0xc4, 0x50, 0xfb, 0xf6, 0xd4, 0x88,
+	0x80, 0x23, 0x9a, 0xce, 0x91, 0x84, 0x28, 0x10, 0xec, 0x82, 0xb8, 0x6e, 0x3a, 0x9e, 0x5f, 0xf1,
+	0xde, 0x9d, 0x1a, 0x31, 0xed, 0x77, 0x00, 0xc7, 0xc3, 0x12, 0xe2, 0x60, 0xf0, 0x31, 0x48, 0x1c,
+	0x3a, 0xe6, 0xc0, 0xf2, 0xab, 0xdb, 0xb7, 0xa6, 0x9e, 0x07, 0x44, 0xe4, 0x71, 0x14, 0x09, 0x09,
+	0x38, 0x91, 0xe4, 0xbf, 0x89, 0x83, 0x38, 0x9b, 0x81, 0xe8, 0xcd, 0x8d, 0xcf, 0x48, 0x4f, 0xbf,
+	0xb9, 0xf9, 0x74, 0x09, 0xcd, 0xb1, 0xc7, 0x46, 0x0f, 0x76, 0xc0, 0x82, 0xa5, 0x1d, 0x60, 0x37,
+	0x68, 0x71, 0x51, 0x26, 0xfb, 0xda, 0xf9, 0xb0, 0x98, 0x6e, 0x51, 0x4a, 0xd0, 0xe1, 0x56, 0xfc,
+	0xba, 0x1a, 0xe6, 0x97, 0x50, 0xda, 0x0a, 0xd8, 0x18, 0x20, 0x19, 0x07, 0x9c, 0x0d, 0x00, 0xbb,
+	0x57, 0x02, 0x92, 0x49, 0x40, 0x12, 0x06, 0xbc, 0x0b, 0xe2, 0x4c, 0xc1, 0xe5, 0x6e, 0xcd, 0x96,
+	0x25, 0xc4, 0xc9, 0x94, 0x8f, 0xc9, 0xe5, 0xe3, 0x93, 0x7c, 0x44, 0xf0, 0xb1, 0xbf, 0xff, 0x09,
+	0x0d, 0xb8, 0xeb, 0xb7, 0x8c, 0xe7, 0x8c, 0x44, 0xd1, 0x76, 0x45, 0x0b, 0xf9, 0x30, 0x5c, 0x7b,
+	0x93, 0xd7, 0x56, 0x89, 0xdb, 0x62, 0xb7, 0xb9, 0x60, 0xa0, 0x62, 0x04, 0x69, 0xb2, 0x26, 0xbf,
+	0x06, 0x12, 0xe2, 0xca, 0x01, 0xd8, 0x95, 0x23, 0x54, 0x2d, 0xfd, 0xbb, 0x86, 0x60, 0x10, 0x31,
+	0xfb, 0xfb, 0x04, 0x48, 0x6c, 0x69, 0xae, 0x66, 0x79, 0xb0, 0x0e, 0x72, 0x3a, 0x2b, 0x73, 0xaa,
+	0x8b, 0x89, 0xb8, 0x22, 0xd0, 0xe0, 0xcd, 0xc8, 0xb7, 0x82, 0x46, 0x3e, 0xc9, 0x21, 0xa1, 0x2c,
+	0x5f, 0x42, 0xfe, 0x0a, 0xac, 0x82, 0x2c, 0x0f, 0xee, 0x00, 0x86, 0xc7, 0x71, 0x21, 0x98, 0xcc,
+	0x26, 0x18, 0x24, 0xb4, 0xc0, 0x56, 0x02, 0x90, 0xfb, 0x20, 0xc9, 0x63, 0x7b, 0x17, 0xf3, 0x36,
+	0x97, 0x09, 0xdf, 0x73, 0x46, 0x24, 0x09, 0xcd, 0xb3, 0xe7, 0x3a, 0xc6, 0x54, 0x84, 0x8c, 0x44,
+	0x62, 0x93, 0x22, 0x24, 0x24, 0x42, 0x7c, 0x11, 0x0c, 0xb2, 0xc6, 0xe8, 0x9b, 0x0d, 0x25, 0x7a,
+	0xf9, 0x38, 0x6b, 0xe9, 0x13, 0xe5, 0x3f, 0xf8, 0xb0, 0x53, 0xc7, 0xd8, 0x93, 0xd7, 0x84, 0x3b,
+	0x56, 0xfd, 0x16, 0x30, 0x06, 0x21, 0xa1, 0x05, 0x63, 0x8c, 0x1f, 0x96, 0xc1, 0xbc, 0xa5, 0x1d,
+	0xab, 0xfb, 0x4e, 0xdf, 0x63, 0x81, 0x9e, 0x09, 0x37, 0x10, 0x9f, 0x22, 0xa1, 0x39, 0x4b, 0x3b,
+	0xde, 0x74, 0xfa, 0x1e, 0x94, 0x01, 0x9d, 0x8e, 0x54, 0x5a, 0xac, 0x55, 0x31, 0x69, 0xcc, 0x31,
+	0xb1, 0x90, 0x05, 0x27, 0x18, 0x24, 0x94, 0xb1, 0xb4, 0xe3, 0x4e, 0x1f, 0xdb, 0x2c, 0x57, 0x3d,
+	0xb8, 0x03, 0x0a, 0x94, 0x25, 0xb4, 0xb7, 0x30, 0xdc, 0x3c, 0x83, 0x7b, 0xf5, 0x62, 0x58, 0xbc,
+	0x13, 0xc0, 0x5d, 0xcd, 0x2b, 0xa1, 0x1b, 0x96, 0x76, 0x1c, 0x58, 0x20, 0xa4, 0xe3, 0x08, 0x2c,
+	0x6b, 0xba, 0xee, 0x0c, 0x46, 0x33, 0xb6, 0x69, 0x58, 0x06, 0xf1, 0xf2, 0x49, 0x66, 0xc3, 0xd2,
+	0xb8, 0x0d, 0x37, 0x38, 0x27, 0x13, 0x6d, 0x32, 0x3e, 0xf9, 0x15, 0x61, 0xc7, 0x5b, 0x7c, 0x0f,
+	0x57, 0x61, 0x49, 0x08, 0x6a, 0x97, 0x04, 0xe1, 0x8f, 0xc0, 0x0d, 0x96, 0x47, 0xea, 0xbe, 0xe1,
+	0x11, 0xc7, 0x3d, 0x09, 0x85, 0x1a, 0x10, 0x99, 0x3f, 0x99, 0x4c, 0x35, 0xf1, 0xb9, 0x4f, 0x5e,
+	0x17, 0x4a, 0xd7, 0x42, 0x79, 0x79, 0x19, 0x47, 0xfa, 0x94, 0x66, 0xd6, 0x0a, 0xa3, 0x6e, 0x72,
+	0xe2, 0x28, 0x38, 0x45, 0xea, 0xfc, 0x3d, 0x02, 0xe0, 0xe5, 0x43, 0xc1, 0xff, 0x05, 0x73, 0x62,
+	0xc7, 0x62, 0x62, 0x81, 0xc1, 0x9c, 0x2a, 0x08, 0x12, 0xf2, 0x59, 0xae, 0x72, 0x75, 0xf4, 0xc5,
+	0xba, 0x7a, 0xf6, 0x45, 0xb8, 0x5a, 0xfa, 0x4b, 0x04, 0x2c, 0x8c, 0x67, 0xc1, 0xcb, 0x99, 0xcc,
+	0x5e, 0x4a, 0x1d, 0x90, 0x3e, 0x9b, 0x05, 0xd9, 0xe0, 0x74, 0x68, 0x60, 0xbe, 0xac, 0xe3, 0xa9,
+	0xb4, 0x0f, 0xe8, 0x07, 0xaa, 0x67, 0x7c, 0xec, 0x4f, 0xf3, 0xf2, 0xd4, 0x1d, 0x66, 0xd4, 0x15,
+	0x04, 0x10, 0x3d, 0x99, 0xa1, 0x1f, 0x6c, 0x1b, 0x1f, 0x63, 0x68, 0x81, 0x05, 0xcb, 0x10, 0xfe,
+	0xe5, 0x5a, 0x78, 0xeb, 0x7e, 0x6f, 0xea, 0xd1, 0xc7, 0x9f, 0x38, 0xc6, 0xd0, 0xe8, 0xc4, 0x61,
+	0xf0, 0x18, 0x61, 0xea, 0xbe, 0x07, 0xe6, 0x4d, 0x87, 0x70, 0x45, 0xbc, 0xf7, 0x6f, 0x4c, 0xad,
+	0x28, 0xeb, 0x0f, 0x83, 0x44, 0xa8, 0x98, 0x33, 0x1d, 0x42, 0xd1, 0xa5, 0x7f, 0x46, 0x40, 0x8a,
+	0x0e, 0x58, 0x86, 0xbd, 0xb7, 0xa9, 0x99, 0x84, 0x8e, 0x19, 0x3d, 0x6c, 0x3b, 0x56, 0x3e, 0x32,
+	0x39, 0x66, 0xb0, 0x65, 0x09, 0x71, 0x72, 0xc8, 0x95, 0xd1, 0x29, 0x5d, 0x39, 0xfb, 0xec, 0xae,
+	0xfc, 0xee, 0xf8, 0xd7, 0xc4, 0xeb, 0x47, 0x7f, 0xda, 0x45, 0x22, 0xcf, 0xf6, 0x45, 0x51, 0x54,
+	0x9c, 0x2f, 0x62, 0x20, 0xc9, 0x2e, 0x48, 0xb2, 0x66, 0xf7, 0x5e, 0x4e, 0x80, 0x1e, 0x00, 0x5a,
+	0x6c, 0xd4, 0x1e, 0x3e, 0x34, 0xc2, 0x16, 0xa9, 0x4f, 0x1d, 0xa4, 0xcb, 0x41, 0xf1, 0x19, 0x81,
+	0xb1, 0x79, 0xf5, 0xb8, 0xe6, 0xbf, 0xc2, 0x8f, 0x40, 0xd6, 0xc5, 0xbb, 0xd8, 0xc5, 0xb6, 0xee,
+	0x5f, 0x28, 0x79, 0xb4, 0x6e, 0x4e, 0xad, 0x4e, 0x94, 0xce, 0x09, 0x38, 0x09, 0x2d, 0x8c, 0x56,
+	0xf8, 0x25, 0xf8, 0x2d, 0x40, 0xb7, 0xa0, 0xee, 0xb8, 0x58, 0xd3, 0xf7, 0x59, 0xfb, 0xa7, 0xf5,
+	0xe2, 0xc6, 0xc5, 0xb0, 0xb8, 0x14, 0x6c, 0xd8, 0xa7, 0x4a, 0x28, 0x65, 0x69, 0xc7, 0xb2, 0x78,
+	0x83, 0x3f, 0x00, 0x19, 0x4e, 0x51, 0x8f, 0x0c, 0xbb, 0xe7, 0x1c, 0x8d, 0x86, 0xd8, 0xa7, 0xf6,
+	0x9e, 0x92, 0xe8, 0x3d, 0xc2, 0x18, 0x63, 0xd2, 0xbc, 0xe3, 0xa4, 0xf9, 0xda, 0x63, 0xb6, 0x44,
+	0x35, 0xec, 0x6b, 0x26, 0x51, 0xfd, 0xdf, 0xaa, 0xf2, 0x73, 0x53, 0x6a, 0x18, 0x93, 0x16, 0x1a,
+	0xe8, 0x9a, 0xcf, 0x2f, 0xfd, 0x3c, 0x02, 0x16, 0x47, 0x21, 0x35, 0x3a, 0xd9, 0x5d, 0x10, 0x0f,
+	0x3a, 0x58, 0x26, 0x9c, 0x58, 0xa2, 0x7f, 0x71, 0x32, 0xfc, 0x3e, 0x48, 0xf3, 0xcd, 0xab, 0xfc,
+	0xbe, 0x1b, 0xbd, 0x36, 0xe8, 0x8b, 0x62, 0x7f, 0xc2, 0xba, 0x61, 0x69, 0x1e, 0xf5, 0x29, 0xbe,
+	0xb4, 0xcd, 0x56, 0x4e, 0x40, 0x5a, 0xd6, 0x88, 0xbe, 0xbf, 0x31, 0xd0, 0x59, 0x80, 0xbc, 0x8c,
+	0x90, 0xe7, 0xb9, 0xb6, 0xfe, 0x87, 0x28, 0x48, 0x85, 0xbe, 0x67, 0xc2, 0x32, 0xb8, 0xd9, 0x6d,
+	0xb4, 0x14, 0xb5, 0xd1, 0x56, 0xeb, 0x1d, 0x54, 0x55, 0xd4, 0x47, 0xed, 0xed, 0x2d, 0xa5, 0xda,
+	0xa8, 0x37, 0x94, 0x5a, 0x6e, 0xa6, 0x90, 0x3d, 0x3d, 0x2b, 0xa5, 0x1e, 0xd9, 0x5e, 0x1f, 0xeb,
+	0xc6, 0xae, 0x81, 0x7b, 0xf0, 0xeb, 0x60, 0x6d, 0x9c, 0xff, 0xbd, 0x4e, 0xa7, 0xa6, 0x76, 0x1b,
+	0xcd, 0xa6, 0x5a, 0xdd, 0x68, 0x57, 0x95, 0x66, 0x2e, 0x52, 0x80, 0xa7, 0x67, 0xa5, 0x85, 0xf7,
+	0x1c, 0xa7, 0xd7, 0x35, 0x4c, 0xb3, 0xaa, 0xd9, 0x3a, 0x36, 0xe1, 0xdb, 0xe0, 0xce, 0xb8, 0x5c,
+	0xa3, 0xd5, 0x52, 0x6a, 0x8d, 0x8d, 0xae, 0xa2, 0x76, 0x90, 0x2f, 0x1a, 0x2d, 0xac, 0x9c, 0x9e,
+	0x95, 0x16, 0x1b, 0x96, 0x85, 0x7b, 0x86, 0x46, 0x70, 0xc7, 0x15, 0xd2, 0x65, 0x50, 0x18, 0x97,
+	0xae, 0x53, 0x85, 0x1d, 0xa4, 0x3e, 0x6c, 0x34, 0x9b, 0xb9, 0xd9, 0xc2, 0xc2, 0xe9, 0x59, 0x09,
+	0xd0, 0x1f, 0x54, 0x3a, 0xee, 0x43, 0xc3, 0x34, 0xe1, 0x03, 0x70, 0xfb, 0x69, 0xbb, 0xa4, 0xeb,
+	0xb9, 0x58, 0x21, 0x77, 0x7a, 0x56, 0x4a, 0xfb, 0x7b, 0x64, 0xbf, 0x6e, 0xbc, 0x09, 0xfe, 0xeb,
+	0x69, 0x32, 0x72, 0xb3, 0x53, 0x7d, 0x98, 0x8b, 0x17, 0x16, 0x4f, 0xcf, 0x4a, 0x19, 0x5f, 0x48,
+	0x36, 0x1d, 0xfd, 0xa0, 0x10, 0xfb, 0xe5, 0x67, 0x6b, 0x91, 0xf5, 0x1f, 0x47, 0x40, 0x3a, 0xfc,
+	0xe3, 0x05, 0xbc, 0x03, 0x96, 0xb6, 0x3a, 0xdb, 0x5d, 0xb5, 0xd3, 0x6e, 0x3e, 0x51, 0x5b, 0x9d,
+	0x9a, 0xa2, 0xb6, 0x3b, 0x6d, 0x25, 0x37, 0x53, 0x98, 0x3f, 0x3d, 0x2b, 0xc5, 0xda, 0x8e, 0x8d,
+	0xe1, 0xab, 0x60, 0x65, 0x82, 0x05, 0x29, 0xef, 0x2b, 0xd5, 0x6e, 0x2e, 0x52, 0x00, 0xa7, 0x67,
+	0xa5, 0x04, 0xc2, 0x3f, 0xc4, 0x3a, 0x81, 0xff, 0x03, 0x56, 0x2f, 0xb1, 0x6d, 0xa1, 0x46, 0x55,
+	0xc9, 0x45, 0x0b, 0xa9, 0xd3, 0xb3, 0xd2, 0x1c, 0xc2, 0xac, 0x06, 0xac, 0xff, 0x2e, 0x0a, 0x96,
+	0xae, 0xf8, 0x95, 0x03, 0xde, 0x03, 0x85, 0x6d, 0xa5, 0x59, 0x57, 0xbb, 0x68, 0xa3, 0xa6, 0xa8,
+	0x5b, 0x48, 0xf9, 0x50, 0x69, 0x77, 0x1b, 0x9d, 0xf6, 0xe5, 0x1d, 0x7d, 0x13, 0xbc, 0x72, 0x35,
+	0x27, 0x77, 0x8f, 0xda, 0x56, 0x1e, 0x2b, 0xdb, 0x74, 0x7f, 0xcc, 0x78, 0xdc, 0x35, 0x6d, 0x7c,
+	0x84, 0x3d, 0x72, 0xad, 0x68, 0xa7, 0x59, 0xa3, 0xa2, 0xd1, 0xb0, 0x68, 0xc7, 0xa4, 0xe1, 0x09,
+	0xbf, 0x06, 0xee, 0xfc, 0x5b, 0x51, 0xb9, 0xd3, 0xdd, 0xf4, 0x5d, 0xcc, 0x05, 0x65, 0x87, 0xec,
+	0xc3, 0x3a, 0x58, 0xbf, 0x5a, 0xac, 0xa6, 0x54, 0x91, 0xd2, 0x52, 0xda, 0x5d, 0x75, 0xa3, 0x5d,
+	0xf3, 0x23, 0x2b, 0x56, 0x58, 0x3d, 0x3d, 0x2b, 0xc1, 0x1a, 0xd6, 0x5d, 0x4c, 0x67, 0xa1, 0x0d,
+	0xbb, 0xc7, 0xb1, 0xd6, 0x7f, 0x16, 0x01, 0x99, 0xb1, 0x9f, 0x0d, 0xe0, 0xff, 0x81, 0x5b, 0xdb,
+	0xdd, 0xce, 0x96, 0xda, 0x41, 0x35, 0x05, 0xa9, 0xdd, 0x27, 0x5b, 0xd7, 0x26, 0xc5, 0x7f, 0x83,
+	0x95, 0x49, 0x89, 0x66, 0xa3, 0xd5, 0xa0, 0xa6, 0x4a, 0x9e, 0x9e, 0x95, 0xe2, 0x6c, 0x84, 0x86,
+	0x77, 0xc1, 0xea, 0x24, 0x57, 0x6b, 0x03, 0x3d, 0x54, 0xa8, 0x59, 0x98, 0xc7, 0xf9, 0x97, 0xd4,
+	0xf5, 0x5f, 0x47, 0xc0, 0xc2, 0xf8, 0x87, 0x39, 0xba, 0xa5, 0xea, 0x46, 0xbb, 0xd6, 0xa4, 0xd1,
+	0xd9, 0x55, 0xd0, 0x87, 0x1b, 0xcd, 0xeb, 0xb6, 0x74, 0x17, 0xac, 0x4e, 0x4a, 0xb4, 0x1a, 0xed,
+	0x47, 0x5d, 0xc5, 0x0f, 0xaf, 0x96, 0x61, 0x0f, 0x08, 0x86, 0x12, 0x58, 0x9e, 0xe4, 0xdb, 0xec,
+	0x3c, 0x42, 0xb9, 0x28, 0x8f, 0x8b, 0x4d, 0x67, 0xe0, 0xc2, 0x12, 0x58, 0x9a, 0xe4, 0xa9, 0x6d,
+	0x3c, 0xc9, 0xcd, 0x16, 0xe6, 0x4e, 0xcf, 0x4a, 0xb3, 0x35, 0xed, 0x44, 0x56, 0x3e, 0x3f, 0x5f,
+	0x8b, 0x7c, 0x71, 0xbe, 0x16, 0xf9, 0xdb, 0xf9, 0x5a, 0xe4, 0x93, 0x2f, 0xd7, 0x66, 0xbe, 0xf8,
+	0x72, 0x6d, 0xe6, 0x4f, 0x5f, 0xae, 0xcd, 0x7c, 0xe7, 0xf5, 0x50, 0x6b, 0xc3, 0x6f, 0x58, 0x8e,
+	0x8d, 0x4f, 0x2a, 0xd8, 0x7a, 0xc3, 0xc4, 0xbd, 0x3d, 0xec, 0x56, 0x8e, 0xfd, 0x7f, 0x7d, 0x60,
+	0x3d, 0x6e, 0x27, 0xc1, 0x2a, 0xeb, 0xff, 0xff, 0x6b, 0x00, 0x21, 0x11, 0xc9, 0xb8, 0x14, 0x21,
+	0x00, 0x00,
+}
+
+func (m *Instrument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Instrument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Instrument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Order) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Order) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.DisplayRemaining.Size()
+		i -= size
+		if _, err := m.DisplayRemaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.DisplayQuantity.Size()
+		i -= size
+		if _, err := m.DisplayQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.PostOnly != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.PostOnly))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.ExpireHeight != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.ExpireHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ExpireTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpireTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpireTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintMarket(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x5a
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMarket(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.DestinationFilled.Size()
+		i -= size
+		if _, err := m.DestinationFilled.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.SourceFilled.Size()
+		i -= size
+		if _, err := m.SourceFilled.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SourceRemaining.Size()
+		i -= size
+		if _, err := m.SourceRemaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ClientOrderID) > 0 {
+		i -= len(m.ClientOrderID)
+		copy(dAtA[i:], m.ClientOrderID)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.ClientOrderID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StopOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintMarket(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.StopPrice.Size()
+		i -= size
+		if _, err := m.StopPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ClientOrderID) > 0 {
+		i -= len(m.ClientOrderID)
+		copy(dAtA[i:], m.ClientOrderID)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.ClientOrderID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OrderType != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CumulativePrice.Size()
+		i -= size
+		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Timestamp != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timestamp):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintMarket(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LastPrice != nil {
+		{
+			size := m.LastPrice.Size()
+			i -= size
+			if _, err := m.LastPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintMarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Candle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Candle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Candle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Close.Size()
+		i -= size
+		if _, err := m.Close.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Low.Size()
+		i -= size
+		if _, err := m.Low.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.High.Size()
+		i -= size
+		if _, err := m.High.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Open.Size()
+		i -= size
+		if _, err := m.Open.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Start):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintMarket(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	if m.Interval != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Trade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Trade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Trade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x50
+	}
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintMarket(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Taker) > 0 {
+		i -= len(m.Taker)
+		copy(dAtA[i:], m.Taker)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Taker)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Maker) > 0 {
+		i -= len(m.Maker)
+		copy(dAtA[i:], m.Maker)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Maker)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TakerOrderID != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.TakerOrderID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MakerOrderID != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.MakerOrderID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.PriceHistoryRetention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.PriceHistoryRetention):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintMarket(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x52
+	if len(m.AccountOrderLimits) > 0 {
+		for iNdEx := len(m.AccountOrderLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountOrderLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.MaxInstrumentOpenOrders != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.MaxInstrumentOpenOrders))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxOpenOrders != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.MaxOpenOrders))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxHops != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.MaxHops))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.InstrumentFees) > 0 {
+		for iNdEx := len(m.InstrumentFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InstrumentFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.TakerFee != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.TakerFee))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MakerFee != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.MakerFee))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TradeRetention != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.TradeRetention))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CandleRetention != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.CandleRetention))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountOrderLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountOrderLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountOrderLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxInstrumentOpenOrders != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.MaxInstrumentOpenOrders))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxOpenOrders != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.MaxOpenOrders))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InstrumentFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InstrumentFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InstrumentFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TakerFee != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.TakerFee))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MakerFee != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.MakerFee))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InstrumentRules) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InstrumentRules) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InstrumentRules) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LotSize.Size()
+		i -= size
+		if _, err := m.LotSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinOrderSize.Size()
+		i -= size
+		if _, err := m.MinOrderSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TickSize.Size()
+		i -= size
+		if _, err := m.TickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TradingHalt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradingHalt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradingHalt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpireTime != nil {
+		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpireTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpireTime):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintMarket(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceBand) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceBand) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceBand) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.HaltDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.HaltDuration):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintMarket(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x3a
+	n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.BreachWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.BreachWindow):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintMarket(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x32
+	if m.MaxBreaches != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.MaxBreaches))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.ReferencePrice.Size()
+		i -= size
+		if _, err := m.ReferencePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxDeviation.Size()
+		i -= size
+		if _, err := m.MaxDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceBandBreaches) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceBandBreaches) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceBandBreaches) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowStart):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintMarket(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x12
+	if m.Count != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Instrument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	return n
+}
+
+func (m *Order) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovMarket(uint64(m.ID))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovMarket(uint64(m.TimeInForce))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.ClientOrderID)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = m.Source.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.SourceRemaining.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.SourceFilled.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.Destination.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.DestinationFilled.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovMarket(uint64(l))
+	if m.ExpireTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpireTime)
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.ExpireHeight != 0 {
+		n += 1 + sovMarket(uint64(m.ExpireHeight))
+	}
+	if m.PostOnly != 0 {
+		n += 1 + sovMarket(uint64(m.PostOnly))
+	}
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovMarket(uint64(m.SelfTradePrevention))
+	}
+	l = m.DisplayQuantity.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.DisplayRemaining.Size()
+	n += 2 + l + sovMarket(uint64(l))
+	if m.Priority != 0 {
+		n += 2 + sovMarket(uint64(m.Priority))
+	}
+	return n
+}
+
+func (m *StopOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovMarket(uint64(m.ID))
+	}
+	if m.OrderType != 0 {
+		n += 1 + sovMarket(uint64(m.OrderType))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovMarket(uint64(m.TimeInForce))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.ClientOrderID)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = m.Source.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.Destination.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.StopPrice.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *ExecutionPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.LastPrice != nil {
+		l = m.LastPrice.Size()
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.Timestamp != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timestamp)
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = m.CumulativePrice.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *Candle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovMarket(uint64(m.Interval))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.Open.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.High.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.Low.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.Close.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.Volume.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *Trade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovMarket(uint64(m.ID))
+	}
+	if m.MakerOrderID != 0 {
+		n += 1 + sovMarket(uint64(m.MakerOrderID))
+	}
+	if m.TakerOrderID != 0 {
+		n += 1 + sovMarket(uint64(m.TakerOrderID))
+	}
+	l = len(m.Maker)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Taker)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = m.Source.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.Destination.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovMarket(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovMarket(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CandleRetention != 0 {
+		n += 1 + sovMarket(uint64(m.CandleRetention))
+	}
+	if m.TradeRetention != 0 {
+		n += 1 + sovMarket(uint64(m.TradeRetention))
+	}
+	if m.MakerFee != 0 {
+		n += 1 + sovMarket(uint64(m.MakerFee))
+	}
+	if m.TakerFee != 0 {
+		n += 1 + sovMarket(uint64(m.TakerFee))
+	}
+	if len(m.InstrumentFees) > 0 {
+		for _, e := range m.InstrumentFees {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	if m.MaxHops != 0 {
+		n += 1 + sovMarket(uint64(m.MaxHops))
+	}
+	if m.MaxOpenOrders != 0 {
+		n += 1 + sovMarket(uint64(m.MaxOpenOrders))
+	}
+	if m.MaxInstrumentOpenOrders != 0 {
+		n += 1 + sovMarket(uint64(m.MaxInstrumentOpenOrders))
+	}
+	if len(m.AccountOrderLimits) > 0 {
+		for _, e := range m.AccountOrderLimits {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.PriceHistoryRetention)
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *AccountOrderLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.MaxOpenOrders != 0 {
+		n += 1 + sovMarket(uint64(m.MaxOpenOrders))
+	}
+	if m.MaxInstrumentOpenOrders != 0 {
+		n += 1 + sovMarket(uint64(m.MaxInstrumentOpenOrders))
+	}
+	return n
+}
+
+func (m *InstrumentFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.MakerFee != 0 {
+		n += 1 + sovMarket(uint64(m.MakerFee))
+	}
+	if m.TakerFee != 0 {
+		n += 1 + sovMarket(uint64(m.TakerFee))
+	}
+	return n
+}
+
+func (m *InstrumentRules) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = m.TickSize.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.MinOrderSize.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.LotSize.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *TradingHalt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.ExpireTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpireTime)
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	return n
+}
+
+func (m *PriceBand) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = m.MaxDeviation.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.ReferencePrice.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if m.MaxBreaches != 0 {
+		n += 1 + sovMarket(uint64(m.MaxBreaches))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.BreachWindow)
+	n += 1 + l + sovMarket(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.HaltDuration)
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *PriceBandBreaches) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovMarket(uint64(m.Count))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *BatchAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	return n
+}
+
+func sovMarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMarket(x uint64) (n int) {
+	return sovMarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Instrument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Instrument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Instrument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Order) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientOrderID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientOrderID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceRemaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceFilled", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceFilled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationFilled", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DestinationFilled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpireTime == nil {
+				m.ExpireTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireHeight", wireType)
+			}
+			m.ExpireHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostOnly", wireType)
+			}
+			m.PostOnly = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostOnly |= PostOnlyMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DisplayQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayRemaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DisplayRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= StopOrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientOrderID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientOrderID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StopPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutionPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, &Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.LastPrice = &v
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Candle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Candle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Candle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= CandleInterval(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Open.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.High.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Low.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Close.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Trade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerOrderID", wireType)
+			}
+			m.MakerOrderID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerOrderID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerOrderID", wireType)
+			}
+			m.TakerOrderID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerOrderID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Maker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Taker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Taker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandleRetention", wireType)
+			}
+			m.CandleRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CandleRetention |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeRetention", wireType)
+			}
+			m.TradeRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFee", wireType)
+			}
+			m.MakerFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerFee |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			m.TakerFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerFee |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstrumentFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstrumentFees = append(m.InstrumentFees, InstrumentFees{})
+			if err := m.InstrumentFees[len(m.InstrumentFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHops", wireType)
+			}
+			m.MaxHops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHops |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenOrders", wireType)
+			}
+			m.MaxOpenOrders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOpenOrders |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInstrumentOpenOrders", wireType)
+			}
+			m.MaxInstrumentOpenOrders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInstrumentOpenOrders |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountOrderLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountOrderLimits = append(m.AccountOrderLimits, AccountOrderLimits{})
+			if err := m.AccountOrderLimits[len(m.AccountOrderLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.PriceHistoryRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountOrderLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountOrderLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountOrderLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenOrders", wireType)
+			}
+			m.MaxOpenOrders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOpenOrders |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInstrumentOpenOrders", wireType)
+			}
+			m.MaxInstrumentOpenOrders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInstrumentOpenOrders |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InstrumentFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InstrumentFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InstrumentFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFee", wireType)
+			}
+			m.MakerFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerFee |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			m.TakerFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerFee |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MarketData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovMarket(uint64(l))
-	}
-	l = len(m.Destination)
-	if l > 0 {
-		n += 1 + l + sovMarket(uint64(l))
-	}
-	if m.LastPrice != nil {
-		l = m.LastPrice.Size()
-		n += 1 + l + sovMarket(uint64(l))
-	}
-	if m.Timestamp != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timestamp)
-		n += 1 + l + sovMarket(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovMarket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMarket(x uint64) (n int) {
-	return sovMarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Instrument) Unmarshal(dAtA []byte) error {
+func (m *InstrumentRules) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -783,10 +5651,10 @@ func (m *Instrument) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Instrument: wiretype end group for non-group")
+			return fmt.Errorf("proto: InstrumentRules: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Instrument: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InstrumentRules: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -853,6 +5721,108 @@ func (m *Instrument) Unmarshal(dAtA []byte) error {
 			}
 			m.Destination = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOrderSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOrderSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LotSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarket(dAtA[iNdEx:])
@@ -874,7 +5844,7 @@ func (m *Instrument) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Order) Unmarshal(dAtA []byte) error {
+func (m *TradingHalt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -897,17 +5867,17 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+			return fmt.Errorf("proto: TradingHalt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TradingHalt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.ID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -917,16 +5887,29 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			m.TimeInForce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -936,14 +5919,27 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -960,22 +5956,108 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthMarket
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if m.ExpireTime == nil {
+				m.ExpireTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceBand) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceBand: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceBand: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientOrderID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1003,13 +6085,13 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientOrderID = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -1019,28 +6101,27 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarket
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceRemaining", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1068,13 +6149,13 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SourceRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceFilled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1102,15 +6183,15 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SourceFilled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReferencePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBreaches", wireType)
 			}
-			var msglen int
+			m.MaxBreaches = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -1120,30 +6201,16 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxBreaches |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMarket
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMarket
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationFilled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BreachWindow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -1153,29 +6220,28 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMarket
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DestinationFilled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.BreachWindow, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1202,7 +6268,7 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.HaltDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1227,7 +6293,7 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecutionPlan) Unmarshal(dAtA []byte) error {
+func (m *PriceBandBreaches) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1250,17 +6316,17 @@ func (m *ExecutionPlan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutionPlan: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceBandBreaches: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutionPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceBandBreaches: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -1270,65 +6336,14 @@ func (m *ExecutionPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Count |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMarket
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMarket
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstOrder", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMarket
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMarket
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMarket
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.FirstOrder == nil {
-				m.FirstOrder = &Order{}
-			}
-			if err := m.FirstOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1355,10 +6370,7 @@ func (m *ExecutionPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecondOrder == nil {
-				m.SecondOrder = &Order{}
-			}
-			if err := m.SecondOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1383,7 +6395,7 @@ func (m *ExecutionPlan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketData) Unmarshal(dAtA []byte) error {
+func (m *BatchAuction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1406,10 +6418,10 @@ func (m *MarketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchAuction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1476,78 +6488,6 @@ func (m *MarketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Destination = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMarket
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMarket
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMarket
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.LastPrice = &v
-			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMarket
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMarket
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMarket
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Timestamp == nil {
-				m.Timestamp = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarket(dAtA[iNdEx:])
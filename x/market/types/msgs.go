@@ -15,8 +15,17 @@ var (
 	_ sdk.Msg = &MsgAddLimitOrder{}
 	_ sdk.Msg = &MsgAddMarketOrder{}
 	_ sdk.Msg = &MsgCancelOrder{}
+	_ sdk.Msg = &MsgCancelAllOrders{}
 	_ sdk.Msg = &MsgCancelReplaceLimitOrder{}
 	_ sdk.Msg = &MsgCancelReplaceMarketOrder{}
+	_ sdk.Msg = &MsgAddStopOrder{}
+	_ sdk.Msg = &MsgSetFees{}
+	_ sdk.Msg = &MsgSetInstrumentRules{}
+	_ sdk.Msg = &MsgHaltTrading{}
+	_ sdk.Msg = &MsgResumeTrading{}
+	_ sdk.Msg = &MsgSetPriceBand{}
+	_ sdk.Msg = &MsgSetOrderLimits{}
+	_ sdk.Msg = &MsgSetBatchAuction{}
 )
 
 func (m MsgAddMarketOrder) Route() string {
@@ -90,6 +99,10 @@ func (m MsgCancelReplaceLimitOrder) ValidateBasic() error {
 		return sdkerrors.Wrapf(ErrInvalidInstrument, "'%v/%v' is not a valid instrument", m.Source.Denom, m.Destination.Denom)
 	}
 
+	if !m.DisplayQuantity.IsNil() && m.DisplayQuantity.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidDisplayQuantity, "display quantity cannot be negative: %v", m.DisplayQuantity)
+	}
+
 	err := validateClientOrderID(m.OrigClientOrderId)
 	if err != nil {
 		return err
@@ -138,6 +151,46 @@ func (m MsgCancelOrder) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{from}
 }
 
+func (m MsgCancelAllOrders) Route() string {
+	return RouterKey
+}
+
+func (m MsgCancelAllOrders) Type() string {
+	return "cancel_all_orders"
+}
+
+func (m MsgCancelAllOrders) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	if m.Source != "" {
+		if err := sdk.ValidateDenom(m.Source); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "source denomination is invalid: %v", m.Source)
+		}
+	}
+
+	if m.Destination != "" {
+		if err := sdk.ValidateDenom(m.Destination); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "destination denomination is invalid: %v", m.Destination)
+		}
+	}
+
+	return nil
+}
+
+func (m MsgCancelAllOrders) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgCancelAllOrders) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
 func (m MsgAddLimitOrder) Route() string {
 	return RouterKey
 }
@@ -163,6 +216,10 @@ func (m MsgAddLimitOrder) ValidateBasic() error {
 		return sdkerrors.Wrapf(ErrInvalidInstrument, "'%v/%v' is not a valid instrument", m.Source.Denom, m.Destination.Denom)
 	}
 
+	if !m.DisplayQuantity.IsNil() && m.DisplayQuantity.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidDisplayQuantity, "display quantity cannot be negative: %v", m.DisplayQuantity)
+	}
+
 	return validateClientOrderID(m.ClientOrderId)
 }
 
@@ -229,4 +286,297 @@ func (m MsgCancelReplaceMarketOrder) GetSigners() []sdk.AccAddress {
 		panic(err)
 	}
 	return []sdk.AccAddress{from}
-}
\ No newline at end of file
+}
+
+func (m MsgAddStopOrder) Route() string {
+	return RouterKey
+}
+
+func (m MsgAddStopOrder) Type() string {
+	return "add_stop_order"
+}
+
+func (m MsgAddStopOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	if !m.Destination.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "destination amount is invalid: %v", m.Destination.String())
+	}
+
+	if !m.Source.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "source amount is invalid: %v", m.Source.String())
+	}
+
+	if m.Source.Denom == m.Destination.Denom {
+		return sdkerrors.Wrapf(ErrInvalidInstrument, "'%v/%v' is not a valid instrument", m.Source.Denom, m.Destination.Denom)
+	}
+
+	if m.StopPrice.IsNil() || !m.StopPrice.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidStopOrder, "Stop price must be positive")
+	}
+
+	switch m.OrderType {
+	case StopOrderType_Limit:
+		if !m.Source.IsPositive() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "source amount is invalid: %v", m.Source.String())
+		}
+	case StopOrderType_Market:
+		if !m.Source.Amount.IsZero() {
+			return sdkerrors.Wrapf(ErrInvalidStopOrder, "Market stop orders only specify the source denomination")
+		}
+		if m.MaxSlippage.IsNil() || m.MaxSlippage.IsNegative() {
+			return sdkerrors.Wrapf(ErrInvalidSlippage, "Cannot be negative")
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidStopOrder, "Unknown stop order type specified : %v", m.OrderType)
+	}
+
+	return validateClientOrderID(m.ClientOrderId)
+}
+
+func (m MsgAddStopOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgAddStopOrder) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (m MsgSetFees) Route() string {
+	return RouterKey
+}
+
+func (m MsgSetFees) Type() string {
+	return "set_fees"
+}
+
+func (m MsgSetFees) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if err := validateFeeRate(m.MakerFee); err != nil {
+		return sdkerrors.Wrap(ErrInvalidFees, err.Error())
+	}
+
+	if err := validateFeeRate(m.TakerFee); err != nil {
+		return sdkerrors.Wrap(ErrInvalidFees, err.Error())
+	}
+
+	if err := validateInstrumentFees(m.InstrumentFees); err != nil {
+		return sdkerrors.Wrap(ErrInvalidFees, err.Error())
+	}
+
+	return nil
+}
+
+func (m MsgSetFees) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetFees) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (m MsgSetInstrumentRules) Route() string {
+	return RouterKey
+}
+
+func (m MsgSetInstrumentRules) Type() string {
+	return "set_instrument_rules"
+}
+
+func (m MsgSetInstrumentRules) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	rules := NewInstrumentRules(m.Source, m.Destination, m.TickSize, m.MinOrderSize, m.LotSize)
+	if err := rules.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInstrumentRules, err.Error())
+	}
+
+	return nil
+}
+
+func (m MsgSetInstrumentRules) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetInstrumentRules) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (m MsgHaltTrading) Route() string {
+	return RouterKey
+}
+
+func (m MsgHaltTrading) Type() string {
+	return "halt_trading"
+}
+
+func (m MsgHaltTrading) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if err := m.Halt.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidTradingHalt, err.Error())
+	}
+
+	return nil
+}
+
+func (m MsgHaltTrading) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgHaltTrading) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (m MsgResumeTrading) Route() string {
+	return RouterKey
+}
+
+func (m MsgResumeTrading) Type() string {
+	return "resume_trading"
+}
+
+func (m MsgResumeTrading) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if err := m.Halt.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidTradingHalt, err.Error())
+	}
+
+	return nil
+}
+
+func (m MsgResumeTrading) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgResumeTrading) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (m MsgSetPriceBand) Route() string {
+	return RouterKey
+}
+
+func (m MsgSetPriceBand) Type() string {
+	return "set_price_band"
+}
+
+func (m MsgSetPriceBand) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if err := m.Band.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPriceBand, err.Error())
+	}
+
+	return nil
+}
+
+func (m MsgSetPriceBand) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetPriceBand) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (m MsgSetOrderLimits) Route() string {
+	return RouterKey
+}
+
+func (m MsgSetOrderLimits) Type() string {
+	return "set_order_limits"
+}
+
+func (m MsgSetOrderLimits) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if err := validateAccountOrderLimits(m.AccountOrderLimits); err != nil {
+		return sdkerrors.Wrap(ErrInvalidOrderLimits, err.Error())
+	}
+
+	return nil
+}
+
+func (m MsgSetOrderLimits) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetOrderLimits) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (m MsgSetBatchAuction) Route() string {
+	return RouterKey
+}
+
+func (m MsgSetBatchAuction) Type() string {
+	return "set_batch_auction"
+}
+
+func (m MsgSetBatchAuction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if err := m.Auction.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidBatchAuction, err.Error())
+	}
+
+	return nil
+}
+
+func (m MsgSetBatchAuction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetBatchAuction) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
@@ -0,0 +1,303 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package types
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+const (
+	// Keep a day of one-minute candles, two months of hourly candles and four years of daily candles.
+	DefaultCandleRetention = uint32(1440)
+	DefaultTradeRetention  = uint64(10000)
+
+	// The retention window of daily candles must be representable as a time.Duration.
+	MaxCandleRetention = uint32(math.MaxInt64 / int64(24*time.Hour))
+
+	// Fee rates are expressed in basis points and cannot exceed the traded amount.
+	feeRateDenominator = 10000
+	MaxFeeRate         = uint32(feeRateDenominator)
+
+	// Routes through up to two intermediate denominations. Route search grows with the number of instruments for every
+	// additional hop, so the parameter is capped.
+	DefaultMaxHops = uint32(3)
+	MaxMaxHops     = uint32(5)
+
+	// Every resting order of an account is revisited when its balance changes, so the number of orders is bounded.
+	DefaultMaxOpenOrders           = uint32(200)
+	DefaultMaxInstrumentOpenOrders = uint32(50)
+
+	// Time-weighted average prices can be queried over windows of up to a day.
+	DefaultPriceHistoryRetention = 24 * time.Hour
+)
+
+// Parameter store keys
+var (
+	KeyCandleRetention = []byte("CandleRetention")
+	KeyTradeRetention  = []byte("TradeRetention")
+	KeyMakerFee        = []byte("MakerFee")
+	KeyTakerFee        = []byte("TakerFee")
+	KeyInstrumentFees  = []byte("InstrumentFees")
+	KeyMaxHops         = []byte("MaxHops")
+
+	KeyMaxOpenOrders           = []byte("MaxOpenOrders")
+	KeyMaxInstrumentOpenOrders = []byte("MaxInstrumentOpenOrders")
+	KeyAccountOrderLimits      = []byte("AccountOrderLimits")
+
+	KeyPriceHistoryRetention = []byte("PriceHistoryRetention")
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+func NewParams(
+	candleRetention uint32, tradeRetention uint64, makerFee, takerFee uint32, instrumentFees []InstrumentFees, maxHops uint32,
+	maxOpenOrders, maxInstrumentOpenOrders uint32, accountOrderLimits []AccountOrderLimits, priceHistoryRetention time.Duration,
+) Params {
+	return Params{
+		CandleRetention:         candleRetention,
+		TradeRetention:          tradeRetention,
+		MakerFee:                makerFee,
+		TakerFee:                takerFee,
+		InstrumentFees:          instrumentFees,
+		MaxHops:                 maxHops,
+		MaxOpenOrders:           maxOpenOrders,
+		MaxInstrumentOpenOrders: maxInstrumentOpenOrders,
+		AccountOrderLimits:      accountOrderLimits,
+		PriceHistoryRetention:   priceHistoryRetention,
+	}
+}
+
+func DefaultParams() Params {
+	return NewParams(
+		DefaultCandleRetention, DefaultTradeRetention, 0, 0, nil, DefaultMaxHops,
+		DefaultMaxOpenOrders, DefaultMaxInstrumentOpenOrders, nil, DefaultPriceHistoryRetention,
+	)
+}
+
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyCandleRetention, &p.CandleRetention, validateCandleRetention),
+		paramtypes.NewParamSetPair(KeyTradeRetention, &p.TradeRetention, validateTradeRetention),
+		paramtypes.NewParamSetPair(KeyMakerFee, &p.MakerFee, validateFeeRate),
+		paramtypes.NewParamSetPair(KeyTakerFee, &p.TakerFee, validateFeeRate),
+		paramtypes.NewParamSetPair(KeyInstrumentFees, &p.InstrumentFees, validateInstrumentFees),
+		paramtypes.NewParamSetPair(KeyMaxHops, &p.MaxHops, validateMaxHops),
+		paramtypes.NewParamSetPair(KeyMaxOpenOrders, &p.MaxOpenOrders, validateOrderLimit),
+		paramtypes.NewParamSetPair(KeyMaxInstrumentOpenOrders, &p.MaxInstrumentOpenOrders, validateOrderLimit),
+		paramtypes.NewParamSetPair(KeyAccountOrderLimits, &p.AccountOrderLimits, validateAccountOrderLimits),
+		paramtypes.NewParamSetPair(KeyPriceHistoryRetention, &p.PriceHistoryRetention, validatePriceHistoryRetention),
+	}
+}
+
+func (p Params) Validate() error {
+	if err := validateCandleRetention(p.CandleRetention); err != nil {
+		return err
+	}
+
+	if err := validateTradeRetention(p.TradeRetention); err != nil {
+		return err
+	}
+
+	if err := validateFeeRate(p.MakerFee); err != nil {
+		return err
+	}
+
+	if err := validateFeeRate(p.TakerFee); err != nil {
+		return err
+	}
+
+	if err := validateInstrumentFees(p.InstrumentFees); err != nil {
+		return err
+	}
+
+	if err := validateMaxHops(p.MaxHops); err != nil {
+		return err
+	}
+
+	if err := validateAccountOrderLimits(p.AccountOrderLimits); err != nil {
+		return err
+	}
+
+	return validatePriceHistoryRetention(p.PriceHistoryRetention)
+}
+
+// FeeRates returns the maker and taker fee rates of trades between src and dst, in either direction.
+func (p Params) FeeRates(src, dst string) (makerFee, takerFee uint32) {
+	for _, f := range p.InstrumentFees {
+		if f.matches(src, dst) {
+			return f.MakerFee, f.TakerFee
+		}
+	}
+
+	return p.MakerFee, p.TakerFee
+}
+
+// OrderLimits returns the maximum number of resting orders of account in total and in a single instrument. Zero imposes
+// no limit.
+func (p Params) OrderLimits(account string) (maxOpenOrders, maxInstrumentOpenOrders uint32) {
+	for _, l := range p.AccountOrderLimits {
+		if l.Account == account {
+			return l.MaxOpenOrders, l.MaxInstrumentOpenOrders
+		}
+	}
+
+	return p.MaxOpenOrders, p.MaxInstrumentOpenOrders
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf("Candle retention: %v\nTrade retention: %v\nMaker fee: %v bps\nTaker fee: %v bps\nInstrument fees: %v\nMax hops: %v\n"+
+		"Max open orders: %v\nMax instrument open orders: %v\nAccount order limits: %v\nPrice history retention: %v",
+		p.CandleRetention, p.TradeRetention, p.MakerFee, p.TakerFee, p.InstrumentFees, p.MaxHops,
+		p.MaxOpenOrders, p.MaxInstrumentOpenOrders, p.AccountOrderLimits, p.PriceHistoryRetention)
+}
+
+func (f InstrumentFees) matches(src, dst string) bool {
+	return (f.Source == src && f.Destination == dst) || (f.Source == dst && f.Destination == src)
+}
+
+// TradingFee returns the fee at rate basis points of amount, rounded down.
+func TradingFee(amount sdk.Int, rate uint32) sdk.Int {
+	return amount.MulRaw(int64(rate)).QuoRaw(feeRateDenominator)
+}
+
+func validateCandleRetention(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 || v > MaxCandleRetention {
+		return fmt.Errorf("candle retention must be between 1 and %v: %v", MaxCandleRetention, v)
+	}
+
+	return nil
+}
+
+func validateTradeRetention(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("trade retention must be positive")
+	}
+
+	return nil
+}
+
+func validateFeeRate(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxFeeRate {
+		return fmt.Errorf("fee rate cannot exceed %v basis points: %v", MaxFeeRate, v)
+	}
+
+	return nil
+}
+
+func validateInstrumentFees(i interface{}) error {
+	v, ok := i.([]InstrumentFees)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for idx, f := range v {
+		if err := sdk.ValidateDenom(f.Source); err != nil {
+			return fmt.Errorf("invalid instrument fee source: %w", err)
+		}
+
+		if err := sdk.ValidateDenom(f.Destination); err != nil {
+			return fmt.Errorf("invalid instrument fee destination: %w", err)
+		}
+
+		if f.Source == f.Destination {
+			return fmt.Errorf("instrument fee source and destination are identical: %v", f.Source)
+		}
+
+		if err := validateFeeRate(f.MakerFee); err != nil {
+			return err
+		}
+
+		if err := validateFeeRate(f.TakerFee); err != nil {
+			return err
+		}
+
+		for _, prev := range v[:idx] {
+			if prev.matches(f.Source, f.Destination) {
+				return fmt.Errorf("duplicate instrument fees: %v/%v", f.Source, f.Destination)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateMaxHops(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 || v > MaxMaxHops {
+		return fmt.Errorf("max hops must be between 1 and %v: %v", MaxMaxHops, v)
+	}
+
+	return nil
+}
+
+func validateOrderLimit(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateAccountOrderLimits(i interface{}) error {
+	v, ok := i.([]AccountOrderLimits)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for idx, l := range v {
+		if _, err := sdk.AccAddressFromBech32(l.Account); err != nil {
+			return fmt.Errorf("invalid order limits account: %w", err)
+		}
+
+		for _, prev := range v[:idx] {
+			if prev.Account == l.Account {
+				return fmt.Errorf("duplicate order limits: %v", l.Account)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validatePriceHistoryRetention(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("price history retention must be positive: %v", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewPriceBand(src, dst string, maxDeviation, referencePrice sdk.Dec, maxBreaches uint32, breachWindow, haltDuration time.Duration) PriceBand {
+	return PriceBand{
+		Source:         src,
+		Destination:    dst,
+		MaxDeviation:   maxDeviation,
+		ReferencePrice: referencePrice,
+		MaxBreaches:    maxBreaches,
+		BreachWindow:   breachWindow,
+		HaltDuration:   haltDuration,
+	}
+}
+
+func (b PriceBand) Validate() error {
+	if err := sdk.ValidateDenom(b.Source); err != nil {
+		return fmt.Errorf("invalid source denomination: %w", err)
+	}
+
+	if err := sdk.ValidateDenom(b.Destination); err != nil {
+		return fmt.Errorf("invalid destination denomination: %w", err)
+	}
+
+	if b.Source == b.Destination {
+		return fmt.Errorf("'%v/%v' is not a valid instrument", b.Source, b.Destination)
+	}
+
+	if b.MaxDeviation.IsNil() || b.MaxDeviation.IsNegative() {
+		return fmt.Errorf("maximum deviation cannot be negative: %v", b.MaxDeviation)
+	}
+
+	if b.ReferencePrice.IsNil() || b.ReferencePrice.IsNegative() {
+		return fmt.Errorf("reference price cannot be negative: %v", b.ReferencePrice)
+	}
+
+	if b.BreachWindow < 0 || b.HaltDuration < 0 {
+		return fmt.Errorf("durations cannot be negative")
+	}
+
+	if b.MaxBreaches > 0 && (b.BreachWindow == 0 || b.HaltDuration == 0) {
+		return fmt.Errorf("a band that halts trading requires a breach window and a halt duration")
+	}
+
+	return nil
+}
+
+// IsEmpty reports whether the band imposes no constraint at all.
+func (b PriceBand) IsEmpty() bool {
+	return b.MaxDeviation.IsZero()
+}
+
+// Breached reports whether a trade at price, expressed as dst per src, deviates from the reference price by more than
+// the band allows. The reference price is expressed in the orientation of the band.
+func (b PriceBand) Breached(src string, price, reference sdk.Dec) bool {
+	if !reference.IsPositive() || !price.IsPositive() {
+		return false
+	}
+
+	if src != b.Source {
+		price = sdk.OneDec().Quo(price)
+	}
+
+	return price.Sub(reference).Abs().Quo(reference).GT(b.MaxDeviation)
+}
@@ -0,0 +1,38 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CumulativePriceAt extends the cumulative price of the market data to t, as the last price holds until the next trade.
+func (md MarketData) CumulativePriceAt(t time.Time) sdk.Dec {
+	cumulativePrice := md.CumulativePrice
+	// Market data recorded before prices were accumulated starts from zero.
+	if cumulativePrice.IsNil() {
+		cumulativePrice = sdk.ZeroDec()
+	}
+
+	if md.LastPrice == nil || md.Timestamp == nil || !t.After(*md.Timestamp) {
+		return cumulativePrice
+	}
+
+	return cumulativePrice.Add(md.LastPrice.Mul(seconds(t.Sub(*md.Timestamp))))
+}
+
+// TimeWeightedAveragePrice returns the average of the last price over the window from start to end, weighted by the
+// time each price held. Both market data must belong to the same instrument and have been recorded at or before the
+// respective end of the window.
+func TimeWeightedAveragePrice(start, end MarketData, window time.Duration, endTime time.Time) sdk.Dec {
+	startTime := endTime.Add(-window)
+	return end.CumulativePriceAt(endTime).Sub(start.CumulativePriceAt(startTime)).Quo(seconds(window))
+}
+
+func seconds(d time.Duration) sdk.Dec {
+	return sdk.NewDec(d.Nanoseconds()).QuoInt64(int64(time.Second))
+}
@@ -46,3 +46,45 @@ func (q QueryInstrumentsResponse) String() string {
 func (q QueryInstrumentsResponse_Element) String() string {
 	return fmt.Sprintf("%v => %v", q.Source, q.Destination)
 }
+
+func (q QueryOrderBookResponse) String() string {
+	sb := new(strings.Builder)
+
+	sb.WriteString(fmt.Sprintf("%v => %v\n", q.Source, q.Destination))
+
+	for _, level := range q.Levels {
+		sb.WriteString(level.String())
+	}
+
+	for _, level := range q.SyntheticLevels {
+		sb.WriteString(level.String())
+	}
+
+	return sb.String()
+}
+
+func (l OrderBookLevel) String() string {
+	if l.Intermediate != "" {
+		return fmt.Sprintf(" - %v %v via %v\n", l.Price, l.SourceRemaining, l.Intermediate)
+	}
+
+	return fmt.Sprintf(" - %v %v (%v)\n", l.Price, l.SourceRemaining, l.OrderCount)
+}
+
+func (q QueryQuoteResponse) String() string {
+	sb := new(strings.Builder)
+
+	sb.WriteString(fmt.Sprintf("%v => %v @ %v\n", q.SourceFilled, q.DestinationFilled, q.AveragePrice))
+	sb.WriteString(fmt.Sprintf("Fee      : %v\n", q.Fee))
+	sb.WriteString(fmt.Sprintf("Remaining: %v\n", q.DestinationRemaining))
+
+	for _, leg := range q.Route {
+		sb.WriteString(leg.String())
+	}
+
+	return sb.String()
+}
+
+func (l QuoteLeg) String() string {
+	return fmt.Sprintf(" - %v => %v @ %v\n", l.Source, l.Destination, l.Price)
+}
@@ -0,0 +1,101 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Scales a Dec to the integer it is stored as.
+var decPrecisionMultiplier = sdk.NewIntWithDecimal(1, sdk.Precision)
+
+func NewInstrumentRules(src, dst string, tickSize sdk.Dec, minOrderSize, lotSize sdk.Int) InstrumentRules {
+	return InstrumentRules{
+		Source:       src,
+		Destination:  dst,
+		TickSize:     tickSize,
+		MinOrderSize: minOrderSize,
+		LotSize:      lotSize,
+	}
+}
+
+func (r InstrumentRules) Validate() error {
+	if err := sdk.ValidateDenom(r.Source); err != nil {
+		return fmt.Errorf("invalid source denomination: %w", err)
+	}
+
+	if err := sdk.ValidateDenom(r.Destination); err != nil {
+		return fmt.Errorf("invalid destination denomination: %w", err)
+	}
+
+	if r.Source == r.Destination {
+		return fmt.Errorf("'%v/%v' is not a valid instrument", r.Source, r.Destination)
+	}
+
+	if r.TickSize.IsNil() || r.TickSize.IsNegative() {
+		return fmt.Errorf("tick size cannot be negative: %v", r.TickSize)
+	}
+
+	if r.MinOrderSize.IsNil() || r.MinOrderSize.IsNegative() {
+		return fmt.Errorf("minimum order size cannot be negative: %v", r.MinOrderSize)
+	}
+
+	if r.LotSize.IsNil() || r.LotSize.IsNegative() {
+		return fmt.Errorf("lot size cannot be negative: %v", r.LotSize)
+	}
+
+	return nil
+}
+
+// IsEmpty reports whether the rules impose no constraint at all.
+func (r InstrumentRules) IsEmpty() bool {
+	return r.TickSize.IsZero() && r.MinOrderSize.IsZero() && r.LotSize.IsZero()
+}
+
+// ValidateOrder checks the order's source amount against the minimum order size and the lot size. The limit price of
+// orders that can rest on the book must be a multiple of the tick size.
+func (r InstrumentRules) ValidateOrder(order Order) error {
+	if r.MinOrderSize.IsPositive() && order.Source.Amount.LT(r.MinOrderSize) {
+		return sdkerrors.Wrapf(ErrOrderBelowMinimumSize, "%v is below the minimum order size of %v%v", order.Source, r.MinOrderSize, r.Source)
+	}
+
+	if r.LotSize.IsPositive() && !order.Source.Amount.Mod(r.LotSize).IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidLotSize, "%v is not a multiple of the lot size of %v%v", order.Source, r.LotSize, r.Source)
+	}
+
+	// Immediate orders never rest on the book. Their price is often derived from a slippage rather than chosen.
+	if order.TimeInForce == TimeInForce_ImmediateOrCancel || order.TimeInForce == TimeInForce_FillOrKill {
+		return nil
+	}
+
+	if r.TickSize.IsPositive() && !isPriceOnTick(order.Source.Amount, order.Destination.Amount, r.TickSize) {
+		return sdkerrors.Wrapf(ErrInvalidTickSize, "price %v of %v -> %v is not a multiple of the tick size %v", order.Price(), order.Source, order.Destination, r.TickSize)
+	}
+
+	return nil
+}
+
+// NextTickDestination returns the destination amount of an order selling src at the first tick above price. Returns
+// false if that price does not buy a whole destination amount.
+func (r InstrumentRules) NextTickDestination(src sdk.Int, price sdk.Dec) (sdk.Int, bool) {
+	scaledTick := sdk.NewIntFromBigInt(r.TickSize.BigInt())
+	ticks := sdk.NewIntFromBigInt(price.BigInt()).Quo(scaledTick).AddRaw(1)
+
+	scaledDst := ticks.Mul(scaledTick).Mul(src)
+	if !scaledDst.Mod(decPrecisionMultiplier).IsZero() {
+		return sdk.Int{}, false
+	}
+
+	return scaledDst.Quo(decPrecisionMultiplier), true
+}
+
+// The price dst / src is a multiple of the tick if dst * 10^18 is a multiple of src * tick * 10^18.
+func isPriceOnTick(src, dst sdk.Int, tick sdk.Dec) bool {
+	scaledTick := sdk.NewIntFromBigInt(tick.BigInt())
+	return dst.Mul(decPrecisionMultiplier).Mod(src.Mul(scaledTick)).IsZero()
+}
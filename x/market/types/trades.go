@@ -0,0 +1,63 @@
+// This software is Copyright (c) 2019-2020 e-Money A/S. It is not offered under an open source license.
+//
+// Please contact partners@e-money.com for licensing related questions.
+
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewTrade records a fill of maker by taker, in which the maker sold source for destination.
+func NewTrade(maker, taker Order, source, destination sdk.Coin, timestamp time.Time, height int64) Trade {
+	return Trade{
+		MakerOrderID: maker.ID,
+		TakerOrderID: taker.ID,
+		Maker:        maker.Owner,
+		Taker:        taker.Owner,
+		Source:       source,
+		Destination:  destination,
+		Price:        maker.Price(),
+		Timestamp:    timestamp,
+		Height:       height,
+	}
+}
+
+func (t Trade) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(t.Maker); err != nil {
+		return fmt.Errorf("invalid maker address: %w", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(t.Taker); err != nil {
+		return fmt.Errorf("invalid taker address: %w", err)
+	}
+
+	if !t.Source.IsValid() || !t.Destination.IsValid() || t.Source.Denom == t.Destination.Denom {
+		return fmt.Errorf("invalid source or destination: %v -> %v", t.Source, t.Destination)
+	}
+
+	if t.Price.IsNil() || !t.Price.IsPositive() {
+		return fmt.Errorf("invalid price: %v", t.Price)
+	}
+
+	return nil
+}
+
+func (t Trade) String() string {
+	return fmt.Sprintf("%d : %v -> %v @ %v (maker %v order %v, taker %v order %v) %v", t.ID, t.Source, t.Destination, t.Price, t.Maker, t.MakerOrderID, t.Taker, t.TakerOrderID, t.Timestamp.Format(time.RFC3339))
+}
+
+// NewOrderResult reports an order with the given status that has not been filled yet.
+func NewOrderResult(orderID uint64, status OrderStatus, srcDenom, dstDenom string) OrderResult {
+	return OrderResult{
+		OrderID:           orderID,
+		Status:            status,
+		SourceFilled:      sdk.NewCoin(srcDenom, sdk.ZeroInt()),
+		DestinationFilled: sdk.NewCoin(dstDenom, sdk.ZeroInt()),
+		Fee:               sdk.NewCoin(dstDenom, sdk.ZeroInt()),
+		Fills:             []Trade{},
+	}
+}
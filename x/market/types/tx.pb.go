@@ -11,6 +11,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -18,12 +19,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,12 +34,59 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// OrderStatus reports what happened to an order when it was accepted.
+type OrderStatus int32
+
+const (
+	OrderStatus_Unspecified OrderStatus = 0
+	// The remainder of the order rests on the book.
+	OrderStatus_Resting OrderStatus = 1
+	// The order was filled completely.
+	OrderStatus_Filled OrderStatus = 2
+	// The remainder of the order was canceled, because it was immediate-or-cancel
+	// or to prevent a self-trade or a trade outside a price band.
+	OrderStatus_Expired OrderStatus = 3
+	// The fill-or-kill order could not be filled completely and made no trades.
+	OrderStatus_Killed OrderStatus = 4
+)
+
+var OrderStatus_name = map[int32]string{
+	0: "ORDER_STATUS_UNSPECIFIED",
+	1: "ORDER_STATUS_RESTING",
+	2: "ORDER_STATUS_FILLED",
+	3: "ORDER_STATUS_EXPIRED",
+	4: "ORDER_STATUS_KILLED",
+}
+
+var OrderStatus_value = map[string]int32{
+	"ORDER_STATUS_UNSPECIFIED": 0,
+	"ORDER_STATUS_RESTING":     1,
+	"ORDER_STATUS_FILLED":      2,
+	"ORDER_STATUS_EXPIRED":     3,
+	"ORDER_STATUS_KILLED":      4,
+}
+
+func (x OrderStatus) String() string {
+	return proto.EnumName(OrderStatus_name, int32(x))
+}
+
+func (OrderStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{0}
+}
+
 type MsgAddLimitOrder struct {
-	Owner         string      `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
-	ClientOrderId string      `protobuf:"bytes,2,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty" yaml:"client_order_id"`
-	TimeInForce   TimeInForce `protobuf:"varint,3,opt,name=time_in_force,json=timeInForce,proto3,enum=em.market.v1.TimeInForce" json:"time_in_force,omitempty" yaml:"time_in_force"`
-	Source        types.Coin  `protobuf:"bytes,4,opt,name=source,proto3" json:"source" yaml:"source"`
-	Destination   types.Coin  `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination" yaml:"destination"`
+	Owner               string              `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ClientOrderId       string              `protobuf:"bytes,2,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty" yaml:"client_order_id"`
+	TimeInForce         TimeInForce         `protobuf:"varint,3,opt,name=time_in_force,json=timeInForce,proto3,enum=em.market.v1.TimeInForce" json:"time_in_force,omitempty" yaml:"time_in_force"`
+	Source              types.Coin          `protobuf:"bytes,4,opt,name=source,proto3" json:"source" yaml:"source"`
+	Destination         types.Coin          `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination" yaml:"destination"`
+	ExpireTime          *time.Time          `protobuf:"bytes,6,opt,name=expire_time,json=expireTime,proto3,stdtime" json:"expire_time,omitempty" yaml:"expire_time"`
+	ExpireHeight        int64               `protobuf:"varint,7,opt,name=expire_height,json=expireHeight,proto3" json:"expire_height,omitempty" yaml:"expire_height"`
+	PostOnly            PostOnlyMode        `protobuf:"varint,8,opt,name=post_only,json=postOnly,proto3,enum=em.market.v1.PostOnlyMode" json:"post_only,omitempty" yaml:"post_only"`
+	SelfTradePrevention SelfTradePrevention `protobuf:"varint,9,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=em.market.v1.SelfTradePrevention" json:"self_trade_prevention,omitempty" yaml:"self_trade_prevention"`
+	// Amount of the source denomination shown on the book at a time. Zero
+	// shows the entire remainder of the order.
+	DisplayQuantity github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=display_quantity,json=displayQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"display_quantity" yaml:"display_quantity"`
 }
 
 func (m *MsgAddLimitOrder) Reset()         { *m = MsgAddLimitOrder{} }
@@ -107,7 +157,36 @@ func (m *MsgAddLimitOrder) GetDestination() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgAddLimitOrder) GetExpireTime() *time.Time {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+func (m *MsgAddLimitOrder) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *MsgAddLimitOrder) GetPostOnly() PostOnlyMode {
+	if m != nil {
+		return m.PostOnly
+	}
+	return PostOnlyMode_None
+}
+
+func (m *MsgAddLimitOrder) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return SelfTradePrevention_None
+}
+
 type MsgAddLimitOrderResponse struct {
+	Result OrderResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result" yaml:"result"`
 }
 
 func (m *MsgAddLimitOrderResponse) Reset()         { *m = MsgAddLimitOrderResponse{} }
@@ -143,20 +222,119 @@ func (m *MsgAddLimitOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddLimitOrderResponse proto.InternalMessageInfo
 
+func (m *MsgAddLimitOrderResponse) GetResult() OrderResult {
+	if m != nil {
+		return m.Result
+	}
+	return OrderResult{}
+}
+
+// OrderResult reports the outcome of an order accepted by the market.
+type OrderResult struct {
+	OrderID uint64      `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" yaml:"order_id"`
+	Status  OrderStatus `protobuf:"varint,2,opt,name=status,proto3,enum=em.market.v1.OrderStatus" json:"status,omitempty" yaml:"status"`
+	// Amount sold by the order, including the fills of a replaced order.
+	SourceFilled types.Coin `protobuf:"bytes,3,opt,name=source_filled,json=sourceFilled,proto3" json:"source_filled" yaml:"source_filled"`
+	// Amount bought by the order, including the fills of a replaced order and
+	// before fees are deducted.
+	DestinationFilled types.Coin `protobuf:"bytes,4,opt,name=destination_filled,json=destinationFilled,proto3" json:"destination_filled" yaml:"destination_filled"`
+	// Taker fee charged on the fills of the order.
+	Fee types.Coin `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee" yaml:"fee"`
+	// Trades made by the order when it was accepted, one per instrument of a
+	// synthetic route.
+	Fills []Trade `protobuf:"bytes,6,rep,name=fills,proto3" json:"fills" yaml:"fills"`
+}
+
+func (m *OrderResult) Reset()         { *m = OrderResult{} }
+func (m *OrderResult) String() string { return proto.CompactTextString(m) }
+func (*OrderResult) ProtoMessage()    {}
+func (*OrderResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{2}
+}
+func (m *OrderResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderResult.Merge(m, src)
+}
+func (m *OrderResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderResult proto.InternalMessageInfo
+
+func (m *OrderResult) GetOrderID() uint64 {
+	if m != nil {
+		return m.OrderID
+	}
+	return 0
+}
+
+func (m *OrderResult) GetStatus() OrderStatus {
+	if m != nil {
+		return m.Status
+	}
+	return OrderStatus_Unspecified
+}
+
+func (m *OrderResult) GetSourceFilled() types.Coin {
+	if m != nil {
+		return m.SourceFilled
+	}
+	return types.Coin{}
+}
+
+func (m *OrderResult) GetDestinationFilled() types.Coin {
+	if m != nil {
+		return m.DestinationFilled
+	}
+	return types.Coin{}
+}
+
+func (m *OrderResult) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+func (m *OrderResult) GetFills() []Trade {
+	if m != nil {
+		return m.Fills
+	}
+	return nil
+}
+
 type MsgAddMarketOrder struct {
-	Owner         string                                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
-	ClientOrderId string                                 `protobuf:"bytes,2,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty" yaml:"client_order_id"`
-	TimeInForce   TimeInForce                            `protobuf:"varint,3,opt,name=time_in_force,json=timeInForce,proto3,enum=em.market.v1.TimeInForce" json:"time_in_force,omitempty" yaml:"time_in_force"`
-	Source        string                                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
-	Destination   types.Coin                             `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination" yaml:"destination"`
-	MaxSlippage   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=maximum_slippage,json=maximumSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maximum_slippage" yaml:"maximum_slippage"`
+	Owner               string                                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ClientOrderId       string                                 `protobuf:"bytes,2,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty" yaml:"client_order_id"`
+	TimeInForce         TimeInForce                            `protobuf:"varint,3,opt,name=time_in_force,json=timeInForce,proto3,enum=em.market.v1.TimeInForce" json:"time_in_force,omitempty" yaml:"time_in_force"`
+	Source              string                                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
+	Destination         types.Coin                             `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination" yaml:"destination"`
+	MaxSlippage         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=maximum_slippage,json=maximumSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maximum_slippage" yaml:"maximum_slippage"`
+	SelfTradePrevention SelfTradePrevention                    `protobuf:"varint,7,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=em.market.v1.SelfTradePrevention" json:"self_trade_prevention,omitempty" yaml:"self_trade_prevention"`
 }
 
 func (m *MsgAddMarketOrder) Reset()         { *m = MsgAddMarketOrder{} }
 func (m *MsgAddMarketOrder) String() string { return proto.CompactTextString(m) }
 func (*MsgAddMarketOrder) ProtoMessage()    {}
 func (*MsgAddMarketOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_636272ab2288df51, []int{2}
+	return fileDescriptor_636272ab2288df51, []int{3}
 }
 func (m *MsgAddMarketOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -220,14 +398,22 @@ func (m *MsgAddMarketOrder) GetDestination() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgAddMarketOrder) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return SelfTradePrevention_None
+}
+
 type MsgAddMarketOrderResponse struct {
+	Result OrderResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result" yaml:"result"`
 }
 
 func (m *MsgAddMarketOrderResponse) Reset()         { *m = MsgAddMarketOrderResponse{} }
 func (m *MsgAddMarketOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddMarketOrderResponse) ProtoMessage()    {}
 func (*MsgAddMarketOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_636272ab2288df51, []int{3}
+	return fileDescriptor_636272ab2288df51, []int{4}
 }
 func (m *MsgAddMarketOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,6 +442,13 @@ func (m *MsgAddMarketOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddMarketOrderResponse proto.InternalMessageInfo
 
+func (m *MsgAddMarketOrderResponse) GetResult() OrderResult {
+	if m != nil {
+		return m.Result
+	}
+	return OrderResult{}
+}
+
 type MsgCancelOrder struct {
 	Owner         string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 	ClientOrderId string `protobuf:"bytes,2,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty" yaml:"client_order_id"`
@@ -265,7 +458,7 @@ func (m *MsgCancelOrder) Reset()         { *m = MsgCancelOrder{} }
 func (m *MsgCancelOrder) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelOrder) ProtoMessage()    {}
 func (*MsgCancelOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_636272ab2288df51, []int{4}
+	return fileDescriptor_636272ab2288df51, []int{5}
 }
 func (m *MsgCancelOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -315,7 +508,7 @@ func (m *MsgCancelOrderResponse) Reset()         { *m = MsgCancelOrderResponse{}
 func (m *MsgCancelOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelOrderResponse) ProtoMessage()    {}
 func (*MsgCancelOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_636272ab2288df51, []int{5}
+	return fileDescriptor_636272ab2288df51, []int{6}
 }
 func (m *MsgCancelOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,20 +537,125 @@ func (m *MsgCancelOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelOrderResponse proto.InternalMessageInfo
 
+// MsgCancelAllOrders cancels every resting order of the owner. Empty source
+// and destination denominations match any denomination.
+type MsgCancelAllOrders struct {
+	Owner       string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	Source      string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
+	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty" yaml:"destination"`
+}
+
+func (m *MsgCancelAllOrders) Reset()         { *m = MsgCancelAllOrders{} }
+func (m *MsgCancelAllOrders) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAllOrders) ProtoMessage()    {}
+func (*MsgCancelAllOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{7}
+}
+func (m *MsgCancelAllOrders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAllOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAllOrders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelAllOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAllOrders.Merge(m, src)
+}
+func (m *MsgCancelAllOrders) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAllOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAllOrders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelAllOrders proto.InternalMessageInfo
+
+func (m *MsgCancelAllOrders) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgCancelAllOrders) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *MsgCancelAllOrders) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+type MsgCancelAllOrdersResponse struct {
+}
+
+func (m *MsgCancelAllOrdersResponse) Reset()         { *m = MsgCancelAllOrdersResponse{} }
+func (m *MsgCancelAllOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAllOrdersResponse) ProtoMessage()    {}
+func (*MsgCancelAllOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{8}
+}
+func (m *MsgCancelAllOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAllOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAllOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelAllOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAllOrdersResponse.Merge(m, src)
+}
+func (m *MsgCancelAllOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAllOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAllOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelAllOrdersResponse proto.InternalMessageInfo
+
 type MsgCancelReplaceLimitOrder struct {
-	Owner             string      `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
-	OrigClientOrderId string      `protobuf:"bytes,2,opt,name=original_client_order_id,json=originalClientOrderId,proto3" json:"original_client_order_id,omitempty" yaml:"original_client_order_id"`
-	NewClientOrderId  string      `protobuf:"bytes,3,opt,name=new_client_order_id,json=newClientOrderId,proto3" json:"new_client_order_id,omitempty" yaml:"new_client_order_id"`
-	TimeInForce       TimeInForce `protobuf:"varint,4,opt,name=time_in_force,json=timeInForce,proto3,enum=em.market.v1.TimeInForce" json:"time_in_force,omitempty" yaml:"time_in_force"`
-	Source            types.Coin  `protobuf:"bytes,5,opt,name=source,proto3" json:"source" yaml:"source"`
-	Destination       types.Coin  `protobuf:"bytes,6,opt,name=destination,proto3" json:"destination" yaml:"destination"`
+	Owner               string              `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	OrigClientOrderId   string              `protobuf:"bytes,2,opt,name=original_client_order_id,json=originalClientOrderId,proto3" json:"original_client_order_id,omitempty" yaml:"original_client_order_id"`
+	NewClientOrderId    string              `protobuf:"bytes,3,opt,name=new_client_order_id,json=newClientOrderId,proto3" json:"new_client_order_id,omitempty" yaml:"new_client_order_id"`
+	TimeInForce         TimeInForce         `protobuf:"varint,4,opt,name=time_in_force,json=timeInForce,proto3,enum=em.market.v1.TimeInForce" json:"time_in_force,omitempty" yaml:"time_in_force"`
+	Source              types.Coin          `protobuf:"bytes,5,opt,name=source,proto3" json:"source" yaml:"source"`
+	Destination         types.Coin          `protobuf:"bytes,6,opt,name=destination,proto3" json:"destination" yaml:"destination"`
+	ExpireTime          *time.Time          `protobuf:"bytes,7,opt,name=expire_time,json=expireTime,proto3,stdtime" json:"expire_time,omitempty" yaml:"expire_time"`
+	ExpireHeight        int64               `protobuf:"varint,8,opt,name=expire_height,json=expireHeight,proto3" json:"expire_height,omitempty" yaml:"expire_height"`
+	PostOnly            PostOnlyMode        `protobuf:"varint,9,opt,name=post_only,json=postOnly,proto3,enum=em.market.v1.PostOnlyMode" json:"post_only,omitempty" yaml:"post_only"`
+	SelfTradePrevention SelfTradePrevention `protobuf:"varint,10,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=em.market.v1.SelfTradePrevention" json:"self_trade_prevention,omitempty" yaml:"self_trade_prevention"`
+	// Amount of the source denomination shown on the book at a time. Zero
+	// shows the entire remainder of the order.
+	DisplayQuantity github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=display_quantity,json=displayQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"display_quantity" yaml:"display_quantity"`
 }
 
 func (m *MsgCancelReplaceLimitOrder) Reset()         { *m = MsgCancelReplaceLimitOrder{} }
 func (m *MsgCancelReplaceLimitOrder) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelReplaceLimitOrder) ProtoMessage()    {}
 func (*MsgCancelReplaceLimitOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_636272ab2288df51, []int{6}
+	return fileDescriptor_636272ab2288df51, []int{9}
 }
 func (m *MsgCancelReplaceLimitOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -428,14 +726,43 @@ func (m *MsgCancelReplaceLimitOrder) GetDestination() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgCancelReplaceLimitOrder) GetExpireTime() *time.Time {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+func (m *MsgCancelReplaceLimitOrder) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *MsgCancelReplaceLimitOrder) GetPostOnly() PostOnlyMode {
+	if m != nil {
+		return m.PostOnly
+	}
+	return PostOnlyMode_None
+}
+
+func (m *MsgCancelReplaceLimitOrder) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return SelfTradePrevention_None
+}
+
 type MsgCancelReplaceLimitOrderResponse struct {
+	Result OrderResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result" yaml:"result"`
 }
 
 func (m *MsgCancelReplaceLimitOrderResponse) Reset()         { *m = MsgCancelReplaceLimitOrderResponse{} }
 func (m *MsgCancelReplaceLimitOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelReplaceLimitOrderResponse) ProtoMessage()    {}
 func (*MsgCancelReplaceLimitOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_636272ab2288df51, []int{7}
+	return fileDescriptor_636272ab2288df51, []int{10}
 }
 func (m *MsgCancelReplaceLimitOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -464,21 +791,29 @@ func (m *MsgCancelReplaceLimitOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelReplaceLimitOrderResponse proto.InternalMessageInfo
 
+func (m *MsgCancelReplaceLimitOrderResponse) GetResult() OrderResult {
+	if m != nil {
+		return m.Result
+	}
+	return OrderResult{}
+}
+
 type MsgCancelReplaceMarketOrder struct {
-	Owner             string                                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
-	OrigClientOrderId string                                 `protobuf:"bytes,2,opt,name=original_client_order_id,json=originalClientOrderId,proto3" json:"original_client_order_id,omitempty" yaml:"original_client_order_id"`
-	NewClientOrderId  string                                 `protobuf:"bytes,3,opt,name=new_client_order_id,json=newClientOrderId,proto3" json:"new_client_order_id,omitempty" yaml:"new_client_order_id"`
-	TimeInForce       TimeInForce                            `protobuf:"varint,4,opt,name=time_in_force,json=timeInForce,proto3,enum=em.market.v1.TimeInForce" json:"time_in_force,omitempty" yaml:"time_in_force"`
-	Source            string                                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
-	Destination       types.Coin                             `protobuf:"bytes,6,opt,name=destination,proto3" json:"destination" yaml:"destination"`
-	MaxSlippage       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=maximum_slippage,json=maximumSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maximum_slippage" yaml:"maximum_slippage"`
+	Owner               string                                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	OrigClientOrderId   string                                 `protobuf:"bytes,2,opt,name=original_client_order_id,json=originalClientOrderId,proto3" json:"original_client_order_id,omitempty" yaml:"original_client_order_id"`
+	NewClientOrderId    string                                 `protobuf:"bytes,3,opt,name=new_client_order_id,json=newClientOrderId,proto3" json:"new_client_order_id,omitempty" yaml:"new_client_order_id"`
+	TimeInForce         TimeInForce                            `protobuf:"varint,4,opt,name=time_in_force,json=timeInForce,proto3,enum=em.market.v1.TimeInForce" json:"time_in_force,omitempty" yaml:"time_in_force"`
+	Source              string                                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
+	Destination         types.Coin                             `protobuf:"bytes,6,opt,name=destination,proto3" json:"destination" yaml:"destination"`
+	MaxSlippage         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=maximum_slippage,json=maximumSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maximum_slippage" yaml:"maximum_slippage"`
+	SelfTradePrevention SelfTradePrevention                    `protobuf:"varint,8,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=em.market.v1.SelfTradePrevention" json:"self_trade_prevention,omitempty" yaml:"self_trade_prevention"`
 }
 
 func (m *MsgCancelReplaceMarketOrder) Reset()         { *m = MsgCancelReplaceMarketOrder{} }
 func (m *MsgCancelReplaceMarketOrder) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelReplaceMarketOrder) ProtoMessage()    {}
 func (*MsgCancelReplaceMarketOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_636272ab2288df51, []int{8}
+	return fileDescriptor_636272ab2288df51, []int{11}
 }
 func (m *MsgCancelReplaceMarketOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -549,14 +884,22 @@ func (m *MsgCancelReplaceMarketOrder) GetDestination() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgCancelReplaceMarketOrder) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return SelfTradePrevention_None
+}
+
 type MsgCancelReplaceMarketOrderResponse struct {
+	Result OrderResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result" yaml:"result"`
 }
 
 func (m *MsgCancelReplaceMarketOrderResponse) Reset()         { *m = MsgCancelReplaceMarketOrderResponse{} }
 func (m *MsgCancelReplaceMarketOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelReplaceMarketOrderResponse) ProtoMessage()    {}
 func (*MsgCancelReplaceMarketOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_636272ab2288df51, []int{9}
+	return fileDescriptor_636272ab2288df51, []int{12}
 }
 func (m *MsgCancelReplaceMarketOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -585,918 +928,6083 @@ func (m *MsgCancelReplaceMarketOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelReplaceMarketOrderResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgAddLimitOrder)(nil), "em.market.v1.MsgAddLimitOrder")
-	proto.RegisterType((*MsgAddLimitOrderResponse)(nil), "em.market.v1.MsgAddLimitOrderResponse")
-	proto.RegisterType((*MsgAddMarketOrder)(nil), "em.market.v1.MsgAddMarketOrder")
-	proto.RegisterType((*MsgAddMarketOrderResponse)(nil), "em.market.v1.MsgAddMarketOrderResponse")
-	proto.RegisterType((*MsgCancelOrder)(nil), "em.market.v1.MsgCancelOrder")
-	proto.RegisterType((*MsgCancelOrderResponse)(nil), "em.market.v1.MsgCancelOrderResponse")
-	proto.RegisterType((*MsgCancelReplaceLimitOrder)(nil), "em.market.v1.MsgCancelReplaceLimitOrder")
-	proto.RegisterType((*MsgCancelReplaceLimitOrderResponse)(nil), "em.market.v1.MsgCancelReplaceLimitOrderResponse")
-	proto.RegisterType((*MsgCancelReplaceMarketOrder)(nil), "em.market.v1.MsgCancelReplaceMarketOrder")
-	proto.RegisterType((*MsgCancelReplaceMarketOrderResponse)(nil), "em.market.v1.MsgCancelReplaceMarketOrderResponse")
+func (m *MsgCancelReplaceMarketOrderResponse) GetResult() OrderResult {
+	if m != nil {
+		return m.Result
+	}
+	return OrderResult{}
 }
 
-func init() { proto.RegisterFile("em/market/v1/tx.proto", fileDescriptor_636272ab2288df51) }
-
-var fileDescriptor_636272ab2288df51 = []byte{
-	// 780 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0x9b, 0x0f, 0xd4, 0x4d, 0x3f, 0x52, 0xd3, 0x0f, 0xc7, 0x45, 0x76, 0x65, 0x4a, 0x49,
-	0x85, 0x6a, 0x93, 0x70, 0x41, 0xdc, 0x48, 0x01, 0x51, 0x89, 0x50, 0x61, 0x90, 0x8a, 0x7a, 0x89,
-	0x1c, 0x7b, 0x6b, 0x56, 0xf5, 0x7a, 0x83, 0xed, 0xb4, 0xa9, 0xc4, 0x8d, 0x3f, 0xc0, 0x89, 0xdf,
-	0xd4, 0x63, 0x8f, 0x88, 0x83, 0x85, 0xd2, 0x7f, 0x90, 0x23, 0x87, 0x0a, 0xd9, 0xeb, 0x04, 0xc7,
-	0x69, 0xd2, 0x52, 0x95, 0x1e, 0x10, 0xa7, 0x36, 0x3b, 0xef, 0xbd, 0x19, 0xcd, 0x8c, 0xdf, 0x2e,
-	0x58, 0x80, 0x58, 0xc1, 0x9a, 0xb3, 0x0f, 0x3d, 0xe5, 0xa0, 0xac, 0x78, 0x6d, 0xb9, 0xe9, 0x10,
-	0x8f, 0xb0, 0x53, 0x10, 0xcb, 0xf4, 0x58, 0x3e, 0x28, 0xf3, 0xf3, 0x26, 0x31, 0x49, 0x18, 0x50,
-	0x82, 0xff, 0x28, 0x86, 0x17, 0x74, 0xe2, 0x62, 0xe2, 0x2a, 0x0d, 0xcd, 0x85, 0xca, 0x41, 0xb9,
-	0x01, 0x3d, 0xad, 0xac, 0xe8, 0x04, 0xd9, 0x51, 0xbc, 0x38, 0x20, 0x1d, 0xa9, 0xd1, 0x90, 0x68,
-	0x12, 0x62, 0x5a, 0x50, 0x09, 0x7f, 0x35, 0x5a, 0x7b, 0x8a, 0x87, 0x30, 0x74, 0x3d, 0x0d, 0x37,
-	0x29, 0x40, 0xfa, 0x39, 0x01, 0x0a, 0x35, 0xd7, 0x7c, 0x6a, 0x18, 0xaf, 0x10, 0x46, 0xde, 0xb6,
-	0x63, 0x40, 0x87, 0x5d, 0x03, 0x59, 0x72, 0x68, 0x43, 0x87, 0x63, 0x56, 0x98, 0xd2, 0x64, 0xb5,
-	0xd0, 0xf5, 0xc5, 0xa9, 0x23, 0x0d, 0x5b, 0x4f, 0xa4, 0xf0, 0x58, 0x52, 0x69, 0x98, 0xad, 0x82,
-	0x59, 0xdd, 0x42, 0xd0, 0xf6, 0xea, 0x24, 0xe0, 0xd5, 0x91, 0xc1, 0x4d, 0x84, 0x0c, 0xbe, 0xeb,
-	0x8b, 0x8b, 0x94, 0x91, 0x00, 0x48, 0xea, 0x34, 0x3d, 0x09, 0x33, 0x6d, 0x19, 0xec, 0x0e, 0x98,
-	0x0e, 0x6a, 0xaa, 0x23, 0xbb, 0xbe, 0x47, 0x1c, 0x1d, 0x72, 0xe9, 0x15, 0xa6, 0x34, 0x53, 0x29,
-	0xca, 0xf1, 0xc6, 0xc8, 0xef, 0x10, 0x86, 0x5b, 0xf6, 0x8b, 0x00, 0x50, 0xe5, 0xba, 0xbe, 0x38,
-	0x4f, 0xc5, 0x07, 0x98, 0x92, 0x9a, 0xf7, 0x7e, 0xc3, 0xd8, 0x97, 0x20, 0xe7, 0x92, 0x56, 0xa0,
-	0x98, 0x59, 0x61, 0x4a, 0xf9, 0x4a, 0x51, 0xa6, 0x6d, 0x94, 0x83, 0x36, 0xca, 0x51, 0x1b, 0xe5,
-	0x4d, 0x82, 0xec, 0xea, 0xc2, 0xb1, 0x2f, 0xa6, 0xba, 0xbe, 0x38, 0x4d, 0x55, 0x29, 0x4d, 0x52,
-	0x23, 0x3e, 0xbb, 0x03, 0xf2, 0x06, 0x74, 0x3d, 0x64, 0x6b, 0x1e, 0x22, 0x36, 0x97, 0xbd, 0x48,
-	0x8e, 0x8f, 0xe4, 0x58, 0x2a, 0x17, 0xe3, 0x4a, 0x6a, 0x5c, 0x49, 0xe2, 0x01, 0x97, 0xec, 0xbd,
-	0x0a, 0xdd, 0x26, 0xb1, 0x5d, 0x28, 0x75, 0xd2, 0x60, 0x8e, 0x06, 0x6b, 0x61, 0x17, 0xfe, 0xa1,
-	0xc9, 0xac, 0x0f, 0x4c, 0x66, 0xb2, 0x3a, 0x77, 0xf3, 0xad, 0x67, 0x3f, 0x33, 0xa0, 0x80, 0xb5,
-	0x36, 0xc2, 0x2d, 0x5c, 0x77, 0x2d, 0xd4, 0x6c, 0x6a, 0x26, 0xe4, 0x72, 0x61, 0x39, 0xef, 0x03,
-	0x8d, 0xef, 0xbe, 0xb8, 0x66, 0x22, 0xef, 0x43, 0xab, 0x21, 0xeb, 0x04, 0x2b, 0xd1, 0x17, 0x48,
-	0xff, 0x6c, 0xb8, 0xc6, 0xbe, 0xe2, 0x1d, 0x35, 0xa1, 0x2b, 0x3f, 0x83, 0x7a, 0xc7, 0x17, 0xf3,
-	0x35, 0xad, 0xfd, 0x36, 0x12, 0xe9, 0xfa, 0xe2, 0x12, 0x4d, 0x9e, 0x94, 0x97, 0xd4, 0xd9, 0xe8,
-	0xa8, 0x87, 0x95, 0x96, 0x41, 0x71, 0x68, 0xc6, 0xfd, 0x0d, 0xf8, 0x04, 0x66, 0x6a, 0xae, 0xb9,
-	0xa9, 0xd9, 0x3a, 0xb4, 0x6e, 0x7c, 0xfa, 0x12, 0x07, 0x16, 0x07, 0xb3, 0xf7, 0xeb, 0xfa, 0x9a,
-	0x01, 0x7c, 0x3f, 0xa4, 0xc2, 0xa6, 0xa5, 0xe9, 0xf0, 0x0a, 0xe6, 0xf1, 0x11, 0x70, 0xc4, 0x41,
-	0x26, 0xb2, 0x35, 0xab, 0x7e, 0x7e, 0xb5, 0x8f, 0x3b, 0xbe, 0x38, 0xb7, 0xed, 0x20, 0x73, 0x33,
-	0x5e, 0x59, 0xd7, 0x17, 0xc5, 0x48, 0x6f, 0x04, 0x5d, 0x52, 0x17, 0x7a, 0xa1, 0x01, 0x26, 0xab,
-	0x81, 0xdb, 0x36, 0x3c, 0x1c, 0xca, 0x96, 0x0e, 0xb3, 0x55, 0x3a, 0xbe, 0x58, 0x78, 0x0d, 0x0f,
-	0x93, 0xc9, 0x78, 0x9a, 0xec, 0x1c, 0xa2, 0xa4, 0x16, 0xec, 0x04, 0x7e, 0xf8, 0xa3, 0xc9, 0x5c,
-	0xbb, 0x9d, 0x65, 0xaf, 0xd7, 0xce, 0x72, 0xd7, 0x66, 0x67, 0xab, 0x40, 0x1a, 0xbd, 0x17, 0xfd,
-	0xf5, 0x39, 0xcb, 0x80, 0xe5, 0x24, 0xec, 0x2a, 0x16, 0xf7, 0x7f, 0x7f, 0xae, 0x68, 0xba, 0xd9,
-	0x3f, 0x34, 0xdd, 0xdc, 0xdf, 0x35, 0xdd, 0x5b, 0x37, 0x6d, 0xba, 0xf7, 0xc0, 0xdd, 0x31, 0xfb,
-	0xd7, 0xdb, 0xd3, 0xca, 0x59, 0x1a, 0xa4, 0x6b, 0xae, 0x19, 0x4c, 0x64, 0xf0, 0x75, 0x24, 0x0c,
-	0xce, 0x22, 0x79, 0x83, 0xf3, 0x6b, 0xe3, 0xe3, 0xbd, 0x04, 0xec, 0x2e, 0x98, 0x49, 0xdc, 0xee,
-	0xe2, 0x79, 0xcc, 0x18, 0x80, 0xbf, 0x7f, 0x01, 0xa0, 0xaf, 0xfd, 0x06, 0xe4, 0xe3, 0x17, 0xc7,
-	0x9d, 0x21, 0x5e, 0x2c, 0xca, 0xaf, 0x8e, 0x8b, 0xf6, 0x25, 0x5b, 0x60, 0x69, 0x94, 0xe5, 0x97,
-	0x46, 0x08, 0x0c, 0x21, 0xf9, 0x87, 0x97, 0x45, 0xf6, 0xd3, 0xb6, 0x01, 0x37, 0xd2, 0x2a, 0xd6,
-	0xc7, 0xab, 0xc5, 0x3b, 0x57, 0xbe, 0x34, 0xb4, 0x97, 0xb9, 0xfa, 0xfc, 0xb8, 0x23, 0x30, 0x27,
-	0x1d, 0x81, 0xf9, 0xd1, 0x11, 0x98, 0x2f, 0xa7, 0x42, 0xea, 0xe4, 0x54, 0x48, 0x7d, 0x3b, 0x15,
-	0x52, 0xbb, 0x0f, 0x62, 0x4b, 0x0a, 0x37, 0x30, 0xb1, 0xe1, 0x91, 0x02, 0xf1, 0x86, 0x05, 0x0d,
-	0x13, 0x3a, 0x4a, 0xbb, 0xf7, 0x18, 0x0f, 0xb7, 0xb5, 0x91, 0x0b, 0x1f, 0xda, 0x8f, 0x7e, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x7e, 0x1f, 0x6d, 0x63, 0x01, 0x0c, 0x00, 0x00,
+type MsgAddStopOrder struct {
+	Owner         string        `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ClientOrderId string        `protobuf:"bytes,2,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty" yaml:"client_order_id"`
+	TimeInForce   TimeInForce   `protobuf:"varint,3,opt,name=time_in_force,json=timeInForce,proto3,enum=em.market.v1.TimeInForce" json:"time_in_force,omitempty" yaml:"time_in_force"`
+	OrderType     StopOrderType `protobuf:"varint,4,opt,name=order_type,json=orderType,proto3,enum=em.market.v1.StopOrderType" json:"order_type,omitempty" yaml:"order_type"`
+	// The amount must be zero for market stop orders.
+	Source      types.Coin                             `protobuf:"bytes,5,opt,name=source,proto3" json:"source" yaml:"source"`
+	Destination types.Coin                             `protobuf:"bytes,6,opt,name=destination,proto3" json:"destination" yaml:"destination"`
+	StopPrice   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=stop_price,json=stopPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"stop_price" yaml:"stop_price"`
+	MaxSlippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=maximum_slippage,json=maximumSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maximum_slippage" yaml:"maximum_slippage"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgAddStopOrder) Reset()         { *m = MsgAddStopOrder{} }
+func (m *MsgAddStopOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgAddStopOrder) ProtoMessage()    {}
+func (*MsgAddStopOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{13}
+}
+func (m *MsgAddStopOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddStopOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddStopOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddStopOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddStopOrder.Merge(m, src)
+}
+func (m *MsgAddStopOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddStopOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddStopOrder.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgAddStopOrder proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	AddLimitOrder(ctx context.Context, in *MsgAddLimitOrder, opts ...grpc.CallOption) (*MsgAddLimitOrderResponse, error)
-	AddMarketOrder(ctx context.Context, in *MsgAddMarketOrder, opts ...grpc.CallOption) (*MsgAddMarketOrderResponse, error)
-	CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error)
-	CancelReplaceLimitOrder(ctx context.Context, in *MsgCancelReplaceLimitOrder, opts ...grpc.CallOption) (*MsgCancelReplaceLimitOrderResponse, error)
-	CancelReplaceMarketOrder(ctx context.Context, in *MsgCancelReplaceMarketOrder, opts ...grpc.CallOption) (*MsgCancelReplaceMarketOrderResponse, error)
+func (m *MsgAddStopOrder) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgAddStopOrder) GetClientOrderId() string {
+	if m != nil {
+		return m.ClientOrderId
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgAddStopOrder) GetTimeInForce() TimeInForce {
+	if m != nil {
+		return m.TimeInForce
+	}
+	return TimeInForce_Unspecified
 }
 
-func (c *msgClient) AddLimitOrder(ctx context.Context, in *MsgAddLimitOrder, opts ...grpc.CallOption) (*MsgAddLimitOrderResponse, error) {
-	out := new(MsgAddLimitOrderResponse)
-	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/AddLimitOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddStopOrder) GetOrderType() StopOrderType {
+	if m != nil {
+		return m.OrderType
 	}
-	return out, nil
+	return StopOrderType_Unspecified
 }
 
-func (c *msgClient) AddMarketOrder(ctx context.Context, in *MsgAddMarketOrder, opts ...grpc.CallOption) (*MsgAddMarketOrderResponse, error) {
-	out := new(MsgAddMarketOrderResponse)
-	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/AddMarketOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddStopOrder) GetSource() types.Coin {
+	if m != nil {
+		return m.Source
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *msgClient) CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error) {
-	out := new(MsgCancelOrderResponse)
-	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/CancelOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddStopOrder) GetDestination() types.Coin {
+	if m != nil {
+		return m.Destination
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *msgClient) CancelReplaceLimitOrder(ctx context.Context, in *MsgCancelReplaceLimitOrder, opts ...grpc.CallOption) (*MsgCancelReplaceLimitOrderResponse, error) {
-	out := new(MsgCancelReplaceLimitOrderResponse)
-	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/CancelReplaceLimitOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgAddStopOrderResponse struct {
 }
 
-func (c *msgClient) CancelReplaceMarketOrder(ctx context.Context, in *MsgCancelReplaceMarketOrder, opts ...grpc.CallOption) (*MsgCancelReplaceMarketOrderResponse, error) {
-	out := new(MsgCancelReplaceMarketOrderResponse)
-	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/CancelReplaceMarketOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddStopOrderResponse) Reset()         { *m = MsgAddStopOrderResponse{} }
+func (m *MsgAddStopOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddStopOrderResponse) ProtoMessage()    {}
+func (*MsgAddStopOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{14}
+}
+func (m *MsgAddStopOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddStopOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddStopOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	AddLimitOrder(context.Context, *MsgAddLimitOrder) (*MsgAddLimitOrderResponse, error)
-	AddMarketOrder(context.Context, *MsgAddMarketOrder) (*MsgAddMarketOrderResponse, error)
-	CancelOrder(context.Context, *MsgCancelOrder) (*MsgCancelOrderResponse, error)
-	CancelReplaceLimitOrder(context.Context, *MsgCancelReplaceLimitOrder) (*MsgCancelReplaceLimitOrderResponse, error)
-	CancelReplaceMarketOrder(context.Context, *MsgCancelReplaceMarketOrder) (*MsgCancelReplaceMarketOrderResponse, error)
+func (m *MsgAddStopOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddStopOrderResponse.Merge(m, src)
+}
+func (m *MsgAddStopOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddStopOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddStopOrderResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgAddStopOrderResponse proto.InternalMessageInfo
+
+// MsgSetFees replaces the trading fee rates. It must be signed by the
+// authority.
+type MsgSetFees struct {
+	Authority      string           `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	MakerFee       uint32           `protobuf:"varint,2,opt,name=maker_fee,json=makerFee,proto3" json:"maker_fee,omitempty" yaml:"maker_fee"`
+	TakerFee       uint32           `protobuf:"varint,3,opt,name=taker_fee,json=takerFee,proto3" json:"taker_fee,omitempty" yaml:"taker_fee"`
+	InstrumentFees []InstrumentFees `protobuf:"bytes,4,rep,name=instrument_fees,json=instrumentFees,proto3" json:"instrument_fees" yaml:"instrument_fees"`
 }
 
-func (*UnimplementedMsgServer) AddLimitOrder(ctx context.Context, req *MsgAddLimitOrder) (*MsgAddLimitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddLimitOrder not implemented")
+func (m *MsgSetFees) Reset()         { *m = MsgSetFees{} }
+func (m *MsgSetFees) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFees) ProtoMessage()    {}
+func (*MsgSetFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{15}
 }
-func (*UnimplementedMsgServer) AddMarketOrder(ctx context.Context, req *MsgAddMarketOrder) (*MsgAddMarketOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddMarketOrder not implemented")
+func (m *MsgSetFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CancelOrder(ctx context.Context, req *MsgCancelOrder) (*MsgCancelOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+func (m *MsgSetFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CancelReplaceLimitOrder(ctx context.Context, req *MsgCancelReplaceLimitOrder) (*MsgCancelReplaceLimitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelReplaceLimitOrder not implemented")
+func (m *MsgSetFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFees.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CancelReplaceMarketOrder(ctx context.Context, req *MsgCancelReplaceMarketOrder) (*MsgCancelReplaceMarketOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelReplaceMarketOrder not implemented")
+func (m *MsgSetFees) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgSetFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFees.DiscardUnknown(m)
 }
 
-func _Msg_AddLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddLimitOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddLimitOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/em.market.v1.Msg/AddLimitOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddLimitOrder(ctx, req.(*MsgAddLimitOrder))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_MsgSetFees proto.InternalMessageInfo
 
-func _Msg_AddMarketOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddMarketOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddMarketOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/em.market.v1.Msg/AddMarketOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddMarketOrder(ctx, req.(*MsgAddMarketOrder))
+func (m *MsgSetFees) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/em.market.v1.Msg/CancelOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelOrder(ctx, req.(*MsgCancelOrder))
+func (m *MsgSetFees) GetMakerFee() uint32 {
+	if m != nil {
+		return m.MakerFee
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_CancelReplaceLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelReplaceLimitOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelReplaceLimitOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/em.market.v1.Msg/CancelReplaceLimitOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelReplaceLimitOrder(ctx, req.(*MsgCancelReplaceLimitOrder))
+func (m *MsgSetFees) GetTakerFee() uint32 {
+	if m != nil {
+		return m.TakerFee
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_CancelReplaceMarketOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelReplaceMarketOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelReplaceMarketOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/em.market.v1.Msg/CancelReplaceMarketOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelReplaceMarketOrder(ctx, req.(*MsgCancelReplaceMarketOrder))
+func (m *MsgSetFees) GetInstrumentFees() []InstrumentFees {
+	if m != nil {
+		return m.InstrumentFees
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "em.market.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AddLimitOrder",
-			Handler:    _Msg_AddLimitOrder_Handler,
-		},
-		{
-			MethodName: "AddMarketOrder",
-			Handler:    _Msg_AddMarketOrder_Handler,
-		},
-		{
-			MethodName: "CancelOrder",
-			Handler:    _Msg_CancelOrder_Handler,
-		},
-		{
-			MethodName: "CancelReplaceLimitOrder",
-			Handler:    _Msg_CancelReplaceLimitOrder_Handler,
-		},
-		{
-			MethodName: "CancelReplaceMarketOrder",
-			Handler:    _Msg_CancelReplaceMarketOrder_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "em/market/v1/tx.proto",
+type MsgSetFeesResponse struct {
 }
 
-func (m *MsgAddLimitOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetFeesResponse) Reset()         { *m = MsgSetFeesResponse{} }
+func (m *MsgSetFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeesResponse) ProtoMessage()    {}
+func (*MsgSetFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{16}
+}
+func (m *MsgSetFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeesResponse.Merge(m, src)
+}
+func (m *MsgSetFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddLimitOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetFeesResponse proto.InternalMessageInfo
+
+// MsgSetInstrumentRules replaces the tick size, minimum order size and lot size
+// of an instrument. It must be signed by the authority.
+type MsgSetInstrumentRules struct {
+	Authority    string                                 `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Source       string                                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
+	Destination  string                                 `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty" yaml:"destination"`
+	TickSize     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=tick_size,json=tickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tick_size" yaml:"tick_size"`
+	MinOrderSize github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=min_order_size,json=minOrderSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_order_size" yaml:"min_order_size"`
+	LotSize      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=lot_size,json=lotSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"lot_size" yaml:"lot_size"`
 }
 
-func (m *MsgAddLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSetInstrumentRules) Reset()         { *m = MsgSetInstrumentRules{} }
+func (m *MsgSetInstrumentRules) String() string { return proto.CompactTextString(m) }
+func (*MsgSetInstrumentRules) ProtoMessage()    {}
+func (*MsgSetInstrumentRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{17}
+}
+func (m *MsgSetInstrumentRules) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetInstrumentRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetInstrumentRules.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+}
+func (m *MsgSetInstrumentRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetInstrumentRules.Merge(m, src)
+}
+func (m *MsgSetInstrumentRules) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetInstrumentRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetInstrumentRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetInstrumentRules proto.InternalMessageInfo
+
+func (m *MsgSetInstrumentRules) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.TimeInForce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ClientOrderId) > 0 {
-		i -= len(m.ClientOrderId)
-		copy(dAtA[i:], m.ClientOrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientOrderId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgAddLimitOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetInstrumentRules) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetInstrumentRules) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
 }
 
-func (m *MsgAddLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgSetInstrumentRulesResponse struct {
 }
 
-func (m *MsgAddMarketOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetInstrumentRulesResponse) Reset()         { *m = MsgSetInstrumentRulesResponse{} }
+func (m *MsgSetInstrumentRulesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetInstrumentRulesResponse) ProtoMessage()    {}
+func (*MsgSetInstrumentRulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{18}
+}
+func (m *MsgSetInstrumentRulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetInstrumentRulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetInstrumentRulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetInstrumentRulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetInstrumentRulesResponse.Merge(m, src)
+}
+func (m *MsgSetInstrumentRulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetInstrumentRulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetInstrumentRulesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddMarketOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetInstrumentRulesResponse proto.InternalMessageInfo
+
+// MsgHaltTrading halts trading on an instrument in both directions, or on every
+// instrument involving a denomination. It must be signed by the authority.
+type MsgHaltTrading struct {
+	Authority string      `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Halt      TradingHalt `protobuf:"bytes,2,opt,name=halt,proto3" json:"halt" yaml:"halt"`
+	// Cancel the resting orders of the halted instruments.
+	CancelOrders bool `protobuf:"varint,3,opt,name=cancel_orders,json=cancelOrders,proto3" json:"cancel_orders,omitempty" yaml:"cancel_orders"`
 }
 
-func (m *MsgAddMarketOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MaxSlippage.Size()
-		i -= size
-		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgHaltTrading) Reset()         { *m = MsgHaltTrading{} }
+func (m *MsgHaltTrading) String() string { return proto.CompactTextString(m) }
+func (*MsgHaltTrading) ProtoMessage()    {}
+func (*MsgHaltTrading) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{19}
+}
+func (m *MsgHaltTrading) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgHaltTrading) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgHaltTrading.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.TimeInForce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ClientOrderId) > 0 {
-		i -= len(m.ClientOrderId)
-		copy(dAtA[i:], m.ClientOrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientOrderId)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgHaltTrading) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgHaltTrading.Merge(m, src)
+}
+func (m *MsgHaltTrading) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgHaltTrading) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgHaltTrading.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgHaltTrading proto.InternalMessageInfo
+
+func (m *MsgHaltTrading) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgAddMarketOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgHaltTrading) GetHalt() TradingHalt {
+	if m != nil {
+		return m.Halt
 	}
-	return dAtA[:n], nil
+	return TradingHalt{}
 }
 
-func (m *MsgAddMarketOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgHaltTrading) GetCancelOrders() bool {
+	if m != nil {
+		return m.CancelOrders
+	}
+	return false
 }
 
-func (m *MsgAddMarketOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgHaltTradingResponse struct {
 }
 
-func (m *MsgCancelOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgHaltTradingResponse) Reset()         { *m = MsgHaltTradingResponse{} }
+func (m *MsgHaltTradingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgHaltTradingResponse) ProtoMessage()    {}
+func (*MsgHaltTradingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{20}
+}
+func (m *MsgHaltTradingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgHaltTradingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgHaltTradingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgHaltTradingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgHaltTradingResponse.Merge(m, src)
+}
+func (m *MsgHaltTradingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgHaltTradingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgHaltTradingResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCancelOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgHaltTradingResponse proto.InternalMessageInfo
+
+// MsgResumeTrading lifts a halt set by MsgHaltTrading. It must be signed by the
+// authority.
+type MsgResumeTrading struct {
+	Authority string      `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Halt      TradingHalt `protobuf:"bytes,2,opt,name=halt,proto3" json:"halt" yaml:"halt"`
 }
 
-func (m *MsgCancelOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClientOrderId) > 0 {
-		i -= len(m.ClientOrderId)
-		copy(dAtA[i:], m.ClientOrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientOrderId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgResumeTrading) Reset()         { *m = MsgResumeTrading{} }
+func (m *MsgResumeTrading) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeTrading) ProtoMessage()    {}
+func (*MsgResumeTrading) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{21}
+}
+func (m *MsgResumeTrading) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeTrading) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeTrading.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgResumeTrading) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeTrading.Merge(m, src)
+}
+func (m *MsgResumeTrading) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeTrading) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeTrading.DiscardUnknown(m)
 }
 
-func (m *MsgCancelOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgResumeTrading proto.InternalMessageInfo
+
+func (m *MsgResumeTrading) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCancelOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgResumeTrading) GetHalt() TradingHalt {
+	if m != nil {
+		return m.Halt
+	}
+	return TradingHalt{}
 }
 
-func (m *MsgCancelOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgResumeTradingResponse struct {
 }
 
-func (m *MsgCancelReplaceLimitOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgResumeTradingResponse) Reset()         { *m = MsgResumeTradingResponse{} }
+func (m *MsgResumeTradingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeTradingResponse) ProtoMessage()    {}
+func (*MsgResumeTradingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{22}
+}
+func (m *MsgResumeTradingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeTradingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeTradingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCancelReplaceLimitOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgResumeTradingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeTradingResponse.Merge(m, src)
+}
+func (m *MsgResumeTradingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeTradingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeTradingResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCancelReplaceLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+var xxx_messageInfo_MsgResumeTradingResponse proto.InternalMessageInfo
+
+// MsgSetPriceBand replaces the price band of an instrument. It must be signed
+// by the authority.
+type MsgSetPriceBand struct {
+	Authority string    `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Band      PriceBand `protobuf:"bytes,2,opt,name=band,proto3" json:"band" yaml:"band"`
+}
+
+func (m *MsgSetPriceBand) Reset()         { *m = MsgSetPriceBand{} }
+func (m *MsgSetPriceBand) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPriceBand) ProtoMessage()    {}
+func (*MsgSetPriceBand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{23}
+}
+func (m *MsgSetPriceBand) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPriceBand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPriceBand.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	if m.TimeInForce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
-		i--
-		dAtA[i] = 0x20
+}
+func (m *MsgSetPriceBand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPriceBand.Merge(m, src)
+}
+func (m *MsgSetPriceBand) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPriceBand) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPriceBand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPriceBand proto.InternalMessageInfo
+
+func (m *MsgSetPriceBand) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(m.NewClientOrderId) > 0 {
-		i -= len(m.NewClientOrderId)
-		copy(dAtA[i:], m.NewClientOrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewClientOrderId)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *MsgSetPriceBand) GetBand() PriceBand {
+	if m != nil {
+		return m.Band
 	}
-	if len(m.OrigClientOrderId) > 0 {
-		i -= len(m.OrigClientOrderId)
-		copy(dAtA[i:], m.OrigClientOrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrigClientOrderId)))
-		i--
-		dAtA[i] = 0x12
+	return PriceBand{}
+}
+
+type MsgSetPriceBandResponse struct {
+}
+
+func (m *MsgSetPriceBandResponse) Reset()         { *m = MsgSetPriceBandResponse{} }
+func (m *MsgSetPriceBandResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPriceBandResponse) ProtoMessage()    {}
+func (*MsgSetPriceBandResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{24}
+}
+func (m *MsgSetPriceBandResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPriceBandResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPriceBandResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetPriceBandResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPriceBandResponse.Merge(m, src)
+}
+func (m *MsgSetPriceBandResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPriceBandResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPriceBandResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPriceBandResponse proto.InternalMessageInfo
+
+// MsgSetOrderLimits replaces the limits on the number of resting orders of an
+// account. It must be signed by the authority.
+type MsgSetOrderLimits struct {
+	Authority               string               `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	MaxOpenOrders           uint32               `protobuf:"varint,2,opt,name=max_open_orders,json=maxOpenOrders,proto3" json:"max_open_orders,omitempty" yaml:"max_open_orders"`
+	MaxInstrumentOpenOrders uint32               `protobuf:"varint,3,opt,name=max_instrument_open_orders,json=maxInstrumentOpenOrders,proto3" json:"max_instrument_open_orders,omitempty" yaml:"max_instrument_open_orders"`
+	AccountOrderLimits      []AccountOrderLimits `protobuf:"bytes,4,rep,name=account_order_limits,json=accountOrderLimits,proto3" json:"account_order_limits" yaml:"account_order_limits"`
+}
+
+func (m *MsgSetOrderLimits) Reset()         { *m = MsgSetOrderLimits{} }
+func (m *MsgSetOrderLimits) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOrderLimits) ProtoMessage()    {}
+func (*MsgSetOrderLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{25}
+}
+func (m *MsgSetOrderLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOrderLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOrderLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetOrderLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOrderLimits.Merge(m, src)
+}
+func (m *MsgSetOrderLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOrderLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOrderLimits.DiscardUnknown(m)
 }
 
-func (m *MsgCancelReplaceLimitOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetOrderLimits proto.InternalMessageInfo
+
+func (m *MsgSetOrderLimits) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCancelReplaceLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetOrderLimits) GetMaxOpenOrders() uint32 {
+	if m != nil {
+		return m.MaxOpenOrders
+	}
+	return 0
 }
 
-func (m *MsgCancelReplaceLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetOrderLimits) GetMaxInstrumentOpenOrders() uint32 {
+	if m != nil {
+		return m.MaxInstrumentOpenOrders
+	}
+	return 0
 }
 
-func (m *MsgCancelReplaceMarketOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetOrderLimits) GetAccountOrderLimits() []AccountOrderLimits {
+	if m != nil {
+		return m.AccountOrderLimits
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCancelReplaceMarketOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetOrderLimitsResponse struct {
 }
 
-func (m *MsgCancelReplaceMarketOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MaxSlippage.Size()
-		i -= size
-		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgSetOrderLimitsResponse) Reset()         { *m = MsgSetOrderLimitsResponse{} }
+func (m *MsgSetOrderLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOrderLimitsResponse) ProtoMessage()    {}
+func (*MsgSetOrderLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{26}
+}
+func (m *MsgSetOrderLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOrderLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOrderLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgSetOrderLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOrderLimitsResponse.Merge(m, src)
+}
+func (m *MsgSetOrderLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOrderLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOrderLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetOrderLimitsResponse proto.InternalMessageInfo
+
+// MsgSetBatchAuction switches an instrument between continuous matching and
+// batch auctions. It must be signed by the authority.
+type MsgSetBatchAuction struct {
+	Authority string       `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Auction   BatchAuction `protobuf:"bytes,2,opt,name=auction,proto3" json:"auction" yaml:"auction"`
+	// Whether the instrument is cleared in batch auctions.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+}
+
+func (m *MsgSetBatchAuction) Reset()         { *m = MsgSetBatchAuction{} }
+func (m *MsgSetBatchAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBatchAuction) ProtoMessage()    {}
+func (*MsgSetBatchAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{27}
+}
+func (m *MsgSetBatchAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBatchAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBatchAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.TimeInForce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.NewClientOrderId) > 0 {
-		i -= len(m.NewClientOrderId)
-		copy(dAtA[i:], m.NewClientOrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewClientOrderId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.OrigClientOrderId) > 0 {
-		i -= len(m.OrigClientOrderId)
-		copy(dAtA[i:], m.OrigClientOrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrigClientOrderId)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetBatchAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBatchAuction.Merge(m, src)
+}
+func (m *MsgSetBatchAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBatchAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBatchAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetBatchAuction proto.InternalMessageInfo
+
+func (m *MsgSetBatchAuction) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCancelReplaceMarketOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetBatchAuction) GetAuction() BatchAuction {
+	if m != nil {
+		return m.Auction
 	}
-	return dAtA[:n], nil
+	return BatchAuction{}
 }
 
-func (m *MsgCancelReplaceMarketOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetBatchAuction) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
 }
 
-func (m *MsgCancelReplaceMarketOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgSetBatchAuctionResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgSetBatchAuctionResponse) Reset()         { *m = MsgSetBatchAuctionResponse{} }
+func (m *MsgSetBatchAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBatchAuctionResponse) ProtoMessage()    {}
+func (*MsgSetBatchAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_636272ab2288df51, []int{28}
 }
-func (m *MsgAddLimitOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ClientOrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TimeInForce != 0 {
-		n += 1 + sovTx(uint64(m.TimeInForce))
+func (m *MsgSetBatchAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBatchAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBatchAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Source.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Destination.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgSetBatchAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBatchAuctionResponse.Merge(m, src)
+}
+func (m *MsgSetBatchAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBatchAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBatchAuctionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddLimitOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgSetBatchAuctionResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("em.market.v1.OrderStatus", OrderStatus_name, OrderStatus_value)
+	proto.RegisterType((*MsgAddLimitOrder)(nil), "em.market.v1.MsgAddLimitOrder")
+	proto.RegisterType((*MsgAddLimitOrderResponse)(nil), "em.market.v1.MsgAddLimitOrderResponse")
+	proto.RegisterType((*OrderResult)(nil), "em.market.v1.OrderResult")
+	proto.RegisterType((*MsgAddMarketOrder)(nil), "em.market.v1.MsgAddMarketOrder")
+	proto.RegisterType((*MsgAddMarketOrderResponse)(nil), "em.market.v1.MsgAddMarketOrderResponse")
+	proto.RegisterType((*MsgCancelOrder)(nil), "em.market.v1.MsgCancelOrder")
+	proto.RegisterType((*MsgCancelOrderResponse)(nil), "em.market.v1.MsgCancelOrderResponse")
+	proto.RegisterType((*MsgCancelAllOrders)(nil), "em.market.v1.MsgCancelAllOrders")
+	proto.RegisterType((*MsgCancelAllOrdersResponse)(nil), "em.market.v1.MsgCancelAllOrdersResponse")
+	proto.RegisterType((*MsgCancelReplaceLimitOrder)(nil), "em.market.v1.MsgCancelReplaceLimitOrder")
+	proto.RegisterType((*MsgCancelReplaceLimitOrderResponse)(nil), "em.market.v1.MsgCancelReplaceLimitOrderResponse")
+	proto.RegisterType((*MsgCancelReplaceMarketOrder)(nil), "em.market.v1.MsgCancelReplaceMarketOrder")
+	proto.RegisterType((*MsgCancelReplaceMarketOrderResponse)(nil), "em.market.v1.MsgCancelReplaceMarketOrderResponse")
+	proto.RegisterType((*MsgAddStopOrder)(nil), "em.market.v1.MsgAddStopOrder")
+	proto.RegisterType((*MsgAddStopOrderResponse)(nil), "em.market.v1.MsgAddStopOrderResponse")
+	proto.RegisterType((*MsgSetFees)(nil), "em.market.v1.MsgSetFees")
+	proto.RegisterType((*MsgSetFeesResponse)(nil), "em.market.v1.MsgSetFeesResponse")
+	proto.RegisterType((*MsgSetInstrumentRules)(nil), "em.market.v1.MsgSetInstrumentRules")
+	proto.RegisterType((*MsgSetInstrumentRulesResponse)(nil), "em.market.v1.MsgSetInstrumentRulesResponse")
+	proto.RegisterType((*MsgHaltTrading)(nil), "em.market.v1.MsgHaltTrading")
+	proto.RegisterType((*MsgHaltTradingResponse)(nil), "em.market.v1.MsgHaltTradingResponse")
+	proto.RegisterType((*MsgResumeTrading)(nil), "em.market.v1.MsgResumeTrading")
+	proto.RegisterType((*MsgResumeTradingResponse)(nil), "em.market.v1.MsgResumeTradingResponse")
+	proto.RegisterType((*MsgSetPriceBand)(nil), "em.market.v1.MsgSetPriceBand")
+	proto.RegisterType((*MsgSetPriceBandResponse)(nil), "em.market.v1.MsgSetPriceBandResponse")
+	proto.RegisterType((*MsgSetOrderLimits)(nil), "em.market.v1.MsgSetOrderLimits")
+	proto.RegisterType((*MsgSetOrderLimitsResponse)(nil), "em.market.v1.MsgSetOrderLimitsResponse")
+	proto.RegisterType((*MsgSetBatchAuction)(nil), "em.market.v1.MsgSetBatchAuction")
+	proto.RegisterType((*MsgSetBatchAuctionResponse)(nil), "em.market.v1.MsgSetBatchAuctionResponse")
 }
 
-func (m *MsgAddMarketOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ClientOrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TimeInForce != 0 {
-		n += 1 + sovTx(uint64(m.TimeInForce))
-	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Destination.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MaxSlippage.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func init() { proto.RegisterFile("em/market/v1/tx.proto", fileDescriptor_636272ab2288df51) }
+
+var fileDescriptor_636272ab2288df51 = []byte{
+	// 2131 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4d, 0x6f, 0x1b, 0xc7,
+	0x19, 0x36, 0x45, 0x49, 0x24, 0x87, 0xfa, 0xa0, 0x56, 0x92, 0xb5, 0x5a, 0xcb, 0x5c, 0x7a, 0x1c,
+	0x3b, 0x4a, 0x53, 0x93, 0x95, 0x7a, 0x49, 0x0b, 0x14, 0xa9, 0x56, 0xa2, 0x62, 0x22, 0x96, 0x25,
+	0x0f, 0x65, 0x38, 0x08, 0x52, 0x10, 0x2b, 0x72, 0x48, 0x2d, 0xb4, 0x5f, 0xe1, 0x0e, 0x2d, 0x29,
+	0xe8, 0xad, 0x87, 0x02, 0x06, 0x0a, 0xe4, 0x0f, 0x18, 0x45, 0xff, 0x44, 0x7f, 0x41, 0x81, 0xe6,
+	0xe8, 0x43, 0x0f, 0x45, 0x03, 0xb0, 0x85, 0x7c, 0xe9, 0xa1, 0x27, 0x5e, 0x7a, 0x2d, 0x76, 0x66,
+	0x76, 0x39, 0xbb, 0xa4, 0x3e, 0x11, 0x3a, 0x6d, 0xd0, 0x93, 0xb9, 0xf3, 0x3e, 0xef, 0x33, 0x33,
+	0xef, 0xd7, 0xbc, 0x33, 0x32, 0x58, 0xc4, 0x56, 0xc9, 0xd2, 0xdb, 0x47, 0x98, 0x94, 0x5e, 0xae,
+	0x95, 0xc8, 0x49, 0xd1, 0x6d, 0x3b, 0xc4, 0x91, 0xa6, 0xb0, 0x55, 0x64, 0xc3, 0xc5, 0x97, 0x6b,
+	0xca, 0x42, 0xcb, 0x69, 0x39, 0x54, 0x50, 0xf2, 0x7f, 0x31, 0x8c, 0x92, 0xaf, 0x3b, 0x9e, 0xe5,
+	0x78, 0xa5, 0x03, 0xdd, 0xc3, 0xa5, 0x97, 0x6b, 0x07, 0x98, 0xe8, 0x6b, 0xa5, 0xba, 0x63, 0xd8,
+	0x5c, 0xbe, 0x1c, 0xa1, 0xe6, 0x6c, 0x4c, 0xa4, 0xb6, 0x1c, 0xa7, 0x65, 0xe2, 0x12, 0xfd, 0x3a,
+	0xe8, 0x34, 0x4b, 0xc4, 0xb0, 0xb0, 0x47, 0x74, 0xcb, 0x65, 0x00, 0xf8, 0x66, 0x12, 0xe4, 0x76,
+	0xbc, 0xd6, 0x46, 0xa3, 0xf1, 0xc4, 0xb0, 0x0c, 0xb2, 0xdb, 0x6e, 0xe0, 0xb6, 0xf4, 0x10, 0x4c,
+	0x38, 0xc7, 0x36, 0x6e, 0xcb, 0x89, 0x42, 0x62, 0x35, 0xa3, 0xe5, 0x7a, 0x5d, 0x75, 0xea, 0x54,
+	0xb7, 0xcc, 0x9f, 0x43, 0x3a, 0x0c, 0x11, 0x13, 0x4b, 0x1a, 0x98, 0xad, 0x9b, 0x06, 0xb6, 0x49,
+	0xcd, 0xf1, 0xf5, 0x6a, 0x46, 0x43, 0x1e, 0xa3, 0x1a, 0x4a, 0xaf, 0xab, 0xde, 0x66, 0x1a, 0x31,
+	0x00, 0x44, 0xd3, 0x6c, 0x84, 0xce, 0x54, 0x69, 0x48, 0x2f, 0xc0, 0xb4, 0xbf, 0xa6, 0x9a, 0x61,
+	0xd7, 0x9a, 0x4e, 0xbb, 0x8e, 0xe5, 0x64, 0x21, 0xb1, 0x3a, 0xb3, 0xbe, 0x5c, 0x14, 0x0d, 0x53,
+	0xdc, 0x37, 0x2c, 0x5c, 0xb1, 0xb7, 0x7d, 0x80, 0x26, 0xf7, 0xba, 0xea, 0x02, 0x23, 0x8f, 0x68,
+	0x42, 0x94, 0x25, 0x7d, 0x98, 0xf4, 0x18, 0x4c, 0x7a, 0x4e, 0xc7, 0x67, 0x1c, 0x2f, 0x24, 0x56,
+	0xb3, 0xeb, 0xcb, 0x45, 0x66, 0xc6, 0xa2, 0x6f, 0xc6, 0x22, 0x37, 0x63, 0x71, 0xd3, 0x31, 0x6c,
+	0x6d, 0xf1, 0x9b, 0xae, 0x7a, 0xab, 0xd7, 0x55, 0xa7, 0x19, 0x2b, 0x53, 0x83, 0x88, 0xeb, 0x4b,
+	0x2f, 0x40, 0xb6, 0x81, 0x3d, 0x62, 0xd8, 0x3a, 0x31, 0x1c, 0x5b, 0x9e, 0xb8, 0x8c, 0x4e, 0xe1,
+	0x74, 0x12, 0xa3, 0x13, 0x74, 0x21, 0x12, 0x99, 0x7c, 0x62, 0x7c, 0xe2, 0x1a, 0x6d, 0x5c, 0xf3,
+	0x17, 0x2e, 0x4f, 0x52, 0x62, 0xa5, 0xc8, 0x7c, 0x56, 0x0c, 0x7c, 0x56, 0xdc, 0x0f, 0x7c, 0xa6,
+	0x29, 0x7d, 0x56, 0x41, 0x11, 0x7e, 0xfd, 0x77, 0x35, 0x81, 0x00, 0x1b, 0xf1, 0xc1, 0xd2, 0x2f,
+	0xc0, 0x34, 0x97, 0x1f, 0x62, 0xa3, 0x75, 0x48, 0xe4, 0x54, 0x21, 0xb1, 0x9a, 0x14, 0x2d, 0x17,
+	0x11, 0x43, 0x34, 0xc5, 0xbe, 0x1f, 0xd3, 0x4f, 0x69, 0x07, 0x64, 0x5c, 0xc7, 0x23, 0x35, 0xc7,
+	0x36, 0x4f, 0xe5, 0x34, 0xf5, 0x87, 0x12, 0xf5, 0xc7, 0x9e, 0xe3, 0x91, 0x5d, 0xdb, 0x3c, 0xdd,
+	0x71, 0x1a, 0x58, 0x5b, 0xe8, 0x75, 0xd5, 0x1c, 0xa3, 0x0d, 0xd5, 0x20, 0x4a, 0xbb, 0x1c, 0x23,
+	0x1d, 0x83, 0x45, 0x0f, 0x9b, 0xcd, 0x1a, 0x69, 0xeb, 0x0d, 0x5c, 0x73, 0xdb, 0xf8, 0x25, 0xb6,
+	0xa9, 0x25, 0x33, 0x94, 0xfa, 0x5e, 0x94, 0xba, 0x8a, 0xcd, 0xe6, 0xbe, 0x8f, 0xdc, 0x0b, 0x81,
+	0x5a, 0xa1, 0xd7, 0x55, 0x57, 0xb8, 0x73, 0x86, 0x31, 0x41, 0x34, 0xef, 0x0d, 0xaa, 0x49, 0x04,
+	0xe4, 0x1a, 0x86, 0xe7, 0x9a, 0xfa, 0x69, 0xed, 0xcb, 0x8e, 0x6e, 0x13, 0x83, 0x9c, 0xca, 0x80,
+	0x06, 0x68, 0xc5, 0x77, 0xd1, 0xdf, 0xba, 0xea, 0xc3, 0x96, 0x41, 0x0e, 0x3b, 0x07, 0xc5, 0xba,
+	0x63, 0x95, 0x78, 0x96, 0xb1, 0x7f, 0x1e, 0x79, 0x8d, 0xa3, 0x12, 0x39, 0x75, 0xb1, 0x57, 0xac,
+	0xd8, 0xa4, 0xd7, 0x55, 0x97, 0xb8, 0x33, 0x63, 0x7c, 0x10, 0xcd, 0xf2, 0xa1, 0x67, 0xc1, 0x48,
+	0x03, 0xc8, 0xf1, 0x8c, 0x42, 0xd8, 0x73, 0x1d, 0xdb, 0xa3, 0x41, 0xd9, 0xc6, 0x5e, 0xc7, 0x24,
+	0x72, 0x82, 0x47, 0x51, 0x64, 0xef, 0x01, 0xb8, 0x63, 0x92, 0x78, 0x50, 0x32, 0x35, 0x88, 0xb8,
+	0x3e, 0xfc, 0x36, 0x09, 0xb2, 0x02, 0x5c, 0xfa, 0x19, 0x48, 0x87, 0x49, 0xe8, 0x73, 0x8f, 0x6b,
+	0xf9, 0xb3, 0xae, 0x9a, 0x62, 0x69, 0xb6, 0xd5, 0xeb, 0xaa, 0xb3, 0x3c, 0x83, 0xc3, 0x44, 0x4c,
+	0x39, 0x3c, 0x05, 0xb7, 0xc0, 0xa4, 0x47, 0x74, 0xd2, 0xf1, 0xe4, 0xb1, 0x61, 0xb9, 0x47, 0x29,
+	0xaa, 0x14, 0xa0, 0xcd, 0x09, 0x59, 0x42, 0x47, 0xfc, 0x2c, 0xa1, 0x3f, 0xa4, 0x2f, 0xc0, 0x34,
+	0xcb, 0x97, 0x5a, 0xd3, 0x30, 0x4d, 0xdc, 0x90, 0x93, 0x7c, 0x87, 0xe7, 0xe6, 0xc9, 0x0a, 0xdf,
+	0xe1, 0x82, 0x98, 0x76, 0x5c, 0x1b, 0xa2, 0x29, 0xf6, 0xbd, 0x4d, 0x3f, 0xa5, 0x23, 0x20, 0x09,
+	0x99, 0x13, 0x4c, 0x71, 0x69, 0x66, 0xdf, 0xe3, 0x53, 0x2c, 0x0f, 0xa4, 0x62, 0x38, 0xcf, 0x9c,
+	0x30, 0xc8, 0x27, 0xfb, 0x18, 0x24, 0x9b, 0x18, 0x5f, 0x9e, 0xe8, 0x12, 0x67, 0x07, 0x8c, 0xbd,
+	0x89, 0x31, 0x44, 0xbe, 0xa6, 0xf4, 0x31, 0x98, 0xf0, 0xe9, 0x3d, 0x79, 0xb2, 0x90, 0x5c, 0xcd,
+	0xae, 0xcf, 0xc7, 0x8a, 0x99, 0x1f, 0xa6, 0xda, 0x02, 0x57, 0xe6, 0x95, 0x95, 0xe2, 0x21, 0x62,
+	0x7a, 0xf0, 0xdb, 0x71, 0x30, 0xc7, 0x82, 0x68, 0x87, 0xaa, 0xfd, 0x80, 0xea, 0xf2, 0x07, 0x91,
+	0xba, 0x9c, 0x89, 0x84, 0xd4, 0xbb, 0x2a, 0xbc, 0xbf, 0x49, 0x80, 0x9c, 0xa5, 0x9f, 0x18, 0x56,
+	0xc7, 0xaa, 0x79, 0xa6, 0xe1, 0xba, 0x7a, 0x8b, 0x95, 0xdf, 0x8c, 0xf6, 0xd9, 0x35, 0x2a, 0xc3,
+	0x16, 0xae, 0x9f, 0x75, 0xd5, 0xec, 0x8e, 0x7e, 0x52, 0xe5, 0x24, 0xfd, 0x42, 0x11, 0xa7, 0x87,
+	0x68, 0x96, 0x0f, 0x05, 0xd8, 0xf3, 0xeb, 0x62, 0x6a, 0xb4, 0x75, 0x11, 0x62, 0xb0, 0x3c, 0x10,
+	0x5c, 0x23, 0x28, 0x51, 0xbf, 0x06, 0x33, 0x3b, 0x5e, 0x6b, 0x53, 0xb7, 0xeb, 0xd8, 0x7c, 0xe7,
+	0x01, 0x0c, 0x65, 0x70, 0x3b, 0x3a, 0x7b, 0xb0, 0x43, 0xf8, 0x87, 0x04, 0x90, 0x42, 0xd1, 0x86,
+	0xc9, 0xa4, 0xde, 0x95, 0x17, 0xd7, 0x0f, 0xe0, 0xb1, 0xcb, 0x02, 0xf8, 0xa3, 0x68, 0x00, 0x27,
+	0x29, 0xfe, 0xf6, 0x15, 0x22, 0x14, 0xae, 0x00, 0x65, 0x70, 0x89, 0xe1, 0x0e, 0xfe, 0x95, 0x12,
+	0xc4, 0x08, 0xbb, 0xa6, 0x5e, 0xc7, 0x37, 0xe8, 0xdf, 0xbe, 0x04, 0xb2, 0xd3, 0x36, 0x5a, 0x86,
+	0xad, 0x9b, 0xb5, 0xe1, 0xf6, 0xfe, 0xe8, 0xac, 0xab, 0xce, 0xed, 0xb6, 0x8d, 0xd6, 0xa6, 0x68,
+	0xdb, 0x5e, 0x57, 0x55, 0x39, 0xdf, 0x39, 0xea, 0x10, 0x2d, 0x06, 0xa2, 0x88, 0xa6, 0xa4, 0x83,
+	0x79, 0x1b, 0x1f, 0x0f, 0xcc, 0xc6, 0x2c, 0xb3, 0x7e, 0xd6, 0x55, 0x73, 0x4f, 0xf1, 0x71, 0x7c,
+	0x32, 0x85, 0x4d, 0x36, 0x44, 0x11, 0xa2, 0x9c, 0x1d, 0xc3, 0x0f, 0x56, 0xae, 0xf1, 0xef, 0xbc,
+	0xa3, 0x9c, 0xf8, 0x6e, 0x3b, 0xca, 0xc9, 0x51, 0x75, 0x94, 0xa9, 0xd1, 0x75, 0x94, 0xe9, 0x9b,
+	0x77, 0x94, 0x99, 0xd1, 0x75, 0x94, 0xe0, 0x7b, 0xe8, 0x28, 0xb3, 0x23, 0xef, 0x28, 0x6d, 0x00,
+	0xcf, 0xcf, 0xf6, 0x11, 0x14, 0xee, 0x7f, 0x4f, 0x80, 0x3b, 0xf1, 0x09, 0x6f, 0xd2, 0x87, 0xfc,
+	0xbf, 0xbe, 0xdc, 0xb0, 0x33, 0x9a, 0xb8, 0x66, 0x67, 0x34, 0x39, 0xda, 0xce, 0x28, 0xf5, 0x5f,
+	0xd3, 0x19, 0xa5, 0x47, 0xdc, 0x19, 0x39, 0xe0, 0xfe, 0x05, 0x81, 0x3f, 0x82, 0x54, 0xfb, 0xe3,
+	0x04, 0x98, 0x65, 0xbd, 0x58, 0x95, 0x38, 0xee, 0x0f, 0xa8, 0xcd, 0x7f, 0x06, 0x00, 0x9b, 0xd4,
+	0x0f, 0x08, 0x9e, 0x22, 0x77, 0x62, 0x7e, 0x0b, 0x76, 0xbc, 0x7f, 0xea, 0x62, 0x6d, 0xb1, 0xd7,
+	0x55, 0xe7, 0xc4, 0x3b, 0xaa, 0xaf, 0x08, 0x51, 0xc6, 0x09, 0x10, 0xff, 0x0b, 0xe7, 0xef, 0x01,
+	0x00, 0x1e, 0x71, 0xdc, 0x9a, 0xdb, 0x36, 0xea, 0x41, 0xde, 0x6c, 0x5e, 0x2f, 0x6f, 0xfa, 0x66,
+	0xe8, 0x33, 0x41, 0x94, 0xf1, 0x3f, 0xf6, 0xfc, 0xdf, 0xc3, 0x53, 0x34, 0xfd, 0x8e, 0x53, 0x14,
+	0x2e, 0x83, 0xa5, 0x58, 0xdc, 0x86, 0xdd, 0xe9, 0xef, 0xc6, 0x00, 0xd8, 0xf1, 0x5a, 0x55, 0x4c,
+	0xb6, 0x31, 0xf6, 0xa4, 0x75, 0x90, 0xd1, 0x3b, 0xe4, 0xd0, 0x69, 0xfb, 0x87, 0x25, 0x0b, 0x69,
+	0xe1, 0x7c, 0x0f, 0x45, 0x10, 0xf5, 0x61, 0xd2, 0x1a, 0xc8, 0x58, 0xfa, 0x11, 0x6e, 0xd7, 0xfc,
+	0x7b, 0xb8, 0x1f, 0xd4, 0xd3, 0xa2, 0x4e, 0x28, 0x82, 0x28, 0x4d, 0x7f, 0x6f, 0x63, 0xec, 0xab,
+	0x90, 0x50, 0x25, 0x19, 0x57, 0x21, 0x82, 0x0a, 0x09, 0x54, 0x30, 0x98, 0x35, 0x6c, 0x8f, 0xb4,
+	0x3b, 0x96, 0x9f, 0x23, 0x4d, 0x8c, 0x3d, 0x79, 0x9c, 0x5e, 0xd8, 0x57, 0xa2, 0x81, 0x5a, 0x09,
+	0x41, 0xfe, 0x86, 0xb4, 0x3c, 0x8f, 0x06, 0x9e, 0x62, 0x31, 0x0a, 0x88, 0x66, 0x8c, 0x08, 0x1e,
+	0x2e, 0x00, 0xa9, 0x6f, 0x8e, 0xd0, 0x4a, 0xdd, 0x24, 0x58, 0x64, 0xc3, 0x7d, 0x7a, 0xd4, 0x31,
+	0x6f, 0x68, 0xb0, 0x77, 0x71, 0x29, 0x91, 0x6a, 0x20, 0x43, 0x8c, 0xfa, 0x51, 0xcd, 0x33, 0xbe,
+	0x0a, 0x6e, 0xef, 0xda, 0xb5, 0x83, 0x3b, 0x70, 0x48, 0x40, 0xe4, 0x3b, 0xc4, 0xa8, 0x1f, 0x55,
+	0x8d, 0xaf, 0xb0, 0x64, 0x81, 0x19, 0xcb, 0xb0, 0x79, 0xb5, 0xa2, 0xb3, 0xb0, 0x93, 0xf0, 0x93,
+	0x6b, 0x37, 0x57, 0x8b, 0x3c, 0x52, 0x22, 0x6c, 0x10, 0x4d, 0x59, 0x86, 0xcd, 0xde, 0xb3, 0xfc,
+	0xe9, 0xbe, 0x00, 0x69, 0xd3, 0x21, 0x6c, 0x22, 0x76, 0xfb, 0xdf, 0xb8, 0xf6, 0x44, 0xfc, 0x59,
+	0x2d, 0xe0, 0x81, 0x28, 0x65, 0x3a, 0xc4, 0x67, 0x87, 0x2a, 0xb8, 0x3b, 0xd4, 0xbf, 0x61, 0x04,
+	0xfc, 0x39, 0x41, 0x2f, 0xc8, 0x8f, 0x75, 0x93, 0xf8, 0xe7, 0x90, 0x61, 0xb7, 0x6e, 0xe4, 0x7a,
+	0x0d, 0x8c, 0x1f, 0xea, 0x26, 0x91, 0xc7, 0x78, 0x15, 0x1b, 0x78, 0x6b, 0x32, 0x6c, 0x3a, 0x87,
+	0x36, 0xcf, 0xe3, 0x36, 0xcb, 0xd8, 0x7c, 0x25, 0x88, 0xa8, 0xae, 0xdf, 0xde, 0xd7, 0xe9, 0xa1,
+	0xc7, 0xac, 0xe5, 0xd1, 0xa8, 0x48, 0x8b, 0xb5, 0x3e, 0x22, 0x86, 0x68, 0xaa, 0xde, 0xbf, 0x58,
+	0x7b, 0xfc, 0xae, 0x2d, 0x6c, 0x24, 0xdc, 0xe3, 0xab, 0x04, 0xfd, 0xfb, 0x82, 0x7f, 0x18, 0x5a,
+	0xf8, 0x7b, 0xde, 0x25, 0x54, 0x80, 0x1c, 0x5f, 0x4b, 0xb8, 0xd0, 0xdf, 0x26, 0xe8, 0x41, 0x5c,
+	0xc5, 0x84, 0x56, 0x59, 0x4d, 0xb7, 0x1b, 0x37, 0x5a, 0xe7, 0x2f, 0xc1, 0xf8, 0x81, 0x6e, 0x37,
+	0xf8, 0x3a, 0x97, 0x62, 0x97, 0x9c, 0x80, 0x3a, 0xbe, 0x4a, 0x5f, 0x05, 0x22, 0xaa, 0xc9, 0x2b,
+	0xab, 0xb8, 0x90, 0x70, 0x91, 0xff, 0x1c, 0xa3, 0xcf, 0x82, 0x55, 0xde, 0x8f, 0xd0, 0x4b, 0x80,
+	0x77, 0x43, 0x73, 0xfa, 0x15, 0xbd, 0xe6, 0xb8, 0xd8, 0x0e, 0x5c, 0xce, 0xca, 0xac, 0xd0, 0x3b,
+	0xc4, 0x00, 0x10, 0x4d, 0x5b, 0xfa, 0xc9, 0xae, 0x8b, 0x6d, 0xfe, 0x60, 0x72, 0x00, 0x14, 0x1f,
+	0x22, 0xd4, 0x3f, 0x91, 0x8e, 0x95, 0xe0, 0x07, 0xbd, 0xae, 0x7a, 0xaf, 0x4f, 0x37, 0x1c, 0x0b,
+	0xd1, 0x92, 0xa5, 0x9f, 0xf4, 0xd3, 0x44, 0x98, 0xe3, 0x18, 0x2c, 0xe8, 0xf5, 0xba, 0xd3, 0x09,
+	0x7b, 0x18, 0x93, 0xee, 0x99, 0xd7, 0xe9, 0x42, 0xd4, 0xbc, 0x1b, 0x0c, 0x29, 0xd8, 0x46, 0xbb,
+	0xcf, 0xed, 0x7c, 0x87, 0x1b, 0x63, 0x08, 0x17, 0x44, 0x92, 0x3e, 0xa0, 0x08, 0xef, 0x80, 0xe5,
+	0x01, 0x4b, 0x87, 0x7e, 0xf8, 0x53, 0x22, 0x28, 0xe9, 0x9a, 0x4e, 0xea, 0x87, 0x1b, 0x9d, 0x3a,
+	0xad, 0x8f, 0x37, 0x71, 0xc4, 0x13, 0x90, 0xd2, 0x99, 0x3a, 0x0f, 0x99, 0xd8, 0xbd, 0x58, 0x9c,
+	0x40, 0xbb, 0xcd, 0x77, 0x33, 0x13, 0x30, 0xd6, 0x59, 0x95, 0x0e, 0x28, 0xa4, 0x1f, 0x83, 0x14,
+	0xb6, 0xf5, 0x83, 0xe0, 0xf9, 0x3d, 0xad, 0x49, 0x7d, 0x34, 0x17, 0x40, 0x14, 0x40, 0xf8, 0x23,
+	0x53, 0x6c, 0x17, 0xc1, 0x26, 0x7f, 0xf4, 0x97, 0x04, 0xc8, 0x0a, 0x6f, 0xff, 0xd2, 0x23, 0x20,
+	0xef, 0xa2, 0xad, 0x32, 0xaa, 0x55, 0xf7, 0x37, 0xf6, 0x9f, 0x57, 0x6b, 0xcf, 0x9f, 0x56, 0xf7,
+	0xca, 0x9b, 0x95, 0xed, 0x4a, 0x79, 0x2b, 0x77, 0x4b, 0x99, 0x7d, 0xf5, 0xba, 0x90, 0x7d, 0x6e,
+	0x7b, 0x2e, 0xae, 0x1b, 0x4d, 0x03, 0x37, 0xa4, 0x07, 0x60, 0x21, 0x02, 0x47, 0xe5, 0xea, 0x7e,
+	0xe5, 0xe9, 0x27, 0xb9, 0x84, 0x92, 0x7d, 0xf5, 0xba, 0x90, 0x42, 0xf4, 0x5c, 0x69, 0x49, 0xf7,
+	0xc1, 0x7c, 0x04, 0xb6, 0x5d, 0x79, 0xf2, 0xa4, 0xbc, 0x95, 0x1b, 0x53, 0xc0, 0xab, 0xd7, 0x85,
+	0x49, 0xfe, 0x20, 0x1f, 0xe7, 0x2a, 0x7f, 0xb6, 0x57, 0x41, 0xe5, 0xad, 0x5c, 0x92, 0x71, 0x95,
+	0xe9, 0x53, 0x43, 0x63, 0x80, 0xeb, 0x53, 0xc6, 0x35, 0xce, 0xb8, 0x3e, 0xa5, 0x5c, 0xeb, 0xbf,
+	0x07, 0x20, 0xb9, 0xe3, 0xb5, 0xfc, 0xce, 0x37, 0xfa, 0x57, 0xcf, 0x7c, 0xd4, 0xf0, 0xf1, 0xbf,
+	0xe1, 0x28, 0x0f, 0x2f, 0x96, 0x87, 0x97, 0x83, 0xcf, 0xc1, 0x4c, 0xec, 0xdd, 0x5e, 0x1d, 0xa6,
+	0x29, 0x00, 0x94, 0xf7, 0x2f, 0x01, 0x84, 0xdc, 0xcf, 0x40, 0x56, 0x7c, 0x4f, 0x5d, 0x19, 0xd0,
+	0x13, 0xa4, 0xca, 0x7b, 0x17, 0x49, 0x43, 0xca, 0x5f, 0x81, 0xd9, 0xf8, 0x4b, 0x68, 0xe1, 0x1c,
+	0xc5, 0x10, 0xa1, 0xac, 0x5e, 0x86, 0x08, 0xe9, 0x3b, 0x60, 0xe9, 0xbc, 0x67, 0xca, 0xf3, 0x48,
+	0x06, 0x90, 0xca, 0x4f, 0xae, 0x8a, 0x0c, 0xa7, 0x3d, 0x01, 0xf2, 0xb9, 0xcf, 0x17, 0x1f, 0x5c,
+	0xcc, 0x26, 0x3a, 0x66, 0xed, 0xca, 0xd0, 0x70, 0xe6, 0x7d, 0x30, 0x15, 0xb9, 0xcd, 0xdd, 0x1d,
+	0xe6, 0xdb, 0x50, 0xac, 0x3c, 0xb8, 0x50, 0x1c, 0xb2, 0x96, 0x41, 0x2a, 0xe8, 0xa7, 0xe5, 0x01,
+	0x0d, 0x2e, 0x51, 0x0a, 0xe7, 0x49, 0x42, 0x9a, 0x26, 0x90, 0x86, 0x34, 0x9c, 0xf7, 0x87, 0xe9,
+	0xc5, 0x40, 0xca, 0x87, 0x57, 0x00, 0x89, 0x71, 0x2a, 0xb6, 0x35, 0x83, 0x71, 0x2a, 0x48, 0x95,
+	0xf7, 0x2e, 0x92, 0x86, 0x94, 0x2f, 0xc0, 0x74, 0xb4, 0x8b, 0x18, 0xcc, 0xd7, 0x88, 0x5c, 0x79,
+	0x78, 0xb1, 0x5c, 0x74, 0x58, 0xe4, 0xd4, 0xbf, 0x3b, 0x6c, 0xa3, 0xa1, 0x58, 0x79, 0x70, 0xa1,
+	0x58, 0xac, 0x02, 0xb1, 0x63, 0x5a, 0x1d, 0xa6, 0x28, 0x00, 0x94, 0xf7, 0x2f, 0x01, 0x88, 0x29,
+	0x1b, 0x3f, 0x7a, 0x86, 0xba, 0x5e, 0x44, 0x28, 0xab, 0x97, 0x21, 0x02, 0x7a, 0xad, 0xfc, 0xcd,
+	0x59, 0x3e, 0xf1, 0xe6, 0x2c, 0x9f, 0xf8, 0xc7, 0x59, 0x3e, 0xf1, 0xf5, 0xdb, 0xfc, 0xad, 0x37,
+	0x6f, 0xf3, 0xb7, 0xfe, 0xfa, 0x36, 0x7f, 0xeb, 0xf3, 0x0f, 0x85, 0xb6, 0x18, 0x3f, 0xb2, 0x1c,
+	0x1b, 0x9f, 0x96, 0xb0, 0xf5, 0xc8, 0xc4, 0x8d, 0x16, 0x6e, 0x97, 0x4e, 0x82, 0xff, 0x85, 0x42,
+	0xfb, 0xe3, 0x83, 0x49, 0xfa, 0xdc, 0xfc, 0xd3, 0xff, 0x0c, 0x00, 0x80, 0x7a, 0x77, 0x94, 0xfa,
+	0x22, 0x00, 0x00,
 }
 
-func (m *MsgAddMarketOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	AddLimitOrder(ctx context.Context, in *MsgAddLimitOrder, opts ...grpc.CallOption) (*MsgAddLimitOrderResponse, error)
+	AddMarketOrder(ctx context.Context, in *MsgAddMarketOrder, opts ...grpc.CallOption) (*MsgAddMarketOrderResponse, error)
+	CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error)
+	CancelAllOrders(ctx context.Context, in *MsgCancelAllOrders, opts ...grpc.CallOption) (*MsgCancelAllOrdersResponse, error)
+	CancelReplaceLimitOrder(ctx context.Context, in *MsgCancelReplaceLimitOrder, opts ...grpc.CallOption) (*MsgCancelReplaceLimitOrderResponse, error)
+	CancelReplaceMarketOrder(ctx context.Context, in *MsgCancelReplaceMarketOrder, opts ...grpc.CallOption) (*MsgCancelReplaceMarketOrderResponse, error)
+	AddStopOrder(ctx context.Context, in *MsgAddStopOrder, opts ...grpc.CallOption) (*MsgAddStopOrderResponse, error)
+	SetFees(ctx context.Context, in *MsgSetFees, opts ...grpc.CallOption) (*MsgSetFeesResponse, error)
+	SetInstrumentRules(ctx context.Context, in *MsgSetInstrumentRules, opts ...grpc.CallOption) (*MsgSetInstrumentRulesResponse, error)
+	HaltTrading(ctx context.Context, in *MsgHaltTrading, opts ...grpc.CallOption) (*MsgHaltTradingResponse, error)
+	ResumeTrading(ctx context.Context, in *MsgResumeTrading, opts ...grpc.CallOption) (*MsgResumeTradingResponse, error)
+	SetPriceBand(ctx context.Context, in *MsgSetPriceBand, opts ...grpc.CallOption) (*MsgSetPriceBandResponse, error)
+	SetOrderLimits(ctx context.Context, in *MsgSetOrderLimits, opts ...grpc.CallOption) (*MsgSetOrderLimitsResponse, error)
+	SetBatchAuction(ctx context.Context, in *MsgSetBatchAuction, opts ...grpc.CallOption) (*MsgSetBatchAuctionResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AddLimitOrder(ctx context.Context, in *MsgAddLimitOrder, opts ...grpc.CallOption) (*MsgAddLimitOrderResponse, error) {
+	out := new(MsgAddLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/AddLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgCancelOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) AddMarketOrder(ctx context.Context, in *MsgAddMarketOrder, opts ...grpc.CallOption) (*MsgAddMarketOrderResponse, error) {
+	out := new(MsgAddMarketOrderResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/AddMarketOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error) {
+	out := new(MsgCancelOrderResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ClientOrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CancelAllOrders(ctx context.Context, in *MsgCancelAllOrders, opts ...grpc.CallOption) (*MsgCancelAllOrdersResponse, error) {
+	out := new(MsgCancelAllOrdersResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/CancelAllOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCancelOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CancelReplaceLimitOrder(ctx context.Context, in *MsgCancelReplaceLimitOrder, opts ...grpc.CallOption) (*MsgCancelReplaceLimitOrderResponse, error) {
+	out := new(MsgCancelReplaceLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/CancelReplaceLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgCancelReplaceLimitOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CancelReplaceMarketOrder(ctx context.Context, in *MsgCancelReplaceMarketOrder, opts ...grpc.CallOption) (*MsgCancelReplaceMarketOrderResponse, error) {
+	out := new(MsgCancelReplaceMarketOrderResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/CancelReplaceMarketOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) AddStopOrder(ctx context.Context, in *MsgAddStopOrder, opts ...grpc.CallOption) (*MsgAddStopOrderResponse, error) {
+	out := new(MsgAddStopOrderResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/AddStopOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.OrigClientOrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetFees(ctx context.Context, in *MsgSetFees, opts ...grpc.CallOption) (*MsgSetFeesResponse, error) {
+	out := new(MsgSetFeesResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/SetFees", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.NewClientOrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetInstrumentRules(ctx context.Context, in *MsgSetInstrumentRules, opts ...grpc.CallOption) (*MsgSetInstrumentRulesResponse, error) {
+	out := new(MsgSetInstrumentRulesResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/SetInstrumentRules", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.TimeInForce != 0 {
-		n += 1 + sovTx(uint64(m.TimeInForce))
+	return out, nil
+}
+
+func (c *msgClient) HaltTrading(ctx context.Context, in *MsgHaltTrading, opts ...grpc.CallOption) (*MsgHaltTradingResponse, error) {
+	out := new(MsgHaltTradingResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/HaltTrading", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Source.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Destination.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgCancelReplaceLimitOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ResumeTrading(ctx context.Context, in *MsgResumeTrading, opts ...grpc.CallOption) (*MsgResumeTradingResponse, error) {
+	out := new(MsgResumeTradingResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/ResumeTrading", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgCancelReplaceMarketOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SetPriceBand(ctx context.Context, in *MsgSetPriceBand, opts ...grpc.CallOption) (*MsgSetPriceBandResponse, error) {
+	out := new(MsgSetPriceBandResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/SetPriceBand", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetOrderLimits(ctx context.Context, in *MsgSetOrderLimits, opts ...grpc.CallOption) (*MsgSetOrderLimitsResponse, error) {
+	out := new(MsgSetOrderLimitsResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/SetOrderLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.OrigClientOrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetBatchAuction(ctx context.Context, in *MsgSetBatchAuction, opts ...grpc.CallOption) (*MsgSetBatchAuctionResponse, error) {
+	out := new(MsgSetBatchAuctionResponse)
+	err := c.cc.Invoke(ctx, "/em.market.v1.Msg/SetBatchAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.NewClientOrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	AddLimitOrder(context.Context, *MsgAddLimitOrder) (*MsgAddLimitOrderResponse, error)
+	AddMarketOrder(context.Context, *MsgAddMarketOrder) (*MsgAddMarketOrderResponse, error)
+	CancelOrder(context.Context, *MsgCancelOrder) (*MsgCancelOrderResponse, error)
+	CancelAllOrders(context.Context, *MsgCancelAllOrders) (*MsgCancelAllOrdersResponse, error)
+	CancelReplaceLimitOrder(context.Context, *MsgCancelReplaceLimitOrder) (*MsgCancelReplaceLimitOrderResponse, error)
+	CancelReplaceMarketOrder(context.Context, *MsgCancelReplaceMarketOrder) (*MsgCancelReplaceMarketOrderResponse, error)
+	AddStopOrder(context.Context, *MsgAddStopOrder) (*MsgAddStopOrderResponse, error)
+	SetFees(context.Context, *MsgSetFees) (*MsgSetFeesResponse, error)
+	SetInstrumentRules(context.Context, *MsgSetInstrumentRules) (*MsgSetInstrumentRulesResponse, error)
+	HaltTrading(context.Context, *MsgHaltTrading) (*MsgHaltTradingResponse, error)
+	ResumeTrading(context.Context, *MsgResumeTrading) (*MsgResumeTradingResponse, error)
+	SetPriceBand(context.Context, *MsgSetPriceBand) (*MsgSetPriceBandResponse, error)
+	SetOrderLimits(context.Context, *MsgSetOrderLimits) (*MsgSetOrderLimitsResponse, error)
+	SetBatchAuction(context.Context, *MsgSetBatchAuction) (*MsgSetBatchAuctionResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) AddLimitOrder(ctx context.Context, req *MsgAddLimitOrder) (*MsgAddLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLimitOrder not implemented")
+}
+func (*UnimplementedMsgServer) AddMarketOrder(ctx context.Context, req *MsgAddMarketOrder) (*MsgAddMarketOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMarketOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelOrder(ctx context.Context, req *MsgCancelOrder) (*MsgCancelOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelAllOrders(ctx context.Context, req *MsgCancelAllOrders) (*MsgCancelAllOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAllOrders not implemented")
+}
+func (*UnimplementedMsgServer) CancelReplaceLimitOrder(ctx context.Context, req *MsgCancelReplaceLimitOrder) (*MsgCancelReplaceLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReplaceLimitOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelReplaceMarketOrder(ctx context.Context, req *MsgCancelReplaceMarketOrder) (*MsgCancelReplaceMarketOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReplaceMarketOrder not implemented")
+}
+func (*UnimplementedMsgServer) AddStopOrder(ctx context.Context, req *MsgAddStopOrder) (*MsgAddStopOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStopOrder not implemented")
+}
+func (*UnimplementedMsgServer) SetFees(ctx context.Context, req *MsgSetFees) (*MsgSetFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFees not implemented")
+}
+func (*UnimplementedMsgServer) SetInstrumentRules(ctx context.Context, req *MsgSetInstrumentRules) (*MsgSetInstrumentRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInstrumentRules not implemented")
+}
+func (*UnimplementedMsgServer) HaltTrading(ctx context.Context, req *MsgHaltTrading) (*MsgHaltTradingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HaltTrading not implemented")
+}
+func (*UnimplementedMsgServer) ResumeTrading(ctx context.Context, req *MsgResumeTrading) (*MsgResumeTradingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeTrading not implemented")
+}
+func (*UnimplementedMsgServer) SetPriceBand(ctx context.Context, req *MsgSetPriceBand) (*MsgSetPriceBandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPriceBand not implemented")
+}
+func (*UnimplementedMsgServer) SetOrderLimits(ctx context.Context, req *MsgSetOrderLimits) (*MsgSetOrderLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOrderLimits not implemented")
+}
+func (*UnimplementedMsgServer) SetBatchAuction(ctx context.Context, req *MsgSetBatchAuction) (*MsgSetBatchAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBatchAuction not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_AddLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.TimeInForce != 0 {
-		n += 1 + sovTx(uint64(m.TimeInForce))
+	if interceptor == nil {
+		return srv.(MsgServer).AddLimitOrder(ctx, in)
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Msg/AddLimitOrder",
 	}
-	l = m.Destination.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MaxSlippage.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddLimitOrder(ctx, req.(*MsgAddLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelReplaceMarketOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AddMarketOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddMarketOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).AddMarketOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Msg/AddMarketOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddMarketOrder(ctx, req.(*MsgAddMarketOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func _Msg_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Msg/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOrder(ctx, req.(*MsgCancelOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func _Msg_CancelAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAllOrders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAllOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Msg/CancelAllOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAllOrders(ctx, req.(*MsgCancelAllOrders))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelReplaceLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelReplaceLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelReplaceLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Msg/CancelReplaceLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelReplaceLimitOrder(ctx, req.(*MsgCancelReplaceLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelReplaceMarketOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelReplaceMarketOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelReplaceMarketOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Msg/CancelReplaceMarketOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelReplaceMarketOrder(ctx, req.(*MsgCancelReplaceMarketOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddStopOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddStopOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddStopOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Msg/AddStopOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddStopOrder(ctx, req.(*MsgAddStopOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFees)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Msg/SetFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFees(ctx, req.(*MsgSetFees))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetInstrumentRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetInstrumentRules)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetInstrumentRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Msg/SetInstrumentRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetInstrumentRules(ctx, req.(*MsgSetInstrumentRules))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HaltTrading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgHaltTrading)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HaltTrading(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Msg/HaltTrading",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HaltTrading(ctx, req.(*MsgHaltTrading))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeTrading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeTrading)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeTrading(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Msg/ResumeTrading",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeTrading(ctx, req.(*MsgResumeTrading))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPriceBand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPriceBand)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPriceBand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Msg/SetPriceBand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPriceBand(ctx, req.(*MsgSetPriceBand))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetOrderLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetOrderLimits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetOrderLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Msg/SetOrderLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetOrderLimits(ctx, req.(*MsgSetOrderLimits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBatchAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBatchAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBatchAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.market.v1.Msg/SetBatchAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBatchAuction(ctx, req.(*MsgSetBatchAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "em.market.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddLimitOrder",
+			Handler:    _Msg_AddLimitOrder_Handler,
+		},
+		{
+			MethodName: "AddMarketOrder",
+			Handler:    _Msg_AddMarketOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _Msg_CancelOrder_Handler,
+		},
+		{
+			MethodName: "CancelAllOrders",
+			Handler:    _Msg_CancelAllOrders_Handler,
+		},
+		{
+			MethodName: "CancelReplaceLimitOrder",
+			Handler:    _Msg_CancelReplaceLimitOrder_Handler,
+		},
+		{
+			MethodName: "CancelReplaceMarketOrder",
+			Handler:    _Msg_CancelReplaceMarketOrder_Handler,
+		},
+		{
+			MethodName: "AddStopOrder",
+			Handler:    _Msg_AddStopOrder_Handler,
+		},
+		{
+			MethodName: "SetFees",
+			Handler:    _Msg_SetFees_Handler,
+		},
+		{
+			MethodName: "SetInstrumentRules",
+			Handler:    _Msg_SetInstrumentRules_Handler,
+		},
+		{
+			MethodName: "HaltTrading",
+			Handler:    _Msg_HaltTrading_Handler,
+		},
+		{
+			MethodName: "ResumeTrading",
+			Handler:    _Msg_ResumeTrading_Handler,
+		},
+		{
+			MethodName: "SetPriceBand",
+			Handler:    _Msg_SetPriceBand_Handler,
+		},
+		{
+			MethodName: "SetOrderLimits",
+			Handler:    _Msg_SetOrderLimits_Handler,
+		},
+		{
+			MethodName: "SetBatchAuction",
+			Handler:    _Msg_SetBatchAuction_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "em/market/v1/tx.proto",
+}
+
+func (m *MsgAddLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DisplayQuantity.Size()
+		i -= size
+		if _, err := m.DisplayQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PostOnly != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PostOnly))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExpireHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpireHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExpireTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpireTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpireTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TimeInForce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClientOrderId) > 0 {
+		i -= len(m.ClientOrderId)
+		copy(dAtA[i:], m.ClientOrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientOrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fills) > 0 {
+		for iNdEx := len(m.Fills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.DestinationFilled.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.SourceFilled.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OrderID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddMarketOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMarketOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMarketOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClientOrderId) > 0 {
+		i -= len(m.ClientOrderId)
+		copy(dAtA[i:], m.ClientOrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientOrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddMarketOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMarketOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMarketOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientOrderId) > 0 {
+		i -= len(m.ClientOrderId)
+		copy(dAtA[i:], m.ClientOrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientOrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAllOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAllOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAllOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAllOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAllOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAllOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelReplaceLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelReplaceLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelReplaceLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DisplayQuantity.Size()
+		i -= size
+		if _, err := m.DisplayQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.PostOnly != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PostOnly))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExpireHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpireHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExpireTime != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpireTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpireTime):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintTx(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.TimeInForce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewClientOrderId) > 0 {
+		i -= len(m.NewClientOrderId)
+		copy(dAtA[i:], m.NewClientOrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewClientOrderId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrigClientOrderId) > 0 {
+		i -= len(m.OrigClientOrderId)
+		copy(dAtA[i:], m.OrigClientOrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrigClientOrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelReplaceLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelReplaceLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelReplaceLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelReplaceMarketOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelReplaceMarketOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelReplaceMarketOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewClientOrderId) > 0 {
+		i -= len(m.NewClientOrderId)
+		copy(dAtA[i:], m.NewClientOrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewClientOrderId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrigClientOrderId) > 0 {
+		i -= len(m.OrigClientOrderId)
+		copy(dAtA[i:], m.OrigClientOrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrigClientOrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelReplaceMarketOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelReplaceMarketOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelReplaceMarketOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddStopOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddStopOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddStopOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.StopPrice.Size()
+		i -= size
+		if _, err := m.StopPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.OrderType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClientOrderId) > 0 {
+		i -= len(m.ClientOrderId)
+		copy(dAtA[i:], m.ClientOrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientOrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddStopOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddStopOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddStopOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InstrumentFees) > 0 {
+		for iNdEx := len(m.InstrumentFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InstrumentFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TakerFee != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TakerFee))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MakerFee != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MakerFee))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetInstrumentRules) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetInstrumentRules) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetInstrumentRules) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LotSize.Size()
+		i -= size
+		if _, err := m.LotSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinOrderSize.Size()
+		i -= size
+		if _, err := m.MinOrderSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TickSize.Size()
+		i -= size
+		if _, err := m.TickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetInstrumentRulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetInstrumentRulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetInstrumentRulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgHaltTrading) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgHaltTrading) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgHaltTrading) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CancelOrders {
+		i--
+		if m.CancelOrders {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Halt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgHaltTradingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgHaltTradingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgHaltTradingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeTrading) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeTrading) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeTrading) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Halt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeTradingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeTradingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeTradingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPriceBand) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPriceBand) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPriceBand) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Band.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPriceBandResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPriceBandResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPriceBandResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOrderLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOrderLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOrderLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccountOrderLimits) > 0 {
+		for iNdEx := len(m.AccountOrderLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountOrderLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxInstrumentOpenOrders != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxInstrumentOpenOrders))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxOpenOrders != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxOpenOrders))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOrderLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOrderLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOrderLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBatchAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBatchAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBatchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBatchAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBatchAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBatchAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClientOrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovTx(uint64(m.TimeInForce))
+	}
+	l = m.Source.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Destination.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ExpireTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpireTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpireHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpireHeight))
+	}
+	if m.PostOnly != 0 {
+		n += 1 + sovTx(uint64(m.PostOnly))
+	}
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovTx(uint64(m.SelfTradePrevention))
+	}
+	l = m.DisplayQuantity.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Result.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *OrderResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderID != 0 {
+		n += 1 + sovTx(uint64(m.OrderID))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	l = m.SourceFilled.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.DestinationFilled.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Fills) > 0 {
+		for _, e := range m.Fills {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddMarketOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClientOrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovTx(uint64(m.TimeInForce))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Destination.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovTx(uint64(m.SelfTradePrevention))
+	}
+	return n
+}
+
+func (m *MsgAddMarketOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Result.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClientOrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelAllOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelAllOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelReplaceLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrigClientOrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewClientOrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovTx(uint64(m.TimeInForce))
+	}
+	l = m.Source.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Destination.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ExpireTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpireTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpireHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpireHeight))
+	}
+	if m.PostOnly != 0 {
+		n += 1 + sovTx(uint64(m.PostOnly))
+	}
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovTx(uint64(m.SelfTradePrevention))
+	}
+	l = m.DisplayQuantity.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelReplaceLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Result.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelReplaceMarketOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrigClientOrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewClientOrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovTx(uint64(m.TimeInForce))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Destination.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovTx(uint64(m.SelfTradePrevention))
+	}
+	return n
+}
+
+func (m *MsgCancelReplaceMarketOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Result.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddStopOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClientOrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovTx(uint64(m.TimeInForce))
+	}
+	if m.OrderType != 0 {
+		n += 1 + sovTx(uint64(m.OrderType))
+	}
+	l = m.Source.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Destination.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.StopPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddStopOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MakerFee != 0 {
+		n += 1 + sovTx(uint64(m.MakerFee))
+	}
+	if m.TakerFee != 0 {
+		n += 1 + sovTx(uint64(m.TakerFee))
+	}
+	if len(m.InstrumentFees) > 0 {
+		for _, e := range m.InstrumentFees {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetInstrumentRules) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TickSize.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinOrderSize.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LotSize.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetInstrumentRulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgHaltTrading) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Halt.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.CancelOrders {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgHaltTradingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResumeTrading) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Halt.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgResumeTradingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPriceBand) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Band.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetPriceBandResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetOrderLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxOpenOrders != 0 {
+		n += 1 + sovTx(uint64(m.MaxOpenOrders))
+	}
+	if m.MaxInstrumentOpenOrders != 0 {
+		n += 1 + sovTx(uint64(m.MaxInstrumentOpenOrders))
+	}
+	if len(m.AccountOrderLimits) > 0 {
+		for _, e := range m.AccountOrderLimits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetOrderLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBatchAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Auction.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetBatchAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientOrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientOrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpireTime == nil {
+				m.ExpireTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireHeight", wireType)
+			}
+			m.ExpireHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostOnly", wireType)
+			}
+			m.PostOnly = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostOnly |= PostOnlyMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DisplayQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddLimitOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddLimitOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderID", wireType)
+			}
+			m.OrderID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= OrderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceFilled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceFilled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationFilled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DestinationFilled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fills", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fills = append(m.Fills, Trade{})
+			if err := m.Fills[len(m.Fills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMarketOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMarketOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMarketOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientOrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientOrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMarketOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMarketOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMarketOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientOrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientOrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAllOrders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAllOrders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAllOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAllOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAllOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAllOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelReplaceLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelReplaceLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelReplaceLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrigClientOrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrigClientOrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewClientOrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewClientOrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpireTime == nil {
+				m.ExpireTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireHeight", wireType)
+			}
+			m.ExpireHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostOnly", wireType)
+			}
+			m.PostOnly = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostOnly |= PostOnlyMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DisplayQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelReplaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelReplaceLimitOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelReplaceLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelReplaceMarketOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelReplaceMarketOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelReplaceMarketOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrigClientOrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrigClientOrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewClientOrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewClientOrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelReplaceMarketOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelReplaceMarketOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelReplaceMarketOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddStopOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddStopOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddStopOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientOrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientOrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= StopOrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StopPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddStopOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddStopOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddStopOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFee", wireType)
+			}
+			m.MakerFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerFee |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			m.TakerFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerFee |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstrumentFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstrumentFees = append(m.InstrumentFees, InstrumentFees{})
+			if err := m.InstrumentFees[len(m.InstrumentFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgAddLimitOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgSetInstrumentRules) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1519,15 +7027,15 @@ func (m *MsgAddLimitOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddLimitOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetInstrumentRules: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetInstrumentRules: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1555,11 +7063,11 @@ func (m *MsgAddLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientOrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1587,13 +7095,13 @@ func (m *MsgAddLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientOrderId = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			m.TimeInForce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1603,16 +7111,29 @@ func (m *MsgAddLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSize", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1622,30 +7143,31 @@ func (m *MsgAddLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOrderSize", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1655,22 +7177,57 @@ func (m *MsgAddLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinOrderSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LotSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1695,7 +7252,7 @@ func (m *MsgAddLimitOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddLimitOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetInstrumentRulesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1718,10 +7275,10 @@ func (m *MsgAddLimitOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddLimitOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetInstrumentRulesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetInstrumentRulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1745,7 +7302,7 @@ func (m *MsgAddLimitOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddMarketOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgHaltTrading) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1768,15 +7325,15 @@ func (m *MsgAddMarketOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMarketOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgHaltTrading: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMarketOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgHaltTrading: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1804,13 +7361,13 @@ func (m *MsgAddMarketOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientOrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Halt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1820,29 +7377,30 @@ func (m *MsgAddMarketOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientOrderId = string(dAtA[iNdEx:postIndex])
+			if err := m.Halt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelOrders", wireType)
 			}
-			m.TimeInForce = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1852,14 +7410,115 @@ func (m *MsgAddMarketOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			m.CancelOrders = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgHaltTradingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgHaltTradingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgHaltTradingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumeTrading) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumeTrading: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumeTrading: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1887,11 +7546,11 @@ func (m *MsgAddMarketOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Halt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1918,41 +7577,7 @@ func (m *MsgAddMarketOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Halt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1977,7 +7602,7 @@ func (m *MsgAddMarketOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddMarketOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeTradingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2000,10 +7625,10 @@ func (m *MsgAddMarketOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMarketOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeTradingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMarketOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeTradingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2027,7 +7652,7 @@ func (m *MsgAddMarketOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPriceBand) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2050,15 +7675,15 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPriceBand: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPriceBand: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2086,13 +7711,13 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientOrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Band", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2102,23 +7727,24 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientOrderId = string(dAtA[iNdEx:postIndex])
+			if err := m.Band.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2141,7 +7767,7 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPriceBandResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2164,10 +7790,10 @@ func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPriceBandResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPriceBandResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2191,7 +7817,7 @@ func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelReplaceLimitOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgSetOrderLimits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2214,15 +7840,15 @@ func (m *MsgCancelReplaceLimitOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelReplaceLimitOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetOrderLimits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelReplaceLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetOrderLimits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2250,13 +7876,13 @@ func (m *MsgCancelReplaceLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrigClientOrderId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenOrders", wireType)
 			}
-			var stringLen uint64
+			m.MaxOpenOrders = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2266,80 +7892,16 @@ func (m *MsgCancelReplaceLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxOpenOrders |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OrigClientOrderId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewClientOrderId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewClientOrderId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
-			}
-			m.TimeInForce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TimeInForce |= TimeInForce(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInstrumentOpenOrders", wireType)
 			}
-			var msglen int
+			m.MaxInstrumentOpenOrders = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2349,28 +7911,14 @@ func (m *MsgCancelReplaceLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxInstrumentOpenOrders |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountOrderLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2397,7 +7945,8 @@ func (m *MsgCancelReplaceLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AccountOrderLimits = append(m.AccountOrderLimits, AccountOrderLimits{})
+			if err := m.AccountOrderLimits[len(m.AccountOrderLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2422,7 +7971,7 @@ func (m *MsgCancelReplaceLimitOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelReplaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetOrderLimitsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2445,10 +7994,10 @@ func (m *MsgCancelReplaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelReplaceLimitOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetOrderLimitsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelReplaceLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetOrderLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2472,7 +8021,7 @@ func (m *MsgCancelReplaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelReplaceMarketOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBatchAuction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2495,15 +8044,15 @@ func (m *MsgCancelReplaceMarketOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelReplaceMarketOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBatchAuction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelReplaceMarketOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBatchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2531,126 +8080,11 @@ func (m *MsgCancelReplaceMarketOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrigClientOrderId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OrigClientOrderId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewClientOrderId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewClientOrderId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
-			}
-			m.TimeInForce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TimeInForce |= TimeInForce(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Source = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2677,15 +8111,15 @@ func (m *MsgCancelReplaceMarketOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2695,26 +8129,12 @@ func (m *MsgCancelReplaceMarketOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2736,7 +8156,7 @@ func (m *MsgCancelReplaceMarketOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelReplaceMarketOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBatchAuctionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2759,10 +8179,10 @@ func (m *MsgCancelReplaceMarketOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelReplaceMarketOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBatchAuctionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelReplaceMarketOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBatchAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5,50 +5,141 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/jsonpb"
 )
 
+// orderJSON defines the JSON layout of an order. The price is derived from source and destination and ignored when reading.
+type orderJSON struct {
+	ID                  uint64     `json:"order_id,string"`
+	TimeInForce         string     `json:"time_in_force"`
+	Owner               string     `json:"owner"`
+	ClientOrderID       string     `json:"client_order_id"`
+	Price               *sdk.Dec   `json:"price,omitempty"`
+	Source              sdk.Coin   `json:"source"`
+	SourceRemaining     sdk.Int    `json:"source_remaining"`
+	SourceFilled        sdk.Int    `json:"source_filled"`
+	Destination         sdk.Coin   `json:"destination"`
+	DestinationFilled   sdk.Int    `json:"destination_filled"`
+	Created             time.Time  `json:"created"`
+	ExpireTime          *time.Time `json:"expire_time,omitempty"`
+	ExpireHeight        int64      `json:"expire_height,omitempty,string"`
+	PostOnly            string     `json:"post_only,omitempty"`
+	SelfTradePrevention string     `json:"self_trade_prevention,omitempty"`
+	DisplayQuantity     *sdk.Int   `json:"display_quantity,omitempty"`
+	DisplayRemaining    *sdk.Int   `json:"display_remaining,omitempty"`
+	Priority            uint64     `json:"priority,omitempty,string"`
+}
+
 func (o Order) MarshalJSON() ([]byte, error) {
-	s := fmt.Sprintf(`
-{
-  "order_id": "%v",
-  "time_in_force" : "%v",
-  "owner": "%v",
-  "client_order_id": "%v",
-  "price": "%v",
-  "source": {
-    "denom": "%v",
-    "amount": "%v"
-  },
-  "source_remaining": "%v",
-  "source_filled": "%v",
-  "destination": {
-    "denom": "%v",
-    "amount": "%v"
-  },
-  "destination_filled": "%v"
-}
-`,
-		o.ID,
-		o.TimeInForce,
-		o.Owner,
-		o.ClientOrderID,
-		o.Price().String(),
-		o.Source.Denom,
-		o.Source.Amount,
-		o.SourceRemaining,
-		o.SourceFilled,
-		o.Destination.Denom,
-		o.Destination.Amount,
-		o.DestinationFilled,
-	)
-
-	return []byte(s), nil
+	price := o.Price()
+
+	var postOnly string
+	if o.PostOnly != PostOnlyMode_None {
+		postOnly = o.PostOnly.String()
+	}
+
+	var selfTrade string
+	if o.SelfTradePrevention != SelfTradePrevention_None {
+		selfTrade = o.SelfTradePrevention.String()
+	}
+
+	var displayQuantity, displayRemaining *sdk.Int
+	if o.IsIceberg() {
+		displayQuantity, displayRemaining = &o.DisplayQuantity, &o.DisplayRemaining
+	}
+
+	return json.Marshal(orderJSON{
+		ID:                  o.ID,
+		TimeInForce:         o.TimeInForce.String(),
+		Owner:               o.Owner,
+		ClientOrderID:       o.ClientOrderID,
+		Price:               &price,
+		Source:              o.Source,
+		SourceRemaining:     o.SourceRemaining,
+		SourceFilled:        o.SourceFilled,
+		Destination:         o.Destination,
+		DestinationFilled:   o.DestinationFilled,
+		Created:             o.Created,
+		ExpireTime:          o.ExpireTime,
+		ExpireHeight:        o.ExpireHeight,
+		PostOnly:            postOnly,
+		SelfTradePrevention: selfTrade,
+		DisplayQuantity:     displayQuantity,
+		DisplayRemaining:    displayRemaining,
+		Priority:            o.Priority,
+	})
+}
+
+func (o *Order) UnmarshalJSON(bz []byte) error {
+	var v orderJSON
+	if err := json.Unmarshal(bz, &v); err != nil {
+		return err
+	}
+
+	tif, found := TimeInForce_value[v.TimeInForce]
+	if !found {
+		return sdkerrors.Wrapf(ErrUnknownTimeInForce, "Unknown 'time in force' specified : %v", v.TimeInForce)
+	}
+
+	postOnly := PostOnlyMode_None
+	if v.PostOnly != "" {
+		mode, found := PostOnlyMode_value[v.PostOnly]
+		if !found {
+			return sdkerrors.Wrapf(ErrInvalidPostOnlyMode, "Unknown post-only mode specified : %v", v.PostOnly)
+		}
+		postOnly = PostOnlyMode(mode)
+	}
+
+	selfTrade := SelfTradePrevention_None
+	if v.SelfTradePrevention != "" {
+		mode, found := SelfTradePrevention_value[v.SelfTradePrevention]
+		if !found {
+			return sdkerrors.Wrapf(ErrInvalidSelfTradePrevention, "Unknown self-trade prevention mode specified : %v", v.SelfTradePrevention)
+		}
+		selfTrade = SelfTradePrevention(mode)
+	}
+
+	displayQuantity, displayRemaining := sdk.ZeroInt(), sdk.ZeroInt()
+	if v.DisplayQuantity != nil {
+		displayQuantity = *v.DisplayQuantity
+	}
+	if v.DisplayRemaining != nil {
+		displayRemaining = *v.DisplayRemaining
+	}
+
+	*o = Order{
+		ID:                  v.ID,
+		TimeInForce:         TimeInForce(tif),
+		Owner:               v.Owner,
+		ClientOrderID:       v.ClientOrderID,
+		Source:              v.Source,
+		SourceRemaining:     v.SourceRemaining,
+		SourceFilled:        v.SourceFilled,
+		Destination:         v.Destination,
+		DestinationFilled:   v.DestinationFilled,
+		Created:             v.Created,
+		ExpireTime:          v.ExpireTime,
+		ExpireHeight:        v.ExpireHeight,
+		PostOnly:            postOnly,
+		SelfTradePrevention: selfTrade,
+		DisplayQuantity:     displayQuantity,
+		DisplayRemaining:    displayRemaining,
+		Priority:            v.Priority,
+	}
+
+	return nil
+}
+
+// UnmarshalJSONPB makes the proto JSON codec read the layout written by Order.MarshalJSON, e.g. in the genesis file.
+func (o *Order) UnmarshalJSONPB(_ *jsonpb.Unmarshaler, bz []byte) error {
+	return o.UnmarshalJSON(bz)
 }
 
 // Signals whether the order can be meaningfully executed, ie will pay for more than one unit of the destination token.
@@ -56,13 +147,108 @@ func (o Order) IsFilled() bool {
 	return o.SourceRemaining.ToDec().Mul(o.Price()).LT(sdk.OneDec()) || o.DestinationFilled.GTE(o.Destination.Amount)
 }
 
+// Signals whether the order only shows a slice of its remainder on the book.
+func (o Order) IsIceberg() bool {
+	return !o.DisplayQuantity.IsNil() && o.DisplayQuantity.IsPositive()
+}
+
+// The amount of the source denomination that is shown on the book and available for matching.
+func (o Order) VisibleRemaining() sdk.Int {
+	if !o.IsIceberg() {
+		return o.SourceRemaining
+	}
+
+	return sdk.MinInt(o.DisplayRemaining, o.SourceRemaining)
+}
+
+// Signals whether the displayed slice of an iceberg order can no longer be meaningfully executed, while its hidden
+// reserve can.
+func (o Order) IsSliceFilled() bool {
+	return o.IsIceberg() && !o.IsFilled() && o.VisibleRemaining().ToDec().Mul(o.Price()).LT(sdk.OneDec())
+}
+
+// Reduce the displayed slice of an iceberg order by a filled amount of the source denomination.
+func (o *Order) FillSlice(amount sdk.Int) {
+	if !o.IsIceberg() {
+		return
+	}
+
+	o.DisplayRemaining = sdk.MaxInt(o.DisplayRemaining.Sub(amount), sdk.ZeroInt())
+}
+
+// Show the next slice of an iceberg order from its hidden reserve.
+func (o *Order) RefillSlice() {
+	if !o.IsIceberg() {
+		return
+	}
+
+	o.DisplayRemaining = sdk.MinInt(o.DisplayQuantity, o.SourceRemaining)
+}
+
+// The position of the order among resting orders at the same price. Lower values are matched first.
+func (o Order) TimePriority() uint64 {
+	if o.Priority != 0 {
+		return o.Priority
+	}
+
+	return o.ID
+}
+
+// Signals whether a GoodTillTime or GoodTillBlock order has reached its expiry.
+func (o Order) IsExpired(blockTime time.Time, blockHeight int64) bool {
+	switch o.TimeInForce {
+	case TimeInForce_GoodTillTime:
+		return !blockTime.Before(*o.ExpireTime)
+	case TimeInForce_GoodTillBlock:
+		return blockHeight >= o.ExpireHeight
+	}
+
+	return false
+}
+
 func (o Order) IsValid() error {
 	switch o.TimeInForce {
 	case TimeInForce_GoodTillCancel, TimeInForce_FillOrKill, TimeInForce_ImmediateOrCancel:
+		if o.ExpireTime != nil || o.ExpireHeight != 0 {
+			return sdkerrors.Wrapf(ErrInvalidExpiry, "Expiry can only be specified for GTT and GTB orders")
+		}
+	case TimeInForce_GoodTillTime:
+		if o.ExpireTime == nil || o.ExpireHeight != 0 {
+			return sdkerrors.Wrapf(ErrInvalidExpiry, "GTT orders must specify an expiry time only")
+		}
+	case TimeInForce_GoodTillBlock:
+		if o.ExpireHeight <= 0 || o.ExpireTime != nil {
+			return sdkerrors.Wrapf(ErrInvalidExpiry, "GTB orders must specify a positive expiry height only")
+		}
 	default:
 		return sdkerrors.Wrapf(ErrUnknownTimeInForce, "Unknown 'time in force' specified : %v", o.TimeInForce)
 	}
 
+	switch o.PostOnly {
+	case PostOnlyMode_None:
+	case PostOnlyMode_Reject, PostOnlyMode_Reprice:
+		if o.TimeInForce == TimeInForce_ImmediateOrCancel || o.TimeInForce == TimeInForce_FillOrKill {
+			return sdkerrors.Wrapf(ErrInvalidPostOnlyMode, "%v orders cannot be post-only", o.TimeInForce)
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidPostOnlyMode, "Unknown post-only mode specified : %v", o.PostOnly)
+	}
+
+	if o.IsIceberg() {
+		if o.TimeInForce == TimeInForce_ImmediateOrCancel || o.TimeInForce == TimeInForce_FillOrKill {
+			return sdkerrors.Wrapf(ErrInvalidDisplayQuantity, "%v orders do not rest on the book", o.TimeInForce)
+		}
+		if o.DisplayQuantity.GT(o.Source.Amount) {
+			return sdkerrors.Wrapf(ErrInvalidDisplayQuantity, "Display quantity %v exceeds the order's source %v", o.DisplayQuantity, o.Source)
+		}
+	} else if !o.DisplayQuantity.IsNil() && o.DisplayQuantity.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidDisplayQuantity, "Display quantity cannot be negative: %v", o.DisplayQuantity)
+	}
+
+	if _, found := SelfTradePrevention_name[int32(o.SelfTradePrevention)]; !found {
+		return sdkerrors.Wrapf(ErrInvalidSelfTradePrevention, "Unknown self-trade prevention mode specified : %v", o.SelfTradePrevention)
+	}
+
 	if o.Source.Amount.LTE(sdk.ZeroInt()) {
 		return sdkerrors.Wrapf(ErrInvalidPrice, "Order price is invalid: %s -> %s", o.Source.Amount, o.Destination.Amount)
 	}
@@ -87,35 +273,46 @@ func (o Order) String() string {
 }
 
 func (ep ExecutionPlan) DestinationCapacity() sdk.Dec {
-	if ep.FirstOrder == nil {
+	if len(ep.Orders) == 0 {
 		return sdk.ZeroDec()
 	}
 
 	// Find capacity of the first order.
-	res := ep.FirstOrder.SourceRemaining.ToDec().Mul(ep.FirstOrder.Price())
-	res = sdk.MinDec(res, ep.FirstOrder.Destination.Amount.Sub(ep.FirstOrder.DestinationFilled).ToDec())
+	res := ep.Orders[0].destinationCapacity()
 
-	if ep.SecondOrder != nil {
-		// Convert first order capacity to second order destination.
-		res = res.Mul(ep.SecondOrder.Price())
+	for _, o := range ep.Orders[1:] {
+		// Convert the capacity of the previous orders to this order's destination.
+		res = res.Mul(o.Price())
 
 		// Determine which of the orders have the lowest capacity.
-		res = sdk.MinDec(res, ep.SecondOrder.SourceRemaining.ToDec().Mul(ep.SecondOrder.Price()))
-		res = sdk.MinDec(res, ep.SecondOrder.Destination.Amount.Sub(ep.SecondOrder.DestinationFilled).ToDec())
+		res = sdk.MinDec(res, o.destinationCapacity())
 	}
 
 	return res
 }
 
+// Signals whether any order in the plan belongs to owner.
+func (ep ExecutionPlan) HasOwner(owner string) bool {
+	for _, o := range ep.Orders {
+		if o.Owner == owner {
+			return true
+		}
+	}
+
+	return false
+}
+
+// The amount of destination tokens the visible remainder of the order can still absorb.
+func (o Order) destinationCapacity() sdk.Dec {
+	res := o.VisibleRemaining().ToDec().Mul(o.Price())
+	return sdk.MinDec(res, o.Destination.Amount.Sub(o.DestinationFilled).ToDec())
+}
+
 func (ep ExecutionPlan) String() string {
 	var buf strings.Builder
 
 	var capacityDenom string
-	for _, o := range []*Order{ep.FirstOrder, ep.SecondOrder} {
-		if o == nil {
-			continue
-		}
-
+	for _, o := range ep.Orders {
 		capacityDenom = o.Destination.Denom
 		buf.WriteString(fmt.Sprintf(" - %v\n", o.String()))
 	}
@@ -132,6 +329,19 @@ func NewOrder(
 	seller sdk.AccAddress,
 	clientOrderId string) (Order, error) {
 
+	return NewOrderWithExpiry(createdTm, timeInForce, src, dst, seller, clientOrderId, nil, 0)
+}
+
+// NewOrderWithExpiry creates an order that may carry the expiry of a GoodTillTime or GoodTillBlock order.
+func NewOrderWithExpiry(
+	createdTm time.Time,
+	timeInForce TimeInForce,
+	src, dst sdk.Coin,
+	seller sdk.AccAddress,
+	clientOrderId string,
+	expireTime *time.Time,
+	expireHeight int64) (Order, error) {
+
 	if src.Amount.LTE(sdk.ZeroInt()) || dst.Amount.LTE(sdk.ZeroInt()) {
 		return Order{}, sdkerrors.Wrapf(ErrInvalidPrice, "Order price is invalid: %s -> %s", src.Amount, dst.Amount)
 	}
@@ -148,6 +358,10 @@ func NewOrder(
 		Destination:       dst,
 		DestinationFilled: sdk.ZeroInt(),
 		Created:           createdTm,
+		ExpireTime:        expireTime,
+		ExpireHeight:      expireHeight,
+		DisplayQuantity:   sdk.ZeroInt(),
+		DisplayRemaining:  sdk.ZeroInt(),
 	}
 
 	if err := o.IsValid(); err != nil {
@@ -157,6 +371,80 @@ func NewOrder(
 	return o, nil
 }
 
+// NewStopOrder creates a stop order that sends a limit or market order to the market once its stop price is reached.
+func NewStopOrder(
+	createdTm time.Time,
+	orderType StopOrderType,
+	timeInForce TimeInForce,
+	src, dst sdk.Coin,
+	stopPrice, maxSlippage sdk.Dec,
+	seller sdk.AccAddress,
+	clientOrderId string) (StopOrder, error) {
+
+	so := StopOrder{
+		OrderType:   orderType,
+		TimeInForce: timeInForce,
+
+		Owner:         seller.String(),
+		ClientOrderID: clientOrderId,
+
+		Source:      src,
+		Destination: dst,
+		StopPrice:   stopPrice,
+		MaxSlippage: maxSlippage,
+		Created:     createdTm,
+	}
+
+	if err := so.IsValid(); err != nil {
+		return StopOrder{}, err
+	}
+
+	return so, nil
+}
+
+func (so StopOrder) IsValid() error {
+	switch so.TimeInForce {
+	case TimeInForce_GoodTillCancel, TimeInForce_FillOrKill, TimeInForce_ImmediateOrCancel:
+	default:
+		return sdkerrors.Wrapf(ErrInvalidStopOrder, "Stop orders cannot use time in force %v", so.TimeInForce)
+	}
+
+	if so.StopPrice.IsNil() || !so.StopPrice.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidStopOrder, "Stop price must be positive: %v", so.StopPrice)
+	}
+
+	if so.Destination.Amount.LTE(sdk.ZeroInt()) {
+		return sdkerrors.Wrapf(ErrInvalidPrice, "Order price is invalid: %s -> %s", so.Source.Amount, so.Destination.Amount)
+	}
+
+	if so.Source.Denom == so.Destination.Denom {
+		return sdkerrors.Wrapf(ErrInvalidInstrument, "'%v/%v' is not a valid instrument", so.Source.Denom, so.Destination.Denom)
+	}
+
+	switch so.OrderType {
+	case StopOrderType_Limit:
+		if so.Source.Amount.LTE(sdk.ZeroInt()) {
+			return sdkerrors.Wrapf(ErrInvalidPrice, "Order price is invalid: %s -> %s", so.Source.Amount, so.Destination.Amount)
+		}
+	case StopOrderType_Market:
+		if !so.Source.Amount.IsZero() {
+			return sdkerrors.Wrapf(ErrInvalidStopOrder, "Market stop orders only specify the source denomination")
+		}
+		if so.MaxSlippage.IsNil() || so.MaxSlippage.IsNegative() {
+			return sdkerrors.Wrapf(ErrInvalidSlippage, "Cannot be negative")
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidStopOrder, "Unknown stop order type specified : %v", so.OrderType)
+	}
+
+	return nil
+}
+
+// Signals whether the last traded price of the instrument has fallen to or below the stop price.
+func (so StopOrder) IsTriggered(lastPrice sdk.Dec) bool {
+	return lastPrice.LTE(so.StopPrice)
+}
+
 // Convert from TimeInForce string representation to the internal enum type. Case insensitive.
 func TimeInForceFromString(p string) (TimeInForce, error) {
 	p = strings.ToLower(p)
@@ -168,7 +456,61 @@ func TimeInForceFromString(p string) (TimeInForce, error) {
 		return TimeInForce_ImmediateOrCancel, nil
 	case "gtc":
 		return TimeInForce_GoodTillCancel, nil
+	case "gtt":
+		return TimeInForce_GoodTillTime, nil
+	case "gtb":
+		return TimeInForce_GoodTillBlock, nil
 	}
 
 	return 0, fmt.Errorf("unknown time-in-force value: %v", p)
 }
+
+// Convert from the post-only string representation to the internal enum type. Case insensitive.
+func PostOnlyModeFromString(p string) (PostOnlyMode, error) {
+	p = strings.ToLower(p)
+
+	switch p {
+	case "", "none":
+		return PostOnlyMode_None, nil
+	case "reject":
+		return PostOnlyMode_Reject, nil
+	case "reprice":
+		return PostOnlyMode_Reprice, nil
+	}
+
+	return 0, fmt.Errorf("unknown post-only mode: %v", p)
+}
+
+// Convert from the self-trade prevention string representation to the internal enum type. Case insensitive.
+func SelfTradePreventionFromString(p string) (SelfTradePrevention, error) {
+	p = strings.ToLower(p)
+
+	switch p {
+	case "", "none":
+		return SelfTradePrevention_None, nil
+	case "cancel_newest":
+		return SelfTradePrevention_CancelNewest, nil
+	case "cancel_oldest":
+		return SelfTradePrevention_CancelOldest, nil
+	case "cancel_both":
+		return SelfTradePrevention_CancelBoth, nil
+	case "decrement_and_cancel":
+		return SelfTradePrevention_DecrementAndCancel, nil
+	}
+
+	return 0, fmt.Errorf("unknown self-trade prevention mode: %v", p)
+}
+
+// Convert from the stop order type string representation to the internal enum type. Case insensitive.
+func StopOrderTypeFromString(p string) (StopOrderType, error) {
+	p = strings.ToLower(p)
+
+	switch p {
+	case "limit":
+		return StopOrderType_Limit, nil
+	case "market":
+		return StopOrderType_Market, nil
+	}
+
+	return 0, fmt.Errorf("unknown stop order type: %v", p)
+}
@@ -5,6 +5,7 @@
 package types
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -123,10 +124,59 @@ func TestTimeInForce(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, TimeInForce_FillOrKill, tif)
 
+	tif, err = TimeInForceFromString("gtt")
+	require.NoError(t, err)
+	require.Equal(t, TimeInForce_GoodTillTime, tif)
+
+	tif, err = TimeInForceFromString("GTB")
+	require.NoError(t, err)
+	require.Equal(t, TimeInForce_GoodTillBlock, tif)
+
 	_, err = TimeInForceFromString("f0k")
 	require.Error(t, err)
 }
 
+func TestOrderExpiry(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Minute)
+
+	specs := map[string]struct {
+		tif          TimeInForce
+		expireTime   *time.Time
+		expireHeight int64
+		expErr       bool
+	}{
+		"gtc":                      {tif: TimeInForce_GoodTillCancel},
+		"gtc with expiry time":     {tif: TimeInForce_GoodTillCancel, expireTime: &later, expErr: true},
+		"ioc with expiry height":   {tif: TimeInForce_ImmediateOrCancel, expireHeight: 5, expErr: true},
+		"gtt":                      {tif: TimeInForce_GoodTillTime, expireTime: &later},
+		"gtt without expiry":       {tif: TimeInForce_GoodTillTime, expErr: true},
+		"gtt with expiry height":   {tif: TimeInForce_GoodTillTime, expireTime: &later, expireHeight: 5, expErr: true},
+		"gtb":                      {tif: TimeInForce_GoodTillBlock, expireHeight: 5},
+		"gtb without expiry":       {tif: TimeInForce_GoodTillBlock, expErr: true},
+		"gtb with negative height": {tif: TimeInForce_GoodTillBlock, expireHeight: -1, expErr: true},
+	}
+
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			o, err := NewOrderWithExpiry(now, spec.tif, coin("100eur"), coin("120usd"), []byte("acc"), "A", spec.expireTime, spec.expireHeight)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.False(t, o.IsExpired(now, 4))
+			switch spec.tif {
+			case TimeInForce_GoodTillTime:
+				require.True(t, o.IsExpired(later, 4))
+			case TimeInForce_GoodTillBlock:
+				require.True(t, o.IsExpired(now, 5))
+			}
+		})
+	}
+}
+
 func coin(s string) sdk.Coin {
 	coin, err := sdk.ParseCoinNormalized(s)
 	if err != nil {
@@ -134,3 +184,126 @@ func coin(s string) sdk.Coin {
 	}
 	return coin
 }
+
+func TestPostOnlyMode(t *testing.T) {
+	for _, tif := range []TimeInForce{TimeInForce_GoodTillCancel, TimeInForce_ImmediateOrCancel, TimeInForce_FillOrKill} {
+		o, err := NewOrder(time.Now(), tif, coin("100eur"), coin("120usd"), []byte("acc"), "A")
+		require.NoError(t, err)
+
+		o.PostOnly = PostOnlyMode_Reprice
+		if tif == TimeInForce_GoodTillCancel {
+			require.NoError(t, o.IsValid())
+		} else {
+			require.True(t, ErrInvalidPostOnlyMode.Is(o.IsValid()))
+		}
+	}
+
+	mode, err := PostOnlyModeFromString("REPRICE")
+	require.NoError(t, err)
+	require.Equal(t, PostOnlyMode_Reprice, mode)
+
+	mode, err = PostOnlyModeFromString("")
+	require.NoError(t, err)
+	require.Equal(t, PostOnlyMode_None, mode)
+
+	_, err = PostOnlyModeFromString("maybe")
+	require.Error(t, err)
+}
+
+func TestSelfTradePrevention(t *testing.T) {
+	o, err := NewOrder(time.Now(), TimeInForce_GoodTillCancel, coin("100eur"), coin("120usd"), []byte("acc"), "A")
+	require.NoError(t, err)
+
+	o.SelfTradePrevention = SelfTradePrevention_DecrementAndCancel
+	require.NoError(t, o.IsValid())
+
+	bz, err := json.Marshal(o)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"self_trade_prevention":"SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL"`)
+
+	var decoded Order
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	require.Equal(t, SelfTradePrevention_DecrementAndCancel, decoded.SelfTradePrevention)
+
+	o.SelfTradePrevention = SelfTradePrevention(42)
+	require.True(t, ErrInvalidSelfTradePrevention.Is(o.IsValid()))
+
+	mode, err := SelfTradePreventionFromString("CANCEL_OLDEST")
+	require.NoError(t, err)
+	require.Equal(t, SelfTradePrevention_CancelOldest, mode)
+
+	mode, err = SelfTradePreventionFromString("")
+	require.NoError(t, err)
+	require.Equal(t, SelfTradePrevention_None, mode)
+
+	_, err = SelfTradePreventionFromString("sometimes")
+	require.Error(t, err)
+}
+
+func TestIcebergSlice(t *testing.T) {
+	o, err := NewOrder(time.Now(), TimeInForce_GoodTillCancel, coin("300eur"), coin("360usd"), []byte("acc"), "A")
+	require.NoError(t, err)
+	require.False(t, o.IsIceberg())
+	require.Equal(t, "300", o.VisibleRemaining().String())
+
+	o.DisplayQuantity = sdk.NewInt(100)
+	o.RefillSlice()
+	require.True(t, o.IsIceberg())
+	require.Equal(t, "100", o.VisibleRemaining().String())
+
+	o.SourceRemaining = sdk.NewInt(200)
+	o.FillSlice(sdk.NewInt(100))
+	require.True(t, o.IsSliceFilled())
+	require.Equal(t, uint64(0), o.TimePriority())
+
+	o.RefillSlice()
+	require.False(t, o.IsSliceFilled())
+	require.Equal(t, "100", o.DisplayRemaining.String())
+
+	o.Priority = 7
+	bz, err := json.Marshal(o)
+	require.NoError(t, err)
+
+	var decoded Order
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	require.Equal(t, "100", decoded.DisplayQuantity.String())
+	require.Equal(t, "100", decoded.DisplayRemaining.String())
+	require.Equal(t, uint64(7), decoded.TimePriority())
+}
+
+func TestStopOrderValidation(t *testing.T) {
+	stopPrice := sdk.NewDecWithPrec(11, 1)
+
+	specs := map[string]struct {
+		orderType   StopOrderType
+		tif         TimeInForce
+		src         string
+		stopPrice   sdk.Dec
+		maxSlippage sdk.Dec
+		expErr      bool
+	}{
+		"limit":                      {orderType: StopOrderType_Limit, tif: TimeInForce_GoodTillCancel, src: "100eur", stopPrice: stopPrice, maxSlippage: sdk.ZeroDec()},
+		"limit without source":       {orderType: StopOrderType_Limit, tif: TimeInForce_GoodTillCancel, src: "0eur", stopPrice: stopPrice, maxSlippage: sdk.ZeroDec(), expErr: true},
+		"market":                     {orderType: StopOrderType_Market, tif: TimeInForce_ImmediateOrCancel, src: "0eur", stopPrice: stopPrice, maxSlippage: sdk.NewDecWithPrec(5, 2)},
+		"market with source amount":  {orderType: StopOrderType_Market, tif: TimeInForce_GoodTillCancel, src: "100eur", stopPrice: stopPrice, maxSlippage: sdk.ZeroDec(), expErr: true},
+		"market with negative slip":  {orderType: StopOrderType_Market, tif: TimeInForce_GoodTillCancel, src: "0eur", stopPrice: stopPrice, maxSlippage: sdk.NewDec(-1), expErr: true},
+		"unspecified order type":     {orderType: StopOrderType_Unspecified, tif: TimeInForce_GoodTillCancel, src: "100eur", stopPrice: stopPrice, maxSlippage: sdk.ZeroDec(), expErr: true},
+		"zero stop price":            {orderType: StopOrderType_Limit, tif: TimeInForce_GoodTillCancel, src: "100eur", stopPrice: sdk.ZeroDec(), maxSlippage: sdk.ZeroDec(), expErr: true},
+		"good till time unsupported": {orderType: StopOrderType_Limit, tif: TimeInForce_GoodTillTime, src: "100eur", stopPrice: stopPrice, maxSlippage: sdk.ZeroDec(), expErr: true},
+	}
+
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			so, err := NewStopOrder(time.Now(), spec.orderType, spec.tif, coin(spec.src), coin("110usd"), spec.stopPrice, spec.maxSlippage, []byte("acc"), "A")
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.False(t, so.IsTriggered(sdk.NewDecWithPrec(12, 1)))
+			require.True(t, so.IsTriggered(stopPrice))
+			require.True(t, so.IsTriggered(sdk.OneDec()))
+		})
+	}
+}